@@ -19,10 +19,17 @@ type Flags struct {
 	runCommand string
 	showVersion bool
 
-	batchSize int64
-	parallel  bool
-	force     bool
-	targetIds targetIds
+	batchSize       int64
+	parallelHeights int64
+	parallel        bool
+	force           bool
+	startHeight     int64
+	endHeight       int64
+	sampleSize      int64
+	output          string
+	height          int64
+	dry             bool
+	targetIds       targetIds
 }
 
 type targetIds []int64
@@ -51,8 +58,15 @@ func (c *Flags) Setup() {
 	flag.StringVar(&c.runCommand, "cmd", "", "Command to run")
 
 	flag.Int64Var(&c.batchSize, "batch_size", 0, "pipeline batch size")
+	flag.Int64Var(&c.parallelHeights, "parallel_heights", 0, "number of heights fetched in parallel (defaults to config value)")
 	flag.BoolVar(&c.parallel, "parallel", false, "should backfill be run in parallel with indexing")
 	flag.BoolVar(&c.force, "force", false, "remove existing reindexing reports")
+	flag.Int64Var(&c.startHeight, "start_height", 0, "first height to reindex or verify")
+	flag.Int64Var(&c.endHeight, "end_height", 0, "last height to reindex or verify")
+	flag.Int64Var(&c.sampleSize, "sample_size", 0, "number of random heights to verify (verifies all heights in range by default)")
+	flag.StringVar(&c.output, "output", "", "path to verification report or run result file (defaults to stdout)")
+	flag.Int64Var(&c.height, "height", 0, "height to run pipeline for")
+	flag.BoolVar(&c.dry, "dry", true, "run pipeline without persisting its result")
 	flag.Var(&c.targetIds, "target_ids", "comma separated list of integers")
 }
 
@@ -134,7 +148,23 @@ func initLogger(cfg *config.Config) error {
 }
 
 func initClient(cfg *config.Config) (figmentclient.Client, error) {
-	return figmentclient.New(cfg.NodeUrl)
+	if cfg.ArchiveMode == config.ArchiveModeReplay {
+		return figmentclient.NewReplayClient(cfg.ArchiveDir)
+	}
+
+	poolCfg, err := figmentclient.NewNodePoolConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := figmentclient.New(cfg.NodeUrl, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ArchiveMode == config.ArchiveModeWrite {
+		return figmentclient.NewArchiveClient(c, cfg.ArchiveDir)
+	}
+	return c, nil
 }
 
 func initTheCeloClient(cfg *config.Config) (theceloclient.Client, error) {
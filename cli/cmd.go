@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/metrics"
 	"github.com/figment-networks/celo-indexer/usecase"
 	"github.com/figment-networks/celo-indexer/utils/logger"
 	"github.com/pkg/errors"
@@ -34,9 +35,20 @@ func runCmd(cfg *config.Config, flags Flags) error {
 	case "status":
 		cmdHandlers.GetStatus.Handle(ctx)
 	case "indexer_start":
-		cmdHandlers.StartIndexer.Handle(ctx, flags.batchSize)
+		cmdHandlers.StartIndexer.Handle(ctx, flags.batchSize, flags.parallelHeights)
+	case "follow":
+		cmdHandlers.FollowIndexer.Handle(ctx, flags.batchSize, flags.parallelHeights)
 	case "indexer_backfill":
 		cmdHandlers.BackfillIndexer.Handle(ctx, flags.parallel, flags.force, flags.targetIds)
+	case "indexer_reindex":
+		cmdHandlers.ReindexIndexer.Handle(ctx, flags.startHeight, flags.endHeight, flags.targetIds)
+	case "indexer_repair":
+		cmdHandlers.RepairIndexer.Handle(ctx)
+	case "indexer_verify":
+		go startCmdMetricsServer(cfg)
+		cmdHandlers.VerifyIndexer.Handle(ctx, flags.startHeight, flags.endHeight, flags.sampleSize, flags.output)
+	case "indexer_run":
+		cmdHandlers.RunIndexer.Handle(ctx, flags.height, flags.targetIds, flags.dry, flags.output)
 	case "indexer_summarize":
 		cmdHandlers.SummarizeIndexer.Handle(ctx)
 	case "indexer_purge":
@@ -49,3 +61,9 @@ func runCmd(cfg *config.Config, flags Flags) error {
 	return nil
 }
 
+// startCmdMetricsServer exposes metrics of long running commands
+func startCmdMetricsServer(cfg *config.Config) {
+	if err := metrics.NewMetricsServer().StartServer(cfg.IndexerMetricAddr, cfg.MetricServerUrl); err != nil {
+		logger.Error(err)
+	}
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"errors"
+)
+
+// ErrorKind classifies errors of clients and indexer tasks, so only errors which can succeed on another attempt are retried
+type ErrorKind string
+
+const (
+	// ErrorKindUnknown is a kind of errors which could not be classified
+	ErrorKindUnknown ErrorKind = "unknown"
+	// ErrorKindTransient is a kind of network errors, timeouts and rate limits of node
+	ErrorKindTransient ErrorKind = "transient"
+	// ErrorKindStatePruned is a kind of errors returned when state of requested height is not available anymore
+	ErrorKindStatePruned ErrorKind = "state_pruned"
+	// ErrorKindContractNotDeployed is a kind of errors returned when contract is not deployed at requested height
+	ErrorKindContractNotDeployed ErrorKind = "contract_not_deployed"
+	// ErrorKindDataInvalid is a kind of errors returned when data cannot be decoded or mapped
+	ErrorKindDataInvalid ErrorKind = "data_invalid"
+	// ErrorKindDatabase is a kind of errors returned by database
+	ErrorKindDatabase ErrorKind = "database"
+)
+
+// Retryable returns true when error of given kind can succeed on another attempt
+func (k ErrorKind) Retryable() bool {
+	switch k {
+	case ErrorKindTransient, ErrorKindDatabase, ErrorKindUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Error is an error with known kind
+type Error struct {
+	Kind ErrorKind
+	Err  error
+}
+
+// NewError creates error of given kind
+func NewError(kind ErrorKind, err error) *Error {
+	return &Error{
+		Kind: kind,
+		Err:  err,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Cause lets errors.Cause of github.com/pkg/errors find wrapped error
+func (e *Error) Cause() error {
+	return e.Err
+}
+
+// KindOf returns kind of the first Error in chain of given error
+func KindOf(err error) ErrorKind {
+	var kindErr *Error
+	if errors.As(err, &kindErr) {
+		return kindErr.Kind
+	}
+	return ErrorKindUnknown
+}
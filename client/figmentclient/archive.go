@@ -0,0 +1,296 @@
+package figmentclient
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/celo-org/kliento/contracts"
+	"github.com/pkg/errors"
+)
+
+const (
+	archiveKindChainParams       = "chain_params"
+	archiveKindMeta              = "meta"
+	archiveKindBlock             = "block"
+	archiveKindTransactions      = "transactions"
+	archiveKindValidatorGroups   = "validator_groups"
+	archiveKindValidators        = "validators"
+	archiveKindIdentityPrefix    = "identity_"
+	archiveKindEpochSummary      = "epoch_summary"
+	archiveKindVoters            = "voters"
+	archiveKindVoterRewards      = "voter_rewards"
+	archiveKindElectedValidators = "elected_validators"
+	archiveKindValidatorSigners  = "validator_signers"
+
+	// archiveBucketSize is the number of heights kept in one archive directory
+	archiveBucketSize = 1000
+	archiveFileExt    = ".json.gz"
+	fixtureFileExt    = ".json"
+)
+
+var (
+	ErrNotArchived = errors.New("data is not archived")
+
+	// archivedDetailsTypes are types of operation details which are restored when transactions are read from archive.
+	// Details of other types are restored as raw JSON
+	archivedDetailsTypes = newDetailsTypes(
+		&Transfer{},
+		&TokenTransfer{},
+		&contracts.ElectionValidatorGroupVoteCast{},
+		&contracts.ElectionValidatorGroupVoteActivated{},
+		&contracts.ElectionValidatorGroupPendingVoteRevoked{},
+		&contracts.ElectionValidatorGroupActiveVoteRevoked{},
+		&contracts.LockedGoldGoldLocked{},
+		&contracts.LockedGoldGoldRelocked{},
+		&contracts.LockedGoldGoldUnlocked{},
+		&contracts.LockedGoldGoldWithdrawn{},
+		&contracts.LockedGoldAccountSlashed{},
+		&contracts.ValidatorsValidatorEpochPaymentDistributed{},
+		&contracts.GovernanceProposalVoted{},
+		&contracts.GovernanceProposalUpvoted{},
+		&contracts.GovernanceProposalApproved{},
+		&contracts.GovernanceProposalExecuted{},
+		&contracts.GovernanceProposalDequeued{},
+		&contracts.GovernanceProposalQueued{},
+		&contracts.GovernanceProposalExpired{},
+	)
+)
+
+func newDetailsTypes(details ...interface{}) map[string]reflect.Type {
+	types := map[string]reflect.Type{}
+	for _, d := range details {
+		t := reflect.TypeOf(d)
+		types[t.String()] = t.Elem()
+	}
+	return types
+}
+
+// archiveEntry is a single raw response stored in archive.
+// Partial entries were returned together with ErrContractNotDeployed
+type archiveEntry struct {
+	Partial bool            `json:"partial"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type archivedTransaction struct {
+	*Transaction
+	Operations []*archivedOperation `json:"operations"`
+}
+
+type archivedOperation struct {
+	Name        string          `json:"name"`
+	DetailsType string          `json:"details_type"`
+	Details     json.RawMessage `json:"details"`
+}
+
+// NewArchive creates archive of raw responses stored in given directory
+func NewArchive(dir string) *archive {
+	return &archive{dir: dir, compressed: true}
+}
+
+// NewFixtureArchive creates archive of raw responses stored as plain JSON files, so they can be reviewed in repository
+func NewFixtureArchive(dir string) *archive {
+	return &archive{dir: dir}
+}
+
+// archive stores raw responses as (optionally gzipped) JSON files in directories of archiveBucketSize heights
+type archive struct {
+	dir        string
+	compressed bool
+}
+
+func (a *archive) write(height int64, kind string, v interface{}, partial bool) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	entry := archiveEntry{Partial: partial, Data: data}
+
+	path := a.path(height, kind)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// Write to temporary file first, so replay never reads incomplete file
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := a.encode(tmp, entry); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (a *archive) read(height int64, kind string, v interface{}) (bool, error) {
+	f, err := os.Open(a.path(height, kind))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, ErrNotArchived
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	var entry archiveEntry
+	if err := a.decode(f, &entry); err != nil {
+		return false, err
+	}
+
+	return entry.Partial, json.Unmarshal(entry.Data, v)
+}
+
+func (a *archive) encode(w io.Writer, entry archiveEntry) error {
+	if !a.compressed {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entry)
+	}
+
+	zw := gzip.NewWriter(w)
+	if err := json.NewEncoder(zw).Encode(entry); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func (a *archive) decode(r io.Reader, entry *archiveEntry) error {
+	if !a.compressed {
+		return json.NewDecoder(r).Decode(entry)
+	}
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	return json.NewDecoder(zr).Decode(entry)
+}
+
+func (a *archive) writeTransactions(height int64, transactions []*Transaction, partial bool) error {
+	var archived []*archivedTransaction
+	for _, transaction := range transactions {
+		archivedTx := &archivedTransaction{Transaction: transaction}
+		for _, operation := range transaction.Operations {
+			details, err := json.Marshal(operation.Details)
+			if err != nil {
+				return err
+			}
+
+			archivedOp := &archivedOperation{
+				Name:    operation.Name,
+				Details: details,
+			}
+			if operation.Details != nil {
+				archivedOp.DetailsType = reflect.TypeOf(operation.Details).String()
+			}
+			archivedTx.Operations = append(archivedTx.Operations, archivedOp)
+		}
+		archived = append(archived, archivedTx)
+	}
+
+	return a.write(height, archiveKindTransactions, archived, partial)
+}
+
+func (a *archive) readTransactions(height int64) ([]*Transaction, bool, error) {
+	var archived []*archivedTransaction
+	partial, err := a.read(height, archiveKindTransactions, &archived)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var transactions []*Transaction
+	for _, archivedTx := range archived {
+		transaction := archivedTx.Transaction
+		if transaction == nil {
+			transaction = &Transaction{}
+		}
+		transaction.Operations = nil
+
+		for _, archivedOp := range archivedTx.Operations {
+			var details interface{} = archivedOp.Details
+			if t, ok := archivedDetailsTypes[archivedOp.DetailsType]; ok {
+				typedDetails := reflect.New(t).Interface()
+				if err := json.Unmarshal(archivedOp.Details, typedDetails); err != nil {
+					return nil, false, errors.Wrap(err, fmt.Sprintf("cannot restore operation details [type=%s]", archivedOp.DetailsType))
+				}
+				details = typedDetails
+			}
+
+			transaction.Operations = append(transaction.Operations, &Operation{
+				Name:    archivedOp.Name,
+				Details: details,
+			})
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, partial, nil
+}
+
+// lastHeight returns the most recent height with archived meta
+func (a *archive) lastHeight() (int64, error) {
+	buckets, err := ioutil.ReadDir(a.dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var lastHeight int64
+	for _, bucket := range buckets {
+		if !bucket.IsDir() {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(filepath.Join(a.dir, bucket.Name()))
+		if err != nil {
+			return 0, err
+		}
+
+		for _, file := range files {
+			name := strings.TrimSuffix(file.Name(), "_"+archiveKindMeta+a.fileExt())
+			if name == file.Name() {
+				continue
+			}
+			height, err := strconv.ParseInt(name, 10, 64)
+			if err == nil && height > lastHeight {
+				lastHeight = height
+			}
+		}
+	}
+
+	if lastHeight == 0 {
+		return 0, ErrNotArchived
+	}
+	return lastHeight, nil
+}
+
+func (a *archive) path(height int64, kind string) string {
+	if kind == archiveKindChainParams {
+		return filepath.Join(a.dir, kind+a.fileExt())
+	}
+	bucket := strconv.FormatInt(height/archiveBucketSize*archiveBucketSize, 10)
+	return filepath.Join(a.dir, bucket, fmt.Sprintf("%d_%s%s", height, kind, a.fileExt()))
+}
+
+func (a *archive) fileExt() string {
+	if a.compressed {
+		return archiveFileExt
+	}
+	return fixtureFileExt
+}
@@ -0,0 +1,131 @@
+package figmentclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	_ Client = (*archiveClient)(nil)
+)
+
+// NewArchiveClient creates client which stores raw responses of given client in archive directory
+func NewArchiveClient(c Client, dir string) (*archiveClient, error) {
+	return newArchiveClient(c, NewArchive(dir))
+}
+
+func newArchiveClient(c Client, a *archive) (*archiveClient, error) {
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "cannot create archive directory")
+	}
+
+	return &archiveClient{
+		Client:  c,
+		archive: a,
+	}, nil
+}
+
+// archiveClient decorates client with writing its raw responses to archive keyed by height.
+// Responses returned together with ErrContractNotDeployed are archived as partial
+type archiveClient struct {
+	Client
+
+	archive *archive
+}
+
+func (l *archiveClient) WithAssignedNode(nodeIndex uint8) Client {
+	return &archiveClient{
+		Client:  l.Client.WithAssignedNode(nodeIndex),
+		archive: l.archive,
+	}
+}
+
+func (l *archiveClient) GetChainParams(ctx context.Context) (*ChainParams, error) {
+	res, err := l.Client.GetChainParams(ctx)
+	return res, l.store(0, archiveKindChainParams, res, err)
+}
+
+func (l *archiveClient) GetMetaByHeight(ctx context.Context, h int64) (*HeightMeta, error) {
+	res, err := l.Client.GetMetaByHeight(ctx, h)
+	return res, l.store(h, archiveKindMeta, res, err)
+}
+
+func (l *archiveClient) GetBlockByHeight(ctx context.Context, h int64) (*Block, error) {
+	res, err := l.Client.GetBlockByHeight(ctx, h)
+	return res, l.store(h, archiveKindBlock, res, err)
+}
+
+func (l *archiveClient) GetTransactionsByHeight(ctx context.Context, h int64) ([]*Transaction, error) {
+	res, err := l.Client.GetTransactionsByHeight(ctx, h)
+	if !archivable(h, archiveKindTransactions, err) {
+		return res, err
+	}
+
+	if archiveErr := l.archive.writeTransactions(h, res, err == ErrContractNotDeployed); archiveErr != nil {
+		return nil, errors.Wrap(archiveErr, fmt.Sprintf("cannot archive transactions [height=%d]", h))
+	}
+	return res, err
+}
+
+func (l *archiveClient) GetValidatorGroupsByHeight(ctx context.Context, h int64) ([]*ValidatorGroup, error) {
+	res, err := l.Client.GetValidatorGroupsByHeight(ctx, h)
+	return res, l.store(h, archiveKindValidatorGroups, res, err)
+}
+
+func (l *archiveClient) GetValidatorsByHeight(ctx context.Context, h int64) ([]*Validator, error) {
+	res, err := l.Client.GetValidatorsByHeight(ctx, h)
+	return res, l.store(h, archiveKindValidators, res, err)
+}
+
+func (l *archiveClient) GetIdentityByHeight(ctx context.Context, rawAddress string, h int64) (*Identity, error) {
+	res, err := l.Client.GetIdentityByHeight(ctx, rawAddress, h)
+	return res, l.store(h, archiveKindIdentityPrefix+rawAddress, res, err)
+}
+
+func (l *archiveClient) GetEpochSummaryByHeight(ctx context.Context, h int64) (*EpochSummary, error) {
+	res, err := l.Client.GetEpochSummaryByHeight(ctx, h)
+	return res, l.store(h, archiveKindEpochSummary, res, err)
+}
+
+func (l *archiveClient) GetVotersByHeight(ctx context.Context, h int64) ([]string, error) {
+	res, err := l.Client.GetVotersByHeight(ctx, h)
+	return res, l.store(h, archiveKindVoters, res, err)
+}
+
+func (l *archiveClient) GetVoterRewardsByHeight(ctx context.Context, h int64, rawAccounts []string) ([]*VoterReward, error) {
+	res, err := l.Client.GetVoterRewardsByHeight(ctx, h, rawAccounts)
+	return res, l.store(h, archiveKindVoterRewards, res, err)
+}
+
+func (l *archiveClient) GetElectedValidatorsByHeight(ctx context.Context, h int64) ([]*ElectedValidator, error) {
+	res, err := l.Client.GetElectedValidatorsByHeight(ctx, h)
+	return res, l.store(h, archiveKindElectedValidators, res, err)
+}
+
+func (l *archiveClient) GetValidatorSignersByHeight(ctx context.Context, h int64) ([]string, error) {
+	res, err := l.Client.GetValidatorSignersByHeight(ctx, h)
+	return res, l.store(h, archiveKindValidatorSigners, res, err)
+}
+
+// store archives response and returns error of original call or error of archiving
+func (l *archiveClient) store(h int64, kind string, v interface{}, err error) error {
+	if !archivable(h, kind, err) {
+		return err
+	}
+
+	if archiveErr := l.archive.write(h, kind, v, err == ErrContractNotDeployed); archiveErr != nil {
+		return errors.Wrap(archiveErr, fmt.Sprintf("cannot archive %s [height=%d]", kind, h))
+	}
+	return err
+}
+
+// archivable checks if response can be archived. Responses for latest height (0) are not keyed by height
+func archivable(h int64, kind string, err error) bool {
+	if err != nil && err != ErrContractNotDeployed {
+		return false
+	}
+	return h != 0 || kind == archiveKindChainParams
+}
@@ -0,0 +1,166 @@
+package figmentclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/celo-org/kliento/contracts"
+	"github.com/ethereum/go-ethereum/common"
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	mock "github.com/figment-networks/celo-indexer/mock/client"
+	"github.com/golang/mock/gomock"
+)
+
+func TestArchiveClient_Replay(t *testing.T) {
+	t.Parallel()
+
+	const height int64 = 1500
+	epoch := int64(2)
+	lastInEpoch := false
+
+	meta := &figmentclient.HeightMeta{Height: height, Hash: "0xabc", Epoch: &epoch, LastInEpoch: &lastInEpoch}
+	block := &figmentclient.Block{Height: height, Hash: "0xabc", TxCount: 1}
+	transactions := []*figmentclient.Transaction{
+		{
+			Hash:     "0xdef",
+			Height:   height,
+			GasPrice: big.NewInt(10),
+			Success:  true,
+			Operations: []*figmentclient.Operation{
+				{Name: figmentclient.OperationTypeInternalTransfer, Details: &figmentclient.Transfer{From: "0x1", To: "0x2", Value: big.NewInt(5), Success: true}},
+				{Name: figmentclient.OperationTypeValidatorGroupVoteCast, Details: &contracts.ElectionValidatorGroupVoteCast{
+					Account: common.HexToAddress("0x1"),
+					Group:   common.HexToAddress("0x3"),
+					Value:   big.NewInt(100),
+					Raw:     celoTypes.Log{Address: common.HexToAddress("0x4"), Topics: []common.Hash{{}}, Data: []byte{}},
+				}},
+				{Name: "UnknownEvent", Details: map[string]interface{}{"value": "1"}},
+				{Name: figmentclient.OperationTypeTokenTransfer, Details: &figmentclient.TokenTransfer{Token: "cUSD", From: "0x1", To: "0x2", Value: big.NewInt(7)}},
+			},
+		},
+	}
+	validators := []*figmentclient.Validator{{Address: "0x1", Score: big.NewInt(1)}}
+	identity := &figmentclient.Identity{Name: "test"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodeClient := mock.NewMockClient(ctrl)
+	nodeClient.EXPECT().GetChainParams(gomock.Any()).Return(&figmentclient.ChainParams{ChainId: 42220}, nil)
+	nodeClient.EXPECT().GetMetaByHeight(gomock.Any(), height).Return(meta, nil)
+	nodeClient.EXPECT().GetBlockByHeight(gomock.Any(), height).Return(block, nil)
+	nodeClient.EXPECT().GetTransactionsByHeight(gomock.Any(), height).Return(transactions, nil)
+	nodeClient.EXPECT().GetValidatorsByHeight(gomock.Any(), height).Return(validators, nil)
+	nodeClient.EXPECT().GetValidatorGroupsByHeight(gomock.Any(), height).Return(nil, figmentclient.ErrContractNotDeployed)
+	nodeClient.EXPECT().GetIdentityByHeight(gomock.Any(), "0x1", height).Return(identity, nil)
+
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatalf("cannot create archive directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	archiveClient, err := figmentclient.NewArchiveClient(nodeClient, dir)
+	if err != nil {
+		t.Fatalf("cannot create archive client: %v", err)
+	}
+	archiveClient.GetChainParams(ctx)
+	archiveClient.GetMetaByHeight(ctx, height)
+	archiveClient.GetBlockByHeight(ctx, height)
+	archiveClient.GetTransactionsByHeight(ctx, height)
+	archiveClient.GetValidatorsByHeight(ctx, height)
+	if _, err := archiveClient.GetValidatorGroupsByHeight(ctx, height); err != figmentclient.ErrContractNotDeployed {
+		t.Errorf("archive client should return error of node client, got %v", err)
+	}
+	archiveClient.GetIdentityByHeight(ctx, "0x1", height)
+
+	replayClient, err := figmentclient.NewReplayClient(dir)
+	if err != nil {
+		t.Fatalf("cannot create replay client: %v", err)
+	}
+
+	t.Run("replays chain status from last archived height", func(t *testing.T) {
+		status, err := replayClient.GetChainStatus(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := &figmentclient.ChainStatus{ChainId: 42220, LastBlockHeight: height, LastBlockHash: "0xabc"}
+		if !reflect.DeepEqual(status, expected) {
+			t.Errorf("unexpected chain status, want %+v; got %+v", expected, status)
+		}
+	})
+
+	t.Run("replays responses", func(t *testing.T) {
+		gotMeta, err := replayClient.GetMetaByHeight(ctx, height)
+		if err != nil || !reflect.DeepEqual(gotMeta, meta) {
+			t.Errorf("unexpected meta, want %+v; got %+v (err: %v)", meta, gotMeta, err)
+		}
+		gotBlock, err := replayClient.GetBlockByHeight(ctx, height)
+		if err != nil || !reflect.DeepEqual(gotBlock, block) {
+			t.Errorf("unexpected block, want %+v; got %+v (err: %v)", block, gotBlock, err)
+		}
+		gotValidators, err := replayClient.GetValidatorsByHeight(ctx, height)
+		if err != nil || !reflect.DeepEqual(gotValidators, validators) {
+			t.Errorf("unexpected validators, want %+v; got %+v (err: %v)", validators, gotValidators, err)
+		}
+		gotIdentity, err := replayClient.GetIdentityByHeight(ctx, "0x1", height)
+		if err != nil || !reflect.DeepEqual(gotIdentity, identity) {
+			t.Errorf("unexpected identity, want %+v; got %+v (err: %v)", identity, gotIdentity, err)
+		}
+	})
+
+	t.Run("replays partial responses with error", func(t *testing.T) {
+		if _, err := replayClient.GetValidatorGroupsByHeight(ctx, height); err != figmentclient.ErrContractNotDeployed {
+			t.Errorf("unexpected error, want %v; got %v", figmentclient.ErrContractNotDeployed, err)
+		}
+	})
+
+	t.Run("restores types of operation details", func(t *testing.T) {
+		gotTransactions, err := replayClient.GetTransactionsByHeight(ctx, height)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(gotTransactions) != 1 || len(gotTransactions[0].Operations) != 4 {
+			t.Fatalf("unexpected transactions: %+v", gotTransactions)
+		}
+
+		operations := gotTransactions[0].Operations
+		if _, ok := operations[0].Details.(*figmentclient.Transfer); !ok {
+			t.Errorf("unexpected details type of transfer: %T", operations[0].Details)
+		}
+		voteCast, ok := operations[1].Details.(*contracts.ElectionValidatorGroupVoteCast)
+		if !ok {
+			t.Fatalf("unexpected details type of vote cast: %T", operations[1].Details)
+		}
+		if voteCast.Value.Cmp(big.NewInt(100)) != 0 || voteCast.Group != common.HexToAddress("0x3") {
+			t.Errorf("unexpected vote cast details: %+v", voteCast)
+		}
+
+		// Details of unknown types are kept as raw JSON, so they are stored the same way
+		raw, _ := json.Marshal(operations[2].Details)
+		if string(raw) != `{"value":"1"}` {
+			t.Errorf("unexpected raw details: %s", raw)
+		}
+
+		tokenTransfer, ok := operations[3].Details.(*figmentclient.TokenTransfer)
+		if !ok {
+			t.Fatalf("unexpected details type of token transfer: %T", operations[3].Details)
+		}
+		if tokenTransfer.Token != "cUSD" || tokenTransfer.Value.Cmp(big.NewInt(7)) != 0 {
+			t.Errorf("unexpected token transfer details: %+v", tokenTransfer)
+		}
+	})
+
+	t.Run("returns error for heights which are not archived", func(t *testing.T) {
+		if _, err := replayClient.GetBlockByHeight(ctx, height+1); err != figmentclient.ErrNotArchived {
+			t.Errorf("unexpected error, want %v; got %v", figmentclient.ErrNotArchived, err)
+		}
+	})
+}
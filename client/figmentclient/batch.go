@@ -0,0 +1,128 @@
+package figmentclient
+
+import (
+	"context"
+	"fmt"
+
+	kliento "github.com/celo-org/kliento/client"
+	"github.com/celo-org/kliento/client/debug"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/rpc"
+	base "github.com/figment-networks/celo-indexer/client"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+const (
+	// maxBatchSize is the biggest number of calls sent to node in one batch request
+	maxBatchSize = 100
+)
+
+type transfersTraceResult struct {
+	Transfers []debug.Transfer `json:"transfers"`
+}
+
+// getReceipts returns receipts of given transactions using batch requests.
+// It falls back to one request per transaction when node does not accept batch request
+func (l *client) getReceipts(ctx context.Context, cc *kliento.CeloClient, txHashes []common.Hash) ([]*celoTypes.Receipt, error) {
+	receipts := make([]*celoTypes.Receipt, len(txHashes))
+
+	elems := make([]rpc.BatchElem, len(txHashes))
+	for i, txHash := range txHashes {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{txHash},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := l.batchCall(ctx, cc, elems); err != nil {
+		logger.Info(fmt.Sprintf("batch receipts request failed, falling back to request per transaction [err=%v]", err))
+		return l.getReceiptsOneByOne(ctx, cc, txHashes)
+	}
+
+	for i, elem := range elems {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		if receipts[i] == nil {
+			// Node returns no receipt for transactions it did not index yet
+			return nil, base.NewError(base.ErrorKindTransient, ethereum.NotFound)
+		}
+	}
+	return receipts, nil
+}
+
+func (l *client) getReceiptsOneByOne(ctx context.Context, cc *kliento.CeloClient, txHashes []common.Hash) ([]*celoTypes.Receipt, error) {
+	var receipts []*celoTypes.Receipt
+	for _, txHash := range txHashes {
+		receipt, err := cc.Eth.TransactionReceipt(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+		l.requestCounter.IncrementCounter()
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// getTransfers returns internal transfers of given transactions traced with transfer tracer using batch requests.
+// It falls back to one request per transaction when node does not accept batch request
+func (l *client) getTransfers(ctx context.Context, cc *kliento.CeloClient, txHashes []common.Hash) ([][]debug.Transfer, error) {
+	results := make([]transfersTraceResult, len(txHashes))
+	tracerConfig := &eth.TraceConfig{Timeout: &transferTracerTimeout, Tracer: &transferTracer}
+
+	elems := make([]rpc.BatchElem, len(txHashes))
+	for i, txHash := range txHashes {
+		elems[i] = rpc.BatchElem{
+			Method: "debug_traceTransaction",
+			Args:   []interface{}{txHash, tracerConfig},
+			Result: &results[i],
+		}
+	}
+
+	if err := l.batchCall(ctx, cc, elems); err != nil {
+		logger.Info(fmt.Sprintf("batch traces request failed, falling back to request per transaction [err=%v]", err))
+		return l.getTransfersOneByOne(ctx, cc, txHashes)
+	}
+
+	transfers := make([][]debug.Transfer, len(txHashes))
+	for i, elem := range elems {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		transfers[i] = results[i].Transfers
+	}
+	return transfers, nil
+}
+
+func (l *client) getTransfersOneByOne(ctx context.Context, cc *kliento.CeloClient, txHashes []common.Hash) ([][]debug.Transfer, error) {
+	var transfers [][]debug.Transfer
+	for _, txHash := range txHashes {
+		internalTransfers, err := cc.Debug.TransactionTransfers(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+		l.requestCounter.IncrementCounter()
+		transfers = append(transfers, internalTransfers)
+	}
+	return transfers, nil
+}
+
+// batchCall sends calls to node in batches of at most maxBatchSize calls
+func (l *client) batchCall(ctx context.Context, cc *kliento.CeloClient, elems []rpc.BatchElem) error {
+	for start := 0; start < len(elems); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(elems) {
+			end = len(elems)
+		}
+
+		if err := cc.Rpc.BatchCallContext(ctx, elems[start:end]); err != nil {
+			return err
+		}
+		l.requestCounter.IncrementCounter()
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package figmentclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	kliento "github.com/celo-org/kliento/client"
+	"github.com/ethereum/go-ethereum/common"
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const testBlockHeight int64 = 100
+
+// fakeRpcServer is in-process stand-in of Celo node serving block with given number of transactions
+type fakeRpcServer struct {
+	*httptest.Server
+
+	block         map[string]interface{}
+	receipts      map[common.Hash]*celoTypes.Receipt
+	supportsBatch bool
+	requests      int64
+}
+
+type fakeRpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type fakeRpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+}
+
+func newFakeRpcServer(t testing.TB, txCount int, supportsBatch bool) *fakeRpcServer {
+	s := &fakeRpcServer{
+		receipts:      map[common.Hash]*celoTypes.Receipt{},
+		supportsBatch: supportsBatch,
+	}
+
+	var txs []*celoTypes.Transaction
+	for i := 0; i < txCount; i++ {
+		tx := celoTypes.NewTransaction(uint64(i), common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+		txs = append(txs, tx)
+		s.receipts[tx.Hash()] = &celoTypes.Receipt{
+			Status:           celoTypes.ReceiptStatusSuccessful,
+			Logs:             []*celoTypes.Log{},
+			TxHash:           tx.Hash(),
+			GasUsed:          21000,
+			TransactionIndex: uint(i),
+		}
+	}
+
+	header := &celoTypes.Header{Number: big.NewInt(testBlockHeight), TxHash: celoTypes.EmptyRootHash, Extra: []byte{}}
+	if txCount > 0 {
+		header.TxHash = celoTypes.DeriveSha(celoTypes.Transactions(txs))
+	}
+	rawHeader, err := json.Marshal(header)
+	if err != nil {
+		t.Fatalf("cannot marshal header: %v", err)
+	}
+	if err := json.Unmarshal(rawHeader, &s.block); err != nil {
+		t.Fatalf("cannot unmarshal header: %v", err)
+	}
+	s.block["transactions"] = txs
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeRpcServer) handle(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt64(&s.requests, 1)
+
+	body, _ := ioutil.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		if !s.supportsBatch {
+			http.Error(w, "batch requests are not supported", http.StatusBadRequest)
+			return
+		}
+
+		var reqs []fakeRpcRequest
+		json.Unmarshal(body, &reqs)
+
+		var resps []fakeRpcResponse
+		for _, req := range reqs {
+			resps = append(resps, s.respond(req))
+		}
+		json.NewEncoder(w).Encode(resps)
+		return
+	}
+
+	var req fakeRpcRequest
+	json.Unmarshal(body, &req)
+	json.NewEncoder(w).Encode(s.respond(req))
+}
+
+func (s *fakeRpcServer) respond(req fakeRpcRequest) fakeRpcResponse {
+	resp := fakeRpcResponse{Version: "2.0", ID: req.ID}
+
+	switch req.Method {
+	case "eth_getBlockByNumber":
+		resp.Result = s.block
+	case "eth_getTransactionReceipt", "debug_traceTransaction":
+		var txHash common.Hash
+		json.Unmarshal(req.Params[0], &txHash)
+		if req.Method == "debug_traceTransaction" {
+			resp.Result = map[string]interface{}{"transfers": []interface{}{}}
+		} else {
+			resp.Result = s.receipts[txHash]
+		}
+	case "eth_call":
+		// Registry returns zero address, so none of contracts is deployed
+		resp.Result = "0x" + strings.Repeat("0", 64)
+	case "eth_getLogs":
+		resp.Result = []interface{}{}
+	}
+	return resp
+}
+
+func newTestClient(t testing.TB, url string) *client {
+	cc, err := kliento.Dial(url)
+	if err != nil {
+		t.Fatalf("cannot dial fake rpc server: %v", err)
+	}
+	return &client{
+		celoClient:     cc,
+		requestCounter: &requestCounter{},
+		registryCache:  NewRegistryCache(),
+	}
+}
+
+func TestClient_GetTransactionsByHeight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description    string
+		txCount        int
+		supportsBatch  bool
+		expectRequests int64
+	}{
+		{"fetches receipts and traces in batches", 150, true, 6},
+		{"falls back to request per transaction when batch is not supported", 150, false, 304},
+		{"does not send batches for empty block", 0, true, 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			server := newFakeRpcServer(t, tt.txCount, tt.supportsBatch)
+			defer server.Close()
+
+			c := newTestClient(t, server.URL)
+			defer c.Close()
+
+			transactions, err := c.GetTransactionsByHeight(context.Background(), testBlockHeight)
+			if err != nil && err != ErrContractNotDeployed {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(transactions) != tt.txCount {
+				t.Errorf("unexpected transactions count, want %d; got %d", tt.txCount, len(transactions))
+			}
+			for i, transaction := range transactions {
+				if transaction.Index != uint(i) {
+					t.Errorf("unexpected transaction index, want %d; got %d", i, transaction.Index)
+				}
+			}
+			if server.requests != tt.expectRequests {
+				t.Errorf("unexpected requests count, want %d; got %d", tt.expectRequests, server.requests)
+			}
+		})
+	}
+}
+
+func BenchmarkClient_GetTransactionsByHeight(b *testing.B) {
+	for _, txCount := range []int{10, 100, 500} {
+		for _, supportsBatch := range []bool{true, false} {
+			b.Run(fmt.Sprintf("txs=%d/batch=%v", txCount, supportsBatch), func(b *testing.B) {
+				server := newFakeRpcServer(b, txCount, supportsBatch)
+				defer server.Close()
+
+				c := newTestClient(b, server.URL)
+				defer c.Close()
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := c.GetTransactionsByHeight(context.Background(), testBlockHeight); err != nil && err != ErrContractNotDeployed {
+						b.Fatalf("unexpected error: %v", err)
+					}
+				}
+				b.StopTimer()
+
+				b.ReportMetric(float64(atomic.LoadInt64(&server.requests))/float64(b.N), "requests/block")
+			})
+		}
+	}
+}
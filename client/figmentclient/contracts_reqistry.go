@@ -6,16 +6,28 @@ import (
 	kliento "github.com/celo-org/kliento/client"
 	"github.com/celo-org/kliento/contracts"
 	"github.com/celo-org/kliento/registry"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	base "github.com/figment-networks/celo-indexer/client"
 	"math/big"
 )
 
 var (
 	ErrContractNotDeployed = errors.New("contract not deployed")
+
+	registryUpdatedTopic = crypto.Keccak256Hash([]byte("RegistryUpdated(string,bytes32,address)"))
+
+	// stableTokenContractIDs are registry ids of all stable token contracts. kliento knows only the first one of them
+	stableTokenContractIDs = []registry.ContractID{
+		registry.StableTokenContractID,
+		registry.ContractID("StableTokenEUR"),
+		registry.ContractID("StableTokenBRL"),
+	}
 )
 
-func NewContractsRegistry(cc *kliento.CeloClient, rc base.RequestCounter, height *big.Int) (*contractsRegistry, error) {
+func NewContractsRegistry(cc *kliento.CeloClient, rc base.RequestCounter, cache *registryCache, height *big.Int) (*contractsRegistry, error) {
 	reg, err := registry.New(cc)
 	if err != nil {
 		return nil, err
@@ -26,8 +38,10 @@ func NewContractsRegistry(cc *kliento.CeloClient, rc base.RequestCounter, height
 		reg:            reg,
 		height:         height,
 		requestCounter: rc,
+		cache:          cache,
 
-		addresses: map[registry.ContractID]common.Address{},
+		addresses:    map[registry.ContractID]common.Address{},
+		stableTokens: map[common.Address]*stableToken{},
 	}, nil
 }
 
@@ -36,8 +50,10 @@ type contractsRegistry struct {
 	reg            registry.Registry
 	height         *big.Int
 	requestCounter base.RequestCounter
+	cache          *registryCache
 
-	addresses map[registry.ContractID]common.Address
+	addresses    map[registry.ContractID]common.Address
+	stableTokens map[common.Address]*stableToken
 
 	reserveContract      *contracts.Reserve
 	stableTokenContract  *contracts.StableToken
@@ -51,6 +67,12 @@ type contractsRegistry struct {
 	governanceContract   *contracts.Governance
 }
 
+// stableToken is stable token contract resolved through registry together with its symbol
+type stableToken struct {
+	contract *contracts.StableToken
+	symbol   string
+}
+
 func contractIncluded(contracts []registry.ContractID, contractID registry.ContractID) bool {
 	for _, id := range contracts {
 		if id == contractID {
@@ -126,17 +148,69 @@ func checkErr(err error) error {
 	}
 }
 
+// getAddressFor returns address of contract at registry height. Addresses at given height are served from cache when possible
+func (l *contractsRegistry) getAddressFor(ctx context.Context, contractID registry.ContractID) (common.Address, error) {
+	if l.cache == nil || l.height == nil {
+		return l.resolveAddressFor(ctx, contractID)
+	}
+
+	h := l.height.Int64()
+	address, ok := l.cache.Get(ctx, h, contractID, l.getRegistryUpdateHeights)
+	if !ok {
+		var err error
+		address, err = l.resolveAddressFor(ctx, contractID)
+		if err != nil && err != kliento.ErrContractNotDeployed {
+			return common.ZeroAddress, err
+		}
+		// Contract deployment is registry update as well, so not deployed contract is cached as zero address
+		l.cache.Set(h, contractID, address)
+	}
+
+	if address == common.ZeroAddress {
+		return common.ZeroAddress, kliento.ErrContractNotDeployed
+	}
+	return address, nil
+}
+
+func (l *contractsRegistry) resolveAddressFor(ctx context.Context, contractID registry.ContractID) (common.Address, error) {
+	address, err := l.reg.GetAddressFor(ctx, l.height, contractID)
+	if err != nil {
+		return common.ZeroAddress, err
+	}
+	l.requestCounter.IncrementCounter()
+	return address, nil
+}
+
+// getRegistryUpdateHeights returns heights of RegistryUpdated events emitted by Registry contract between given heights
+func (l *contractsRegistry) getRegistryUpdateHeights(ctx context.Context, fromHeight int64, toHeight int64) ([]int64, error) {
+	logs, err := l.cc.Eth.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromHeight),
+		ToBlock:   big.NewInt(toHeight),
+		Addresses: []common.Address{registry.RegistryAddress},
+		Topics:    [][]common.Hash{{registryUpdatedTopic}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+
+	var heights []int64
+	for _, log := range logs {
+		heights = append(heights, int64(log.BlockNumber))
+	}
+	return heights, nil
+}
+
 func (l *contractsRegistry) contractDeployed(contractId registry.ContractID) bool {
 	_, ok := l.addresses[contractId]
 	return ok
 }
 
 func (l *contractsRegistry) setupReserveContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.ReserveContractID)
+	address, err := l.getAddressFor(ctx, registry.ReserveContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewReserve(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -148,11 +222,10 @@ func (l *contractsRegistry) setupReserveContract(ctx context.Context) error {
 }
 
 func (l *contractsRegistry) setupStableTokenContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.StableTokenContractID)
+	address, err := l.getAddressFor(ctx, registry.StableTokenContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewStableToken(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -163,12 +236,54 @@ func (l *contractsRegistry) setupStableTokenContract(ctx context.Context) error
 	return nil
 }
 
+// setupStableTokenContracts sets up every stable token deployed at registry height
+func (l *contractsRegistry) setupStableTokenContracts(ctx context.Context) error {
+	for _, contractID := range stableTokenContractIDs {
+		address, err := l.getAddressFor(ctx, contractID)
+		if err == kliento.ErrContractNotDeployed {
+			continue
+		} else if err != nil {
+			return err
+		}
+		contract, err := contracts.NewStableToken(address, l.cc.Eth)
+		if err != nil {
+			return err
+		}
+		symbol, err := l.getTokenSymbol(ctx, address, contract)
+		if err != nil {
+			return err
+		}
+		l.addresses[contractID] = address
+		l.stableTokens[address] = &stableToken{contract: contract, symbol: symbol}
+	}
+	return nil
+}
+
+// getTokenSymbol returns symbol of stable token contract. Symbol of contract does not change, so it is cached for all heights
+func (l *contractsRegistry) getTokenSymbol(ctx context.Context, address common.Address, contract *contracts.StableToken) (string, error) {
+	if l.cache != nil {
+		if symbol, ok := l.cache.GetSymbol(address); ok {
+			return symbol, nil
+		}
+	}
+
+	symbol, err := contract.Symbol(&bind.CallOpts{Context: ctx, BlockNumber: l.height})
+	if err != nil {
+		return "", err
+	}
+	l.requestCounter.IncrementCounter()
+
+	if l.cache != nil {
+		l.cache.SetSymbol(address, symbol)
+	}
+	return symbol, nil
+}
+
 func (l *contractsRegistry) setupValidatorsContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.ValidatorsContractID)
+	address, err := l.getAddressFor(ctx, registry.ValidatorsContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewValidators(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -180,11 +295,10 @@ func (l *contractsRegistry) setupValidatorsContract(ctx context.Context) error {
 }
 
 func (l *contractsRegistry) setupLockedGoldContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.LockedGoldContractID)
+	address, err := l.getAddressFor(ctx, registry.LockedGoldContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewLockedGold(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -196,11 +310,10 @@ func (l *contractsRegistry) setupLockedGoldContract(ctx context.Context) error {
 }
 
 func (l *contractsRegistry) setupElectionContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.ElectionContractID)
+	address, err := l.getAddressFor(ctx, registry.ElectionContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewElection(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -212,11 +325,10 @@ func (l *contractsRegistry) setupElectionContract(ctx context.Context) error {
 }
 
 func (l *contractsRegistry) setupAccountsContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.AccountsContractID)
+	address, err := l.getAddressFor(ctx, registry.AccountsContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewAccounts(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -228,11 +340,10 @@ func (l *contractsRegistry) setupAccountsContract(ctx context.Context) error {
 }
 
 func (l *contractsRegistry) setupGoldTokenContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.GoldTokenContractID)
+	address, err := l.getAddressFor(ctx, registry.GoldTokenContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewGoldToken(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -244,11 +355,10 @@ func (l *contractsRegistry) setupGoldTokenContract(ctx context.Context) error {
 }
 
 func (l *contractsRegistry) setupChainParamsContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.BlockchainParametersContractID)
+	address, err := l.getAddressFor(ctx, registry.BlockchainParametersContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewBlockchainParameters(address, l.cc.Eth)
 	if err != nil {
 		return err
@@ -260,27 +370,25 @@ func (l *contractsRegistry) setupChainParamsContract(ctx context.Context) error
 }
 
 func (l *contractsRegistry) setupEpochRewardsContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.EpochRewardsContractID)
+	address, err := l.getAddressFor(ctx, registry.EpochRewardsContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewEpochRewards(address, l.cc.Eth)
 	if err != nil {
 		return err
 	}
-	l.addresses[registry.BlockchainParametersContractID] = address
+	l.addresses[registry.EpochRewardsContractID] = address
 	l.epochRewardsContract = contract
 
 	return nil
 }
 
 func (l *contractsRegistry) setupGovernanceContract(ctx context.Context) error {
-	address, err := l.reg.GetAddressFor(ctx, l.height, registry.GovernanceContractID)
+	address, err := l.getAddressFor(ctx, registry.GovernanceContractID)
 	if err != nil {
 		return checkErr(err)
 	}
-	l.requestCounter.IncrementCounter()
 	contract, err := contracts.NewGovernance(address, l.cc.Eth)
 	if err != nil {
 		return err
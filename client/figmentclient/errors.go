@@ -0,0 +1,86 @@
+package figmentclient
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	base "github.com/figment-networks/celo-indexer/client"
+	"github.com/pkg/errors"
+)
+
+var (
+	// statePrunedMessages are parts of node errors returned for heights which state is not available anymore
+	statePrunedMessages = []string{
+		"missing trie node",
+		"header not found",
+		"required historical state unavailable",
+		"state is not available",
+	}
+
+	// transientMessages are parts of errors returned by node or proxy which can succeed on another attempt
+	transientMessages = []string{
+		"connection refused",
+		"connection reset",
+		"broken pipe",
+		"i/o timeout",
+		"timeout exceeded",
+		"too many requests",
+		"bad gateway",
+		"service unavailable",
+		"gateway timeout",
+	}
+
+	// dataInvalidMessages are parts of errors returned when response of node cannot be decoded
+	dataInvalidMessages = []string{
+		"abi:",
+		"cannot unmarshal",
+		"invalid character",
+	}
+)
+
+// ErrorKindOf classifies error returned by client
+func ErrorKindOf(err error) base.ErrorKind {
+	if err == nil {
+		return ""
+	}
+	if kind := base.KindOf(err); kind != base.ErrorKindUnknown {
+		return kind
+	}
+
+	switch errors.Cause(err) {
+	case ErrContractNotDeployed:
+		return base.ErrorKindContractNotDeployed
+	case ErrNotArchived:
+		return base.ErrorKindStatePruned
+	case context.DeadlineExceeded, io.EOF, io.ErrUnexpectedEOF:
+		return base.ErrorKindTransient
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return base.ErrorKindTransient
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case containsAny(msg, statePrunedMessages):
+		return base.ErrorKindStatePruned
+	case containsAny(msg, transientMessages):
+		return base.ErrorKindTransient
+	case containsAny(msg, dataInvalidMessages):
+		return base.ErrorKindDataInvalid
+	}
+	return base.ErrorKindUnknown
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
@@ -9,7 +9,9 @@ import (
 
 	kliento "github.com/celo-org/kliento/client"
 	"github.com/celo-org/kliento/client/debug"
+	"github.com/celo-org/kliento/contracts"
 	"github.com/celo-org/kliento/registry"
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
@@ -17,15 +19,22 @@ import (
 	base "github.com/figment-networks/celo-indexer/client"
 	"github.com/figment-networks/celo-indexer/utils"
 	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/pkg/errors"
 )
 
 const (
 	CeloClientFigment = "figment_celo_client"
+
+	// GoldTokenSymbol is symbol of GoldToken transfers. Stable token transfers use symbols of their contracts
+	GoldTokenSymbol = "CELO"
 )
 
 var (
 	_ Client              = (*client)(nil)
 	_ base.RequestCounter = (*requestCounter)(nil)
+
+	ErrNodeUrlRequired = errors.New("at least one node url is required")
+	ErrHeightRequired  = errors.New("height is required")
 )
 
 type Client interface {
@@ -35,6 +44,7 @@ type Client interface {
 
 	GetRequestCounter() base.RequestCounter
 
+	SubscribeNewHead(context.Context, chan<- *celoTypes.Header) (ethereum.Subscription, error)
 	GetChainStatus(context.Context) (*ChainStatus, error)
 	GetChainParams(context.Context) (*ChainParams, error)
 	GetMetaByHeight(context.Context, int64) (*HeightMeta, error)
@@ -44,6 +54,11 @@ type Client interface {
 	GetValidatorsByHeight(context.Context, int64) ([]*Validator, error)
 	GetAccountByAddressAndHeight(context.Context, string, int64) (*AccountInfo, error)
 	GetIdentityByHeight(context.Context, string, int64) (*Identity, error)
+	GetEpochSummaryByHeight(context.Context, int64) (*EpochSummary, error)
+	GetVotersByHeight(context.Context, int64) ([]string, error)
+	GetVoterRewardsByHeight(context.Context, int64, []string) ([]*VoterReward, error)
+	GetElectedValidatorsByHeight(context.Context, int64) ([]*ElectedValidator, error)
+	GetValidatorSignersByHeight(context.Context, int64) ([]string, error)
 }
 
 type requestCounter struct {
@@ -69,51 +84,71 @@ func (rc *requestCounter) GetCounter() uint64 {
 	return count
 }
 
+// client calls single node
 type client struct {
-	ccs []*kliento.CeloClient
+	celoClient *kliento.CeloClient
 
 	requestCounter *requestCounter
+	registryCache  *registryCache
 }
 
-func New(urls string) (*client, error) {
-	client := &client{
-		requestCounter: &requestCounter{},
-	}
+// New creates client which spreads calls among nodes of given space separated urls.
+// Health of nodes is checked before client is returned and calls fail over to another node when node fails
+func New(urls string, poolCfg NodePoolConfig) (*poolClient, error) {
+	rc := &requestCounter{}
+	cache := NewRegistryCache()
 
-	for _, url := range strings.Fields(urls) {
+	var nodes []*node
+	names := map[string]bool{}
+	for i, url := range strings.Fields(urls) {
 		cc, err := kliento.Dial(url)
 		if err != nil {
+			for _, n := range nodes {
+				n.client.Close()
+			}
 			return nil, err
 		}
 
-		client.ccs = append(client.ccs, cc)
+		name := nodeName(url, i)
+		if names[name] {
+			name = fmt.Sprintf("%s_%d", name, i)
+		}
+		names[name] = true
+
+		nodes = append(nodes, &node{
+			name: name,
+			client: &client{
+				celoClient:     cc,
+				requestCounter: rc,
+				registryCache:  cache,
+			},
+		})
+	}
+	if len(nodes) == 0 {
+		return nil, ErrNodeUrlRequired
 	}
 
-	return client, nil
+	pool := newNodePool(nodes, poolCfg)
+	pool.start()
+
+	return &poolClient{
+		pool:           pool,
+		assignedNode:   -1,
+		requestCounter: rc,
+	}, nil
 }
 
-func (l *client) WithAssignedNode(nodeIndex uint8) Client {
-	return &client{
-		ccs: []*kliento.CeloClient{
-			l.ccs[int(nodeIndex)%len(l.ccs)],
-		},
-		requestCounter: l.requestCounter,
-	}
+// WithAssignedNode returns the same client, because it calls single node
+func (l *client) WithAssignedNode(uint8) Client {
+	return l
 }
 
 func (l *client) cc() *kliento.CeloClient {
-	if len(l.ccs) == 1 {
-		return l.ccs[0]
-	} else {
-		rc := l.requestCounter.GetCounter()
-		return l.ccs[rc%uint64(len(l.ccs))]
-	}
+	return l.celoClient
 }
 
 func (l *client) Close() {
-	for _, cc := range l.ccs {
-		cc.Close()
-	}
+	l.celoClient.Close()
 }
 
 func (l *client) GetName() string {
@@ -124,6 +159,17 @@ func (l *client) GetRequestCounter() base.RequestCounter {
 	return l.requestCounter
 }
 
+// SubscribeNewHead subscribes to notifications about new chain heads. It requires websocket or IPC connection to node
+func (l *client) SubscribeNewHead(ctx context.Context, ch chan<- *celoTypes.Header) (ethereum.Subscription, error) {
+	sub, err := l.cc().Eth.SubscribeNewHead(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+
+	return sub, nil
+}
+
 func (l *client) GetChainStatus(ctx context.Context) (*ChainStatus, error) {
 	chainId, err := l.cc().Net.ChainId(ctx)
 	if err != nil {
@@ -156,7 +202,7 @@ func (l *client) GetChainParams(ctx context.Context) (*ChainParams, error) {
 	l.requestCounter.IncrementCounter()
 	chainParams.ChainId = chainId.Uint64()
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, nil)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -195,8 +241,10 @@ func (l *client) GetMetaByHeight(ctx context.Context, h int64) (*HeightMeta, err
 	}
 	l.requestCounter.IncrementCounter()
 	heightMeta.Time = rawBlock.Time()
+	heightMeta.Hash = rawBlock.Hash().String()
+	heightMeta.ParentHash = rawBlock.ParentHash().String()
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, height)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
 	if err != nil {
 		return nil, err
 	}
@@ -223,6 +271,8 @@ func (l *client) GetMetaByHeight(ctx context.Context, h int64) (*HeightMeta, err
 		l.requestCounter.IncrementCounter()
 		isLastInEpoch := istanbul.IsLastBlockOfEpoch(height.Uint64(), epochSize.Uint64())
 		heightMeta.LastInEpoch = &isLastInEpoch
+		isFirstInEpoch := istanbul.IsFirstBlockOfEpoch(height.Uint64(), epochSize.Uint64())
+		heightMeta.FirstInEpoch = &isFirstInEpoch
 	}
 
 	return heightMeta, setupErr
@@ -297,13 +347,19 @@ func (l *client) GetTransactionsByHeight(ctx context.Context, h int64) ([]*Trans
 		height = big.NewInt(h)
 	}
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, height)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
 	if err != nil {
 		return nil, err
 	}
 	setupErr := cr.setupContracts(ctx)
+	if setupErr == nil {
+		setupErr = cr.setupStableTokenContracts(ctx)
+	}
 
-	block, err := l.cc().Eth.BlockByNumber(ctx, height)
+	// Same node has to serve block and all its receipts and traces
+	cc := l.cc()
+
+	block, err := cc.Eth.BlockByNumber(ctx, height)
 	if err != nil {
 		return nil, err
 	}
@@ -311,28 +367,31 @@ func (l *client) GetTransactionsByHeight(ctx context.Context, h int64) ([]*Trans
 
 	rawTransactions := block.Transactions()
 
-	var transactions []*Transaction
-	for _, tx := range rawTransactions {
-		txHash := tx.Hash()
+	txHashes := make([]common.Hash, len(rawTransactions))
+	for i, tx := range rawTransactions {
+		txHashes[i] = tx.Hash()
+	}
 
-		receipt, err := l.cc().Eth.TransactionReceipt(ctx, txHash)
-		if err != nil {
-			return nil, err
-		}
-		l.requestCounter.IncrementCounter()
+	receipts, err := l.getReceipts(ctx, cc, txHashes)
+	if err != nil {
+		return nil, err
+	}
 
-		var operations []*Operation
+	// Internal transfers
+	internalTransfers, err := l.getTransfers(ctx, cc, txHashes)
+	if err != nil {
+		return nil, fmt.Errorf("can't run celo-rpc tx-tracer: %w", err)
+	}
 
-		// Internal transfers
-		internalTransfers, err := l.cc().Debug.TransactionTransfers(ctx, txHash)
-		if err != nil {
-			return nil, fmt.Errorf("can't run celo-rpc tx-tracer: %w", err)
-		}
-		l.requestCounter.IncrementCounter()
-		operations = append(operations, l.parseFromInternalTransfers(internalTransfers)...)
+	var transactions []*Transaction
+	for i, tx := range rawTransactions {
+		receipt := receipts[i]
 
-		// Operations from logs
-		operationsFromLogs, err := l.parseFromLogs(cr, receipt.Logs)
+		var operations []*Operation
+		operations = append(operations, l.parseFromInternalTransfers(internalTransfers[i])...)
+
+		// Operations from logs. CELO moved by GoldToken transfer is traced as internal transfer already
+		operationsFromLogs, err := l.parseFromLogs(cr, receipt.Logs, len(internalTransfers[i]) > 0)
 		if err != nil {
 			return nil, err
 		}
@@ -359,6 +418,14 @@ func (l *client) GetTransactionsByHeight(ctx context.Context, h int64) ([]*Trans
 			transaction.To = tx.To().String()
 		}
 
+		if sender, err := celoTypes.Sender(celoTypes.NewEIP155Signer(tx.ChainId()), tx); err == nil {
+			transaction.Address = sender.String()
+		}
+
+		if tx.FeeCurrency() != nil {
+			transaction.FeeCurrency = tx.FeeCurrency().String()
+		}
+
 		if tx.GatewayFeeRecipient() != nil {
 			transaction.GatewayFeeRecipient = tx.GatewayFeeRecipient().String()
 		}
@@ -389,7 +456,8 @@ func (l *client) parseFromInternalTransfers(internalTransfers []debug.Transfer)
 	return operations
 }
 
-func (l *client) parseFromLogs(cr *contractsRegistry, logs []*celoTypes.Log) ([]*Operation, error) {
+// parseFromLogs maps events of known contracts to operations. GoldToken Transfer events are skipped when transfers of transaction were traced
+func (l *client) parseFromLogs(cr *contractsRegistry, logs []*celoTypes.Log, traced bool) ([]*Operation, error) {
 	var operations []*Operation
 	for _, eventLog := range logs {
 		if eventLog.Address == cr.addresses[registry.ElectionContractID] && cr.contractDeployed(registry.ElectionContractID) {
@@ -425,10 +493,12 @@ func (l *client) parseFromLogs(cr *contractsRegistry, logs []*celoTypes.Log) ([]
 				})
 			}
 
-		} else if eventLog.Address == cr.addresses[registry.StableTokenContractID] && cr.contractDeployed(registry.StableTokenContractID) {
-			eventName, eventRaw, _, err := cr.stableTokenContract.TryParseLog(*eventLog)
+		} else if token, ok := cr.stableTokens[eventLog.Address]; ok {
+			eventName, eventRaw, _, err := token.contract.TryParseLog(*eventLog)
 			if err != nil {
 				logger.Error(fmt.Errorf("can't parse StableToken event: %w", err))
+			} else if transfer, ok := eventRaw.(*contracts.StableTokenTransfer); ok {
+				operations = append(operations, toTokenTransferOperation(token.symbol, eventLog.Address, transfer.From, transfer.To, transfer.Value))
 			} else {
 				operations = append(operations, &Operation{
 					Name:    eventName,
@@ -440,6 +510,10 @@ func (l *client) parseFromLogs(cr *contractsRegistry, logs []*celoTypes.Log) ([]
 			eventName, eventRaw, _, err := cr.goldTokenContract.TryParseLog(*eventLog)
 			if err != nil {
 				logger.Error(fmt.Errorf("can't parse GoldToken event: %w", err))
+			} else if transfer, ok := eventRaw.(*contracts.GoldTokenTransfer); ok {
+				if !traced {
+					operations = append(operations, toTokenTransferOperation(GoldTokenSymbol, eventLog.Address, transfer.From, transfer.To, transfer.Value))
+				}
 			} else {
 				operations = append(operations, &Operation{
 					Name:    eventName,
@@ -475,6 +549,20 @@ func (l *client) parseFromLogs(cr *contractsRegistry, logs []*celoTypes.Log) ([]
 	return operations, nil
 }
 
+// toTokenTransferOperation maps Transfer event of token contract to operation which is the same for all tokens
+func toTokenTransferOperation(symbol string, tokenAddress common.Address, from common.Address, to common.Address, value *big.Int) *Operation {
+	return &Operation{
+		Name: OperationTypeTokenTransfer,
+		Details: &TokenTransfer{
+			Token:        symbol,
+			TokenAddress: tokenAddress.String(),
+			From:         from.String(),
+			To:           to.String(),
+			Value:        value,
+		},
+	}
+}
+
 func (l *client) GetValidatorGroupsByHeight(ctx context.Context, h int64) ([]*ValidatorGroup, error) {
 	var height *big.Int
 	if h == 0 {
@@ -483,7 +571,7 @@ func (l *client) GetValidatorGroupsByHeight(ctx context.Context, h int64) ([]*Va
 		height = big.NewInt(h)
 	}
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, height)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
 	if err != nil {
 		return nil, err
 	}
@@ -564,11 +652,11 @@ func (l *client) GetValidatorsByHeight(ctx context.Context, h int64) ([]*Validat
 		height = big.NewInt(h)
 	}
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, height)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
 	if err != nil {
 		return nil, err
 	}
-	err = cr.setupContracts(ctx, registry.ValidatorsContractID, registry.ElectionContractID)
+	err = cr.setupContracts(ctx, registry.ValidatorsContractID)
 	if err != nil {
 		return nil, err
 	}
@@ -582,11 +670,6 @@ func (l *client) GetValidatorsByHeight(ctx context.Context, h int64) ([]*Validat
 	}
 	l.requestCounter.IncrementCounter()
 
-	validationMap, err := l.getValidationMap(ctx, cr, height)
-	if err != nil {
-		return nil, err
-	}
-
 	for _, rawValidator := range rawValidators {
 		opts := &bind.CallOpts{Context: ctx}
 		validatorDetails, err := cr.validatorsContract.GetValidator(opts, rawValidator)
@@ -604,56 +687,12 @@ func (l *client) GetValidatorsByHeight(ctx context.Context, h int64) ([]*Validat
 			Score:          validatorDetails.Score,
 		}
 
-		signed, ok := validationMap[validatorDetails.Signer.String()]
-		if ok {
-			validator.Signed = &signed
-		}
-
 		validators = append(validators, validator)
 	}
 
 	return validators, nil
 }
 
-func (l *client) getValidationMap(ctx context.Context, cr *contractsRegistry, height *big.Int) (map[string]bool, error) {
-	validationMap := map[string]bool{}
-
-	if cr.electionContract != nil {
-		opts := &bind.CallOpts{Context: ctx}
-		currentValidatorSigners, err := cr.electionContract.GetCurrentValidatorSigners(opts)
-		if err != nil {
-			return nil, err
-		}
-		l.requestCounter.IncrementCounter()
-
-		nextHeight := big.NewInt(0)
-		nextHeight.Add(height, big.NewInt(1))
-		nextBlockHeader, err := l.cc().Eth.HeaderByNumber(ctx, nextHeight)
-		if err != nil {
-			return nil, err
-		}
-		l.requestCounter.IncrementCounter()
-
-		extra, err := celoTypes.ExtractIstanbulExtra(nextBlockHeader)
-		if err != nil {
-			return nil, err
-		}
-
-		for i := 0; i < len(currentValidatorSigners); i++ {
-			signer := currentValidatorSigners[uint64(i)]
-			signed := false
-
-			if extra.ParentAggregatedSeal.Bitmap.Bit(i) == 1 {
-				signed = true
-			}
-
-			validationMap[signer.String()] = signed
-		}
-	}
-
-	return validationMap, nil
-}
-
 func (l *client) GetAccountByAddressAndHeight(ctx context.Context, rawAddress string, h int64) (*AccountInfo, error) {
 	var height *big.Int
 	if h == 0 {
@@ -662,7 +701,7 @@ func (l *client) GetAccountByAddressAndHeight(ctx context.Context, rawAddress st
 		height = big.NewInt(h)
 	}
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, height)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
 	if err != nil {
 		return nil, err
 	}
@@ -725,7 +764,7 @@ func (l *client) GetIdentityByHeight(ctx context.Context, rawAddress string, h i
 		height = big.NewInt(h)
 	}
 
-	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, height)
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
 	if err != nil {
 		return nil, err
 	}
@@ -779,3 +818,323 @@ func (l *client) getIdentity(ctx context.Context, cr *contractsRegistry, rawAddr
 
 	return identity, nil
 }
+
+// GetEpochSummaryByHeight gets target rewards of epoch and votes at given height. Rewards are distributed at last height of epoch
+func (l *client) GetEpochSummaryByHeight(ctx context.Context, h int64) (*EpochSummary, error) {
+	var height *big.Int
+	if h == 0 {
+		height = nil
+	} else {
+		height = big.NewInt(h)
+	}
+
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
+	if err != nil {
+		return nil, err
+	}
+	setupErr := cr.setupContracts(ctx, registry.EpochRewardsContractID, registry.ElectionContractID)
+
+	if !cr.contractDeployed(registry.EpochRewardsContractID) || !cr.contractDeployed(registry.ElectionContractID) {
+		return nil, setupErr
+	}
+
+	epochSummary := &EpochSummary{}
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	targetVotingYield, _, _, err := cr.epochRewardsContract.GetTargetVotingYieldParameters(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+	epochSummary.TargetVotingYield = targetVotingYield
+
+	opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+	rewardsMultiplier, err := cr.epochRewardsContract.GetRewardsMultiplier(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+	epochSummary.RewardsMultiplier = rewardsMultiplier
+
+	opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+	electedValidatorsCount, err := cr.epochRewardsContract.NumberValidatorsInCurrentSet(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+	epochSummary.ElectedValidatorsCount = electedValidatorsCount.Int64()
+
+	opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+	validatorEpochPayment, voterRewards, communityFundRewards, carbonFundRewards, err := cr.epochRewardsContract.CalculateTargetEpochRewards(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+	epochSummary.ValidatorRewards = new(big.Int).Mul(validatorEpochPayment, electedValidatorsCount)
+	epochSummary.VoterRewards = voterRewards
+	epochSummary.CommunityFundRewards = communityFundRewards
+	epochSummary.CarbonFundRewards = carbonFundRewards
+
+	opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+	totalVotes, err := cr.electionContract.GetTotalVotes(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+	epochSummary.TotalVotes = totalVotes
+
+	return epochSummary, setupErr
+}
+
+// GetVotersByHeight gets accounts which activated votes for validator groups up to given height, including those which revoked them later.
+// Election contract does not list voters, so they are read from its ValidatorGroupVoteActivated events
+func (l *client) GetVotersByHeight(ctx context.Context, h int64) ([]string, error) {
+	if h <= 0 {
+		return nil, ErrHeightRequired
+	}
+	height := big.NewInt(h)
+
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
+	if err != nil {
+		return nil, err
+	}
+	setupErr := cr.setupContracts(ctx, registry.ElectionContractID)
+
+	if !cr.contractDeployed(registry.ElectionContractID) {
+		return nil, setupErr
+	}
+
+	end := uint64(h)
+	opts := &bind.FilterOpts{Context: ctx, End: &end}
+	it, err := cr.electionContract.FilterValidatorGroupVoteActivated(opts, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	l.requestCounter.IncrementCounter()
+
+	var voters []string
+	seen := map[common.Address]bool{}
+	for it.Next() {
+		account := it.Event.Account
+		if seen[account] {
+			continue
+		}
+		seen[account] = true
+		voters = append(voters, account.String())
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	return voters, setupErr
+}
+
+// GetVoterRewardsByHeight gets rewards of given voters distributed at given height. Rewards are not emitted as events,
+// they increase value of active vote units of group instead. Reward of voter is its units valued after the height minus the same units valued before it
+func (l *client) GetVoterRewardsByHeight(ctx context.Context, h int64, rawAccounts []string) ([]*VoterReward, error) {
+	if h <= 0 {
+		return nil, ErrHeightRequired
+	}
+	height := big.NewInt(h)
+	prevHeight := big.NewInt(h - 1)
+
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
+	if err != nil {
+		return nil, err
+	}
+	setupErr := cr.setupContracts(ctx, registry.ElectionContractID)
+
+	if !cr.contractDeployed(registry.ElectionContractID) {
+		return nil, setupErr
+	}
+
+	groupUnitValues := map[common.Address]*groupUnitValue{}
+	getGroupUnitValue := func(group common.Address) (*groupUnitValue, error) {
+		if v, ok := groupUnitValues[group]; ok {
+			return v, nil
+		}
+
+		v := &groupUnitValue{}
+		var err error
+		if v.votesBefore, v.unitsBefore, err = l.getGroupActiveVotes(ctx, cr, group, prevHeight); err != nil {
+			return nil, err
+		}
+		if v.votesAfter, v.unitsAfter, err = l.getGroupActiveVotes(ctx, cr, group, height); err != nil {
+			return nil, err
+		}
+
+		groupUnitValues[group] = v
+		return v, nil
+	}
+
+	var voterRewards []*VoterReward
+	for _, rawAccount := range rawAccounts {
+		account := common.HexToAddress(rawAccount)
+
+		opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+		groups, err := cr.electionContract.GetGroupsVotedForByAccount(opts, account)
+		if err != nil {
+			return nil, err
+		}
+		l.requestCounter.IncrementCounter()
+
+		for _, group := range groups {
+			opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+			units, err := cr.electionContract.GetActiveVoteUnitsForGroupByAccount(opts, group, account)
+			if err != nil {
+				return nil, err
+			}
+			l.requestCounter.IncrementCounter()
+
+			if units.Sign() == 0 {
+				continue
+			}
+
+			v, err := getGroupUnitValue(group)
+			if err != nil {
+				return nil, err
+			}
+
+			activeVotes := v.valueAfter(units)
+			voterRewards = append(voterRewards, &VoterReward{
+				Account:     account.String(),
+				Group:       group.String(),
+				Reward:      new(big.Int).Sub(activeVotes, v.valueBefore(units)),
+				ActiveVotes: activeVotes,
+			})
+		}
+	}
+
+	return voterRewards, setupErr
+}
+
+// GetElectedValidatorsByHeight gets validators elected for epoch of given height together with groups they were elected from.
+// Elected set is fixed for the whole epoch, so it is meant to be called at first height of epoch
+func (l *client) GetElectedValidatorsByHeight(ctx context.Context, h int64) ([]*ElectedValidator, error) {
+	if h <= 0 {
+		return nil, ErrHeightRequired
+	}
+	height := big.NewInt(h)
+
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
+	if err != nil {
+		return nil, err
+	}
+	setupErr := cr.setupContracts(ctx, registry.ElectionContractID, registry.AccountsContractID, registry.ValidatorsContractID)
+
+	if !cr.contractDeployed(registry.ElectionContractID) || !cr.contractDeployed(registry.AccountsContractID) || !cr.contractDeployed(registry.ValidatorsContractID) {
+		return nil, setupErr
+	}
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	signers, err := cr.electionContract.GetCurrentValidatorSigners(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+
+	var electedValidators []*ElectedValidator
+	for i, signer := range signers {
+		opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+		account, err := cr.accountsContract.SignerToAccount(opts, signer)
+		if err != nil {
+			return nil, err
+		}
+		l.requestCounter.IncrementCounter()
+
+		// Membership in last epoch is the group validator belonged to when election took place
+		opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+		group, err := cr.validatorsContract.GetMembershipInLastEpoch(opts, account)
+		if err != nil {
+			return nil, err
+		}
+		l.requestCounter.IncrementCounter()
+
+		electedValidators = append(electedValidators, &ElectedValidator{
+			Address: account.String(),
+			Signer:  signer.String(),
+			Group:   group.String(),
+			Index:   int64(i),
+		})
+	}
+
+	return electedValidators, setupErr
+}
+
+// GetValidatorSignersByHeight gets signers of validators elected for epoch of given height.
+// Signers are ordered as bits of aggregated seal bitmap of blocks in the epoch
+func (l *client) GetValidatorSignersByHeight(ctx context.Context, h int64) ([]string, error) {
+	if h <= 0 {
+		return nil, ErrHeightRequired
+	}
+	height := big.NewInt(h)
+
+	cr, err := NewContractsRegistry(l.cc(), l.requestCounter, l.registryCache, height)
+	if err != nil {
+		return nil, err
+	}
+	setupErr := cr.setupContracts(ctx, registry.ElectionContractID)
+
+	if !cr.contractDeployed(registry.ElectionContractID) {
+		return nil, setupErr
+	}
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	rawSigners, err := cr.electionContract.GetCurrentValidatorSigners(opts)
+	if err != nil {
+		return nil, err
+	}
+	l.requestCounter.IncrementCounter()
+
+	var signers []string
+	for _, rawSigner := range rawSigners {
+		signers = append(signers, rawSigner.String())
+	}
+
+	return signers, setupErr
+}
+
+// getGroupActiveVotes gets active votes of group and number of units they are split into at given height
+func (l *client) getGroupActiveVotes(ctx context.Context, cr *contractsRegistry, group common.Address, height *big.Int) (*big.Int, *big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	votes, err := cr.electionContract.GetActiveVotesForGroup(opts, group)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.requestCounter.IncrementCounter()
+
+	opts = &bind.CallOpts{Context: ctx, BlockNumber: height}
+	units, err := cr.electionContract.GetActiveVoteUnitsForGroup(opts, group)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.requestCounter.IncrementCounter()
+
+	return votes, units, nil
+}
+
+// groupUnitValue holds active votes and vote units of group before and after height
+type groupUnitValue struct {
+	votesBefore *big.Int
+	unitsBefore *big.Int
+	votesAfter  *big.Int
+	unitsAfter  *big.Int
+}
+
+func (v *groupUnitValue) valueBefore(units *big.Int) *big.Int {
+	return unitsValue(units, v.votesBefore, v.unitsBefore)
+}
+
+func (v *groupUnitValue) valueAfter(units *big.Int) *big.Int {
+	return unitsValue(units, v.votesAfter, v.unitsAfter)
+}
+
+// unitsValue values units the same way as Election contract: units * total votes / total units
+func unitsValue(units *big.Int, totalVotes *big.Int, totalUnits *big.Int) *big.Int {
+	if totalUnits.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	value := new(big.Int).Mul(units, totalVotes)
+	return value.Div(value, totalUnits)
+}
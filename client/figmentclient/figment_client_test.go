@@ -0,0 +1,141 @@
+package figmentclient
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/celo-org/kliento/contracts"
+	"github.com/celo-org/kliento/registry"
+	"github.com/ethereum/go-ethereum/common"
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestGroupUnitValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description    string
+		value          groupUnitValue
+		units          int64
+		expectedBefore int64
+		expectedAfter  int64
+	}{
+		{
+			description:    "values units by share of group votes",
+			value:          groupUnitValue{big.NewInt(1000), big.NewInt(100), big.NewInt(1100), big.NewInt(100)},
+			units:          10,
+			expectedBefore: 100,
+			expectedAfter:  110,
+		},
+		{
+			description:    "keeps reward when votes are activated at the same height",
+			value:          groupUnitValue{big.NewInt(1000), big.NewInt(100), big.NewInt(2200), big.NewInt(200)},
+			units:          10,
+			expectedBefore: 100,
+			expectedAfter:  110,
+		},
+		{
+			description:    "rounds value down",
+			value:          groupUnitValue{big.NewInt(1000), big.NewInt(300), big.NewInt(1001), big.NewInt(300)},
+			units:          1,
+			expectedBefore: 3,
+			expectedAfter:  3,
+		},
+		{
+			description:    "returns zero value for group without units",
+			value:          groupUnitValue{big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+			units:          10,
+			expectedBefore: 0,
+			expectedAfter:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			units := big.NewInt(tt.units)
+			if before := tt.value.valueBefore(units); before.Int64() != tt.expectedBefore {
+				t.Errorf("unexpected value before, want %d; got %s", tt.expectedBefore, before)
+			}
+			if after := tt.value.valueAfter(units); after.Int64() != tt.expectedAfter {
+				t.Errorf("unexpected value after, want %d; got %s", tt.expectedAfter, after)
+			}
+		})
+	}
+}
+
+func TestClient_parseFromLogs(t *testing.T) {
+	t.Parallel()
+
+	goldTokenAddress := common.HexToAddress("0x471EcE3750Da237f93B8E339c536989b8978a438")
+	stableTokenAddress := common.HexToAddress("0x765DE816845861e75A25fCA122bb6898B8B1282a")
+	stableTokenEURAddress := common.HexToAddress("0xD8763CBa276a3738E6DE85b4b3bF5FDed6D6cA73")
+	from := common.HexToAddress("0x1b6C43d2E9F0eFEa96ecAB6cb3DBC1A8e7Af0E6a")
+	to := common.HexToAddress("0x9C4e1A2B3c5d6E7F8a9B0C1D2E3f4a5b6C7d8e9f")
+
+	transferLog := func(address common.Address) *celoTypes.Log {
+		return &celoTypes.Log{
+			Address: address,
+			Topics: []common.Hash{
+				crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(big.NewInt(100)).Bytes(),
+		}
+	}
+
+	tests := []struct {
+		description    string
+		logs           []*celoTypes.Log
+		traced         bool
+		expectedTokens []string
+	}{
+		{"maps transfers of all stable tokens", []*celoTypes.Log{transferLog(stableTokenAddress), transferLog(stableTokenEURAddress)}, false, []string{"cUSD", "cEUR"}},
+		{"maps GoldToken transfers of transaction without traced transfers", []*celoTypes.Log{transferLog(goldTokenAddress)}, false, []string{"CELO"}},
+		{"skips GoldToken transfers of transaction with traced transfers", []*celoTypes.Log{transferLog(goldTokenAddress), transferLog(stableTokenAddress)}, true, []string{"cUSD"}},
+		{"skips transfers of unknown tokens", []*celoTypes.Log{transferLog(from)}, false, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			goldToken, _ := contracts.NewGoldToken(goldTokenAddress, nil)
+			stableTokenUSD, _ := contracts.NewStableToken(stableTokenAddress, nil)
+			stableTokenEUR, _ := contracts.NewStableToken(stableTokenEURAddress, nil)
+			cr := &contractsRegistry{
+				addresses: map[registry.ContractID]common.Address{
+					registry.GoldTokenContractID: goldTokenAddress,
+				},
+				stableTokens: map[common.Address]*stableToken{
+					stableTokenAddress:    {contract: stableTokenUSD, symbol: "cUSD"},
+					stableTokenEURAddress: {contract: stableTokenEUR, symbol: "cEUR"},
+				},
+				goldTokenContract: goldToken,
+			}
+
+			operations, err := (&client{}).parseFromLogs(cr, tt.logs, tt.traced)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(operations) != len(tt.expectedTokens) {
+				t.Fatalf("unexpected operations count, want %d; got %d", len(tt.expectedTokens), len(operations))
+			}
+			for i, operation := range operations {
+				transfer, ok := operation.Details.(*TokenTransfer)
+				if operation.Name != OperationTypeTokenTransfer || !ok {
+					t.Fatalf("unexpected operation: %+v", operation)
+				}
+				if transfer.Token != tt.expectedTokens[i] || transfer.From != from.String() || transfer.To != to.String() || transfer.Value.Int64() != 100 {
+					t.Errorf("unexpected token transfer: %+v", transfer)
+				}
+			}
+		})
+	}
+}
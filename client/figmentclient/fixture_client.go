@@ -0,0 +1,20 @@
+package figmentclient
+
+// NewFixtureRecorder creates client which stores raw responses of given client as JSON fixtures in given directory
+func NewFixtureRecorder(c Client, dir string) (Client, error) {
+	recorder, err := newArchiveClient(c, NewFixtureArchive(dir))
+	if err != nil {
+		return nil, err
+	}
+	return recorder, nil
+}
+
+// NewFixtureClient creates client which serves JSON fixtures recorded with fixture recorder without connecting to node.
+// It is meant for deterministic tests of indexing pipeline
+func NewFixtureClient(dir string) (Client, error) {
+	c, err := newReplayClient(NewFixtureArchive(dir))
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,304 @@
+package figmentclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	base "github.com/figment-networks/celo-indexer/client"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/metrics"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+// NodePoolConfig holds health check settings of node pool
+type NodePoolConfig struct {
+	// HealthCheckInterval is an interval of background health checks. Health is checked only once when it is not set
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+	// MaxLag is a number of blocks by which node can be behind the most recent node before it is removed from pool
+	MaxLag int64
+}
+
+// NewNodePoolConfig creates node pool config from application config
+func NewNodePoolConfig(cfg *config.Config) (NodePoolConfig, error) {
+	interval, err := time.ParseDuration(cfg.NodeHealthCheckInterval)
+	if err != nil {
+		return NodePoolConfig{}, err
+	}
+	timeout, err := time.ParseDuration(cfg.NodeHealthCheckTimeout)
+	if err != nil {
+		return NodePoolConfig{}, err
+	}
+
+	return NodePoolConfig{
+		HealthCheckInterval: interval,
+		HealthCheckTimeout:  timeout,
+		MaxLag:              cfg.NodeMaxLag,
+	}, nil
+}
+
+// NodeHealth is a result of the most recent health check of node
+type NodeHealth struct {
+	Node      string    `json:"node"`
+	Healthy   bool      `json:"healthy"`
+	ChainId   uint64    `json:"chain_id,omitempty"`
+	Height    int64     `json:"height,omitempty"`
+	Lag       int64     `json:"lag"`
+	LatencyMs int64     `json:"latency_ms"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+type node struct {
+	name   string
+	client Client
+
+	mu     sync.RWMutex
+	health NodeHealth
+}
+
+func (n *node) getHealth() NodeHealth {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.health
+}
+
+func (n *node) setHealth(health NodeHealth) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.health.Healthy != health.Healthy {
+		logger.Info(fmt.Sprintf("node health changed [node=%s] [healthy=%t] [err=%s]", n.name, health.Healthy, health.Error))
+	}
+	n.health = health
+
+	healthy := 0.0
+	if health.Healthy {
+		healthy = 1
+	}
+	metrics.ClientNodeHealthy.WithLabels(n.name).Set(healthy)
+}
+
+// markUnhealthy removes node from pool until its next successful health check
+func (n *node) markUnhealthy(err error) {
+	health := n.getHealth()
+	health.Healthy = false
+	health.Error = err.Error()
+	n.setHealth(health)
+}
+
+func newNodePool(nodes []*node, cfg NodePoolConfig) *nodePool {
+	return &nodePool{
+		nodes: nodes,
+		cfg:   cfg,
+		stop:  make(chan struct{}),
+	}
+}
+
+// nodePool spreads calls among healthy nodes and fails over to other nodes when node fails
+type nodePool struct {
+	nodes []*node
+	cfg   NodePoolConfig
+
+	next     uint64
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// start checks health of nodes and keeps checking it in background
+func (p *nodePool) start() {
+	p.check(context.Background())
+
+	if p.cfg.HealthCheckInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				p.check(context.Background())
+			}
+		}
+	}()
+}
+
+func (p *nodePool) close() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+	})
+	for _, n := range p.nodes {
+		n.client.Close()
+	}
+}
+
+// check checks health of all nodes. Node is healthy when it responds, is on the same chain as most of nodes
+// and it is not behind the most recent node by more than max lag
+func (p *nodePool) check(ctx context.Context) {
+	results := make([]NodeHealth, len(p.nodes))
+
+	var wg sync.WaitGroup
+	for i, n := range p.nodes {
+		wg.Add(1)
+		go func(i int, n *node) {
+			defer wg.Done()
+			results[i] = p.checkNode(ctx, n)
+		}(i, n)
+	}
+	wg.Wait()
+
+	chainId := expectedChainId(results)
+
+	var maxHeight int64
+	for _, result := range results {
+		if result.Error == "" && result.ChainId == chainId && result.Height > maxHeight {
+			maxHeight = result.Height
+		}
+	}
+
+	for i, result := range results {
+		if result.Error == "" {
+			result.Lag = maxHeight - result.Height
+
+			if result.ChainId != chainId {
+				result.Error = fmt.Sprintf("unexpected chain id %d (most of nodes are on chain %d)", result.ChainId, chainId)
+			} else if p.cfg.MaxLag > 0 && result.Lag > p.cfg.MaxLag {
+				result.Error = fmt.Sprintf("node is %d blocks behind", result.Lag)
+			}
+		}
+		result.Healthy = result.Error == ""
+
+		p.nodes[i].setHealth(result)
+	}
+}
+
+func (p *nodePool) checkNode(ctx context.Context, n *node) NodeHealth {
+	if p.cfg.HealthCheckTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.cfg.HealthCheckTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	status, err := n.client.GetChainStatus(ctx)
+	latency := time.Since(start)
+
+	health := NodeHealth{
+		Node:      n.name,
+		LatencyMs: latency.Milliseconds(),
+		CheckedAt: time.Now(),
+	}
+	metrics.ClientNodeLatency.WithLabels(n.name).Set(latency.Seconds())
+
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+
+	health.ChainId = status.ChainId
+	health.Height = status.LastBlockHeight
+	metrics.ClientNodeHeight.WithLabels(n.name).Set(float64(status.LastBlockHeight))
+	return health
+}
+
+// health returns results of the most recent health checks of all nodes
+func (p *nodePool) health() []NodeHealth {
+	health := make([]NodeHealth, len(p.nodes))
+	for i, n := range p.nodes {
+		health[i] = n.getHealth()
+	}
+	return health
+}
+
+// candidates returns nodes in order in which they should be called. Healthy nodes go first starting from given offset,
+// so calls are spread among them. Unhealthy nodes are called only when all healthy nodes failed
+func (p *nodePool) candidates(offset int) []*node {
+	var healthy, unhealthy []*node
+	for _, n := range p.nodes {
+		if n.getHealth().Healthy {
+			healthy = append(healthy, n)
+		} else {
+			unhealthy = append(unhealthy, n)
+		}
+	}
+
+	candidates := make([]*node, 0, len(p.nodes))
+	for i := range healthy {
+		candidates = append(candidates, healthy[(offset+i)%len(healthy)])
+	}
+	return append(candidates, unhealthy...)
+}
+
+// do calls fn with clients of nodes until it succeeds or fails with error which would not change on another node.
+// Calls of client assigned to node prefer the same healthy node, other calls are spread among healthy nodes
+func (p *nodePool) do(ctx context.Context, assignedNode int, fn func(Client) error) error {
+	offset := assignedNode
+	if offset < 0 {
+		offset = int(atomic.AddUint64(&p.next, 1) % uint64(len(p.nodes)))
+	}
+
+	var err error
+	for i, n := range p.candidates(offset) {
+		if i > 0 {
+			metrics.ClientNodeFailovers.WithLabels(n.name).Inc()
+		}
+
+		err = fn(n.client)
+
+		kind := ErrorKindOf(err)
+		if err == nil {
+			metrics.ClientNodeCalls.WithLabels(n.name, "ok").Inc()
+			return nil
+		}
+		metrics.ClientNodeCalls.WithLabels(n.name, string(kind)).Inc()
+
+		if !isFailoverable(kind) || ctx.Err() != nil {
+			return err
+		}
+		if kind == base.ErrorKindTransient {
+			n.markUnhealthy(err)
+		}
+		logger.Info(fmt.Sprintf("node call failed [node=%s] [kind=%s] [err=%v]", n.name, kind, err))
+	}
+	return err
+}
+
+// isFailoverable returns true for errors which can succeed on another node. Node which pruned state of height
+// is not unhealthy, but another (archive) node can still have it
+func isFailoverable(kind base.ErrorKind) bool {
+	return kind == base.ErrorKindTransient || kind == base.ErrorKindStatePruned
+}
+
+// expectedChainId returns chain id reported by most of responding nodes
+func expectedChainId(results []NodeHealth) uint64 {
+	counts := map[uint64]int{}
+	var chainId uint64
+	for _, result := range results {
+		if result.Error != "" {
+			continue
+		}
+		counts[result.ChainId]++
+		if counts[result.ChainId] > counts[chainId] {
+			chainId = result.ChainId
+		}
+	}
+	return chainId
+}
+
+// nodeName returns host of node url, so credentials in url are not exposed in metrics and status
+func nodeName(rawUrl string, index int) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return fmt.Sprintf("node_%d", index)
+	}
+	return u.Host
+}
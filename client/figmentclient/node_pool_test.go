@@ -0,0 +1,182 @@
+package figmentclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+// fakeNodeClient responds to health checks with given status and fails block requests with given error
+type fakeNodeClient struct {
+	Client
+
+	status    *ChainStatus
+	statusErr error
+	blockErr  error
+
+	blockCalls int
+}
+
+func (c *fakeNodeClient) Close() {}
+
+func (c *fakeNodeClient) GetChainStatus(context.Context) (*ChainStatus, error) {
+	return c.status, c.statusErr
+}
+
+func (c *fakeNodeClient) GetBlockByHeight(_ context.Context, h int64) (*Block, error) {
+	c.blockCalls++
+	if c.blockErr != nil {
+		return nil, c.blockErr
+	}
+	return &Block{Height: h}, nil
+}
+
+func newFakeNodePool(cfg NodePoolConfig, clients ...*fakeNodeClient) *nodePool {
+	var nodes []*node
+	for i, c := range clients {
+		nodes = append(nodes, &node{name: nodeName("", i), client: c})
+	}
+	return newNodePool(nodes, cfg)
+}
+
+func TestNodePool_check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		clients       []*fakeNodeClient
+		expectHealthy []bool
+	}{
+		{
+			description: "all nodes are healthy",
+			clients: []*fakeNodeClient{
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 100}},
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 98}},
+			},
+			expectHealthy: []bool{true, true},
+		},
+		{
+			description: "node which does not respond is unhealthy",
+			clients: []*fakeNodeClient{
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 100}},
+				{statusErr: errors.New("test error")},
+			},
+			expectHealthy: []bool{true, false},
+		},
+		{
+			description: "lagging node is unhealthy",
+			clients: []*fakeNodeClient{
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 100}},
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 80}},
+			},
+			expectHealthy: []bool{true, false},
+		},
+		{
+			description: "node on another chain is unhealthy",
+			clients: []*fakeNodeClient{
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 100}},
+				{status: &ChainStatus{ChainId: 2, LastBlockHeight: 500}},
+				{status: &ChainStatus{ChainId: 1, LastBlockHeight: 100}},
+			},
+			expectHealthy: []bool{true, false, true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			pool := newFakeNodePool(NodePoolConfig{MaxLag: 10}, tt.clients...)
+			pool.check(context.Background())
+
+			for i, health := range pool.health() {
+				if health.Healthy != tt.expectHealthy[i] {
+					t.Errorf("unexpected health of node %d, want %t; got %+v", i, tt.expectHealthy[i], health)
+				}
+			}
+		})
+	}
+}
+
+func TestNodePool_do(t *testing.T) {
+	t.Parallel()
+
+	status := &ChainStatus{ChainId: 1, LastBlockHeight: 100}
+	transientErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+
+	t.Run("fails over to another node on transient error", func(t *testing.T) {
+		t.Parallel()
+
+		failing := &fakeNodeClient{status: status, blockErr: transientErr}
+		working := &fakeNodeClient{status: status}
+		pool := newFakeNodePool(NodePoolConfig{}, failing, working)
+		pool.check(context.Background())
+
+		c := &poolClient{pool: pool, assignedNode: 0}
+		block, err := c.GetBlockByHeight(context.Background(), 10)
+		if err != nil || block.Height != 10 {
+			t.Fatalf("unexpected result: %+v, %v", block, err)
+		}
+
+		if pool.health()[0].Healthy {
+			t.Errorf("failing node should be removed from pool")
+		}
+
+		// Failing node is not called again until it passes health check
+		if _, err := c.GetBlockByHeight(context.Background(), 11); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if failing.blockCalls != 1 || working.blockCalls != 2 {
+			t.Errorf("unexpected calls [failing=%d] [working=%d]", failing.blockCalls, working.blockCalls)
+		}
+	})
+
+	t.Run("does not fail over when error would not change", func(t *testing.T) {
+		t.Parallel()
+
+		first := &fakeNodeClient{status: status, blockErr: ErrContractNotDeployed}
+		second := &fakeNodeClient{status: status}
+		pool := newFakeNodePool(NodePoolConfig{}, first, second)
+		pool.check(context.Background())
+
+		c := &poolClient{pool: pool, assignedNode: 0}
+		if _, err := c.GetBlockByHeight(context.Background(), 10); err != ErrContractNotDeployed {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if second.blockCalls != 0 {
+			t.Errorf("second node should not be called")
+		}
+	})
+
+	t.Run("calls unhealthy nodes when there are no healthy nodes", func(t *testing.T) {
+		t.Parallel()
+
+		unhealthy := &fakeNodeClient{statusErr: errors.New("test error")}
+		pool := newFakeNodePool(NodePoolConfig{}, unhealthy)
+		pool.check(context.Background())
+
+		c := &poolClient{pool: pool, assignedNode: -1}
+		if _, err := c.GetBlockByHeight(context.Background(), 10); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("adds health of nodes to chain status", func(t *testing.T) {
+		t.Parallel()
+
+		healthy := &fakeNodeClient{status: &ChainStatus{ChainId: 1, LastBlockHeight: 100}}
+		pool := newFakeNodePool(NodePoolConfig{}, healthy, &fakeNodeClient{statusErr: errors.New("test error")})
+		pool.check(context.Background())
+
+		c := &poolClient{pool: pool, assignedNode: -1}
+		chainStatus, err := c.GetChainStatus(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(chainStatus.Nodes) != 2 || !chainStatus.Nodes[0].Healthy || chainStatus.Nodes[1].Healthy {
+			t.Errorf("unexpected nodes health: %+v", chainStatus.Nodes)
+		}
+	})
+}
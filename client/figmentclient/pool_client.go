@@ -0,0 +1,183 @@
+package figmentclient
+
+import (
+	"context"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+	base "github.com/figment-networks/celo-indexer/client"
+)
+
+var (
+	_ Client = (*poolClient)(nil)
+)
+
+// poolClient calls nodes of node pool. Every call is retried on another node when node fails with transient error
+type poolClient struct {
+	pool *nodePool
+	// assignedNode is an offset of preferred healthy node. Calls are spread among healthy nodes when it is negative
+	assignedNode int
+
+	requestCounter *requestCounter
+}
+
+func (l *poolClient) WithAssignedNode(nodeIndex uint8) Client {
+	return &poolClient{
+		pool:           l.pool,
+		assignedNode:   int(nodeIndex),
+		requestCounter: l.requestCounter,
+	}
+}
+
+func (l *poolClient) Close() {
+	l.pool.close()
+}
+
+func (l *poolClient) GetName() string {
+	return CeloClientFigment
+}
+
+func (l *poolClient) GetRequestCounter() base.RequestCounter {
+	return l.requestCounter
+}
+
+func (l *poolClient) SubscribeNewHead(ctx context.Context, ch chan<- *celoTypes.Header) (ethereum.Subscription, error) {
+	var res ethereum.Subscription
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.SubscribeNewHead(ctx, ch)
+		return err
+	})
+	return res, err
+}
+
+// GetChainStatus returns status of chain together with health of all nodes
+func (l *poolClient) GetChainStatus(ctx context.Context) (*ChainStatus, error) {
+	var res *ChainStatus
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetChainStatus(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.Nodes = l.pool.health()
+	return res, nil
+}
+
+func (l *poolClient) GetChainParams(ctx context.Context) (*ChainParams, error) {
+	var res *ChainParams
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetChainParams(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetMetaByHeight(ctx context.Context, h int64) (*HeightMeta, error) {
+	var res *HeightMeta
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetMetaByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetBlockByHeight(ctx context.Context, h int64) (*Block, error) {
+	var res *Block
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetBlockByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetTransactionsByHeight(ctx context.Context, h int64) ([]*Transaction, error) {
+	var res []*Transaction
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetTransactionsByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetValidatorGroupsByHeight(ctx context.Context, h int64) ([]*ValidatorGroup, error) {
+	var res []*ValidatorGroup
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetValidatorGroupsByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetValidatorsByHeight(ctx context.Context, h int64) ([]*Validator, error) {
+	var res []*Validator
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetValidatorsByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetAccountByAddressAndHeight(ctx context.Context, rawAddress string, h int64) (*AccountInfo, error) {
+	var res *AccountInfo
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetAccountByAddressAndHeight(ctx, rawAddress, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetIdentityByHeight(ctx context.Context, rawAddress string, h int64) (*Identity, error) {
+	var res *Identity
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetIdentityByHeight(ctx, rawAddress, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetEpochSummaryByHeight(ctx context.Context, h int64) (*EpochSummary, error) {
+	var res *EpochSummary
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetEpochSummaryByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetVotersByHeight(ctx context.Context, h int64) ([]string, error) {
+	var res []string
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetVotersByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetVoterRewardsByHeight(ctx context.Context, h int64, rawAccounts []string) ([]*VoterReward, error) {
+	var res []*VoterReward
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetVoterRewardsByHeight(ctx, h, rawAccounts)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetElectedValidatorsByHeight(ctx context.Context, h int64) ([]*ElectedValidator, error) {
+	var res []*ElectedValidator
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetElectedValidatorsByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
+
+func (l *poolClient) GetValidatorSignersByHeight(ctx context.Context, h int64) ([]string, error) {
+	var res []string
+	err := l.pool.do(ctx, l.assignedNode, func(c Client) (err error) {
+		res, err = c.GetValidatorSignersByHeight(ctx, h)
+		return err
+	})
+	return res, err
+}
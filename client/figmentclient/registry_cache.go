@@ -0,0 +1,232 @@
+package figmentclient
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/celo-org/kliento/registry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/figment-networks/celo-indexer/metrics"
+)
+
+const (
+	// maxRegistryCacheGap is the biggest number of heights checked for registry updates when cached range is extended
+	maxRegistryCacheGap = 10000
+	// maxRegistryCacheRanges is the number of height ranges after which the lowest range is dropped
+	maxRegistryCacheRanges = 100
+)
+
+// registryUpdatesFunc returns heights at which Registry contract emitted RegistryUpdated event within given heights (inclusive)
+type registryUpdatesFunc func(ctx context.Context, fromHeight int64, toHeight int64) ([]int64, error)
+
+// NewRegistryCache creates cache of contract addresses resolved through Registry
+func NewRegistryCache() *registryCache {
+	return &registryCache{}
+}
+
+// registryCache keeps contract addresses resolved through Registry for ranges of heights.
+// Registry did not emit RegistryUpdated event within any cached range (except for its first height),
+// so address resolved for one height of range is valid for all its heights
+type registryCache struct {
+	mu      sync.Mutex
+	ranges  []*registryCacheRange
+	symbols map[common.Address]string
+}
+
+type registryCacheRange struct {
+	fromHeight int64
+	toHeight   int64
+	addresses  map[registry.ContractID]common.Address
+}
+
+func (r *registryCacheRange) contains(h int64) bool {
+	return r.fromHeight <= h && h <= r.toHeight
+}
+
+// Get returns cached address of contract at given height.
+// Cached ranges next to height are extended to it when Registry was not updated in between
+func (c *registryCache) Get(ctx context.Context, h int64, contractID registry.ContractID, updates registryUpdatesFunc) (common.Address, bool) {
+	c.mu.Lock()
+	r := c.find(h)
+	prev, next := c.neighbours(h)
+	c.mu.Unlock()
+
+	if r == nil {
+		c.cover(ctx, h, prev, next, updates)
+
+		c.mu.Lock()
+		r = c.find(h)
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var address common.Address
+	var ok bool
+	if r != nil {
+		address, ok = r.addresses[contractID]
+	}
+
+	if ok {
+		metrics.ContractsRegistryCacheHit.WithLabels(contractID.String()).Inc()
+	} else {
+		metrics.ContractsRegistryCacheMiss.WithLabels(contractID.String()).Inc()
+	}
+	return address, ok
+}
+
+// Set caches address of contract at given height
+func (c *registryCache) Set(h int64, contractID registry.ContractID, address common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r := c.find(h)
+	if r == nil {
+		r = c.add(h, h)
+	}
+	r.addresses[contractID] = address
+}
+
+// GetSymbol returns cached symbol of token contract
+func (c *registryCache) GetSymbol(address common.Address) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	symbol, ok := c.symbols[address]
+	return symbol, ok
+}
+
+// SetSymbol caches symbol of token contract
+func (c *registryCache) SetSymbol(address common.Address, symbol string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.symbols == nil {
+		c.symbols = map[common.Address]string{}
+	}
+	c.symbols[address] = symbol
+}
+
+// cover makes sure that given height is within cached range
+func (c *registryCache) cover(ctx context.Context, h int64, prev *registryCacheRange, next *registryCacheRange, updates registryUpdatesFunc) {
+	fromHeight, toHeight := h, h
+
+	c.mu.Lock()
+	var prevToHeight, nextFromHeight int64
+	if prev != nil {
+		prevToHeight = prev.toHeight
+	}
+	if next != nil {
+		nextFromHeight = next.fromHeight
+	}
+	c.mu.Unlock()
+
+	if prev != nil && h-prevToHeight <= maxRegistryCacheGap {
+		updateHeights, err := updates(ctx, prevToHeight+1, h)
+		if err == nil {
+			if len(updateHeights) == 0 {
+				c.extend(prev, h, h)
+				return
+			}
+			// Heights since last update share addresses
+			fromHeight = updateHeights[len(updateHeights)-1]
+			c.extend(prev, updateHeights[0]-1, updateHeights[0]-1)
+		}
+	}
+
+	if next != nil && nextFromHeight-h <= maxRegistryCacheGap {
+		updateHeights, err := updates(ctx, h+1, nextFromHeight)
+		if err == nil {
+			if len(updateHeights) == 0 {
+				c.extend(next, fromHeight, fromHeight)
+				return
+			}
+			// Heights before first update share addresses
+			toHeight = updateHeights[0] - 1
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.find(h) == nil {
+		c.add(fromHeight, toHeight)
+	}
+}
+
+// extend grows range to given heights and merges it with ranges it overlaps
+func (c *registryCache) extend(r *registryCacheRange, fromHeight int64, toHeight int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if fromHeight < r.fromHeight {
+		r.fromHeight = fromHeight
+	}
+	if toHeight > r.toHeight {
+		r.toHeight = toHeight
+	}
+	c.merge()
+}
+
+func (c *registryCache) add(fromHeight int64, toHeight int64) *registryCacheRange {
+	if len(c.ranges) >= maxRegistryCacheRanges {
+		c.ranges = c.ranges[1:]
+	}
+
+	c.ranges = append(c.ranges, &registryCacheRange{
+		fromHeight: fromHeight,
+		toHeight:   toHeight,
+		addresses:  map[registry.ContractID]common.Address{},
+	})
+	c.merge()
+
+	return c.find(fromHeight)
+}
+
+// merge sorts ranges and joins overlapping ones. Overlapping ranges belong to the same period between registry updates
+func (c *registryCache) merge() {
+	sort.Slice(c.ranges, func(i, j int) bool {
+		return c.ranges[i].fromHeight < c.ranges[j].fromHeight
+	})
+
+	var merged []*registryCacheRange
+	for _, r := range c.ranges {
+		if len(merged) > 0 {
+			last := merged[len(merged)-1]
+			if r.fromHeight <= last.toHeight {
+				if r.toHeight > last.toHeight {
+					last.toHeight = r.toHeight
+				}
+				for contractID, address := range r.addresses {
+					last.addresses[contractID] = address
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	c.ranges = merged
+}
+
+func (c *registryCache) find(h int64) *registryCacheRange {
+	for _, r := range c.ranges {
+		if r.contains(h) {
+			return r
+		}
+	}
+	return nil
+}
+
+// neighbours returns closest ranges below and above given height
+func (c *registryCache) neighbours(h int64) (*registryCacheRange, *registryCacheRange) {
+	var prev, next *registryCacheRange
+	for _, r := range c.ranges {
+		if r.toHeight < h {
+			prev = r
+		} else if r.fromHeight > h && next == nil {
+			next = r
+		}
+	}
+	return prev, next
+}
@@ -0,0 +1,102 @@
+package figmentclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/celo-org/kliento/registry"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestRegistryCache_Get(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x1")
+
+	tests := []struct {
+		description   string
+		cachedHeight  int64
+		height        int64
+		updateHeights []int64
+		updatesErr    error
+		expectHit     bool
+		expectQueries int
+	}{
+		{"returns address cached for the same height", 10, 10, nil, nil, true, 0},
+		{"returns address when registry was not updated since cached height", 10, 15, nil, nil, true, 1},
+		{"returns address when registry was not updated before cached height", 10, 5, nil, nil, true, 1},
+		{"does not return address when registry was updated since cached height", 10, 15, []int64{12}, nil, false, 1},
+		{"does not return address when registry was updated before cached height", 10, 5, []int64{8}, nil, false, 1},
+		{"does not return address when registry updates cannot be checked", 10, 15, nil, errors.New("test error"), false, 1},
+		{"does not return address when cached height is too far", 10, 10 + maxRegistryCacheGap + 1, nil, nil, false, 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			var queries int
+			updates := func(ctx context.Context, fromHeight int64, toHeight int64) ([]int64, error) {
+				queries++
+				var heights []int64
+				for _, h := range tt.updateHeights {
+					if fromHeight <= h && h <= toHeight {
+						heights = append(heights, h)
+					}
+				}
+				return heights, tt.updatesErr
+			}
+
+			cache := NewRegistryCache()
+			cache.Set(tt.cachedHeight, registry.ValidatorsContractID, address)
+
+			got, ok := cache.Get(context.Background(), tt.height, registry.ValidatorsContractID, updates)
+			if ok != tt.expectHit {
+				t.Errorf("unexpected cache hit, want %v; got %v", tt.expectHit, ok)
+			}
+			if ok && got != address {
+				t.Errorf("unexpected address, want %v; got %v", address, got)
+			}
+			if queries != tt.expectQueries {
+				t.Errorf("unexpected registry updates queries count, want %d; got %d", tt.expectQueries, queries)
+			}
+
+			// Height is covered by cache now, so next lookup does not check registry updates again
+			cache.Get(context.Background(), tt.height, registry.ValidatorsContractID, updates)
+			if tt.updatesErr == nil && queries != tt.expectQueries {
+				t.Errorf("unexpected registry updates queries count after second lookup, want %d; got %d", tt.expectQueries, queries)
+			}
+		})
+	}
+}
+
+func TestRegistryCache_rangesAroundUpdate(t *testing.T) {
+	t.Parallel()
+
+	oldAddress := common.HexToAddress("0x1")
+	newAddress := common.HexToAddress("0x2")
+	updates := func(ctx context.Context, fromHeight int64, toHeight int64) ([]int64, error) {
+		if fromHeight <= 20 && 20 <= toHeight {
+			return []int64{20}, nil
+		}
+		return nil, nil
+	}
+
+	cache := NewRegistryCache()
+	cache.Set(10, registry.ElectionContractID, oldAddress)
+
+	if _, ok := cache.Get(context.Background(), 25, registry.ElectionContractID, updates); ok {
+		t.Fatalf("address should not be returned after registry update")
+	}
+	cache.Set(25, registry.ElectionContractID, newAddress)
+
+	expected := map[int64]common.Address{10: oldAddress, 19: oldAddress, 20: newAddress, 25: newAddress}
+	for h, address := range expected {
+		got, ok := cache.Get(context.Background(), h, registry.ElectionContractID, updates)
+		if !ok || got != address {
+			t.Errorf("unexpected address at height %d, want %v; got %v (hit: %v)", h, address, got, ok)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package figmentclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+	base "github.com/figment-networks/celo-indexer/client"
+	"github.com/pkg/errors"
+)
+
+const (
+	CeloClientReplay = "replay_celo_client"
+)
+
+var (
+	_ Client = (*replayClient)(nil)
+
+	ErrReplayNotSupported = errors.New("not supported in replay mode")
+)
+
+// NewReplayClient creates client which serves raw responses from archive directory without connecting to node
+func NewReplayClient(dir string) (*replayClient, error) {
+	return newReplayClient(NewArchive(dir))
+}
+
+func newReplayClient(a *archive) (*replayClient, error) {
+	info, err := os.Stat(a.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open archive directory")
+	}
+	if !info.IsDir() {
+		return nil, errors.New(fmt.Sprintf("archive path is not a directory [path=%s]", a.dir))
+	}
+
+	return &replayClient{
+		archive:        a,
+		requestCounter: &requestCounter{},
+	}, nil
+}
+
+// replayClient reads responses from archive. Partial responses are returned together with ErrContractNotDeployed
+// the same way they were returned by node client
+type replayClient struct {
+	archive *archive
+
+	requestCounter *requestCounter
+}
+
+func (l *replayClient) WithAssignedNode(uint8) Client {
+	return l
+}
+
+func (l *replayClient) Close() {}
+
+func (l *replayClient) GetName() string {
+	return CeloClientReplay
+}
+
+func (l *replayClient) GetRequestCounter() base.RequestCounter {
+	return l.requestCounter
+}
+
+func (l *replayClient) SubscribeNewHead(context.Context, chan<- *celoTypes.Header) (ethereum.Subscription, error) {
+	return nil, ErrReplayNotSupported
+}
+
+func (l *replayClient) GetChainStatus(ctx context.Context) (*ChainStatus, error) {
+	lastHeight, err := l.archive.lastHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := l.GetMetaByHeight(ctx, lastHeight)
+	if err != nil && err != ErrContractNotDeployed {
+		return nil, err
+	}
+
+	chainParams, err := l.GetChainParams(ctx)
+	if err != nil && err != ErrContractNotDeployed {
+		return nil, err
+	}
+
+	return &ChainStatus{
+		ChainId:         chainParams.ChainId,
+		LastBlockHeight: lastHeight,
+		LastBlockHash:   meta.Hash,
+	}, nil
+}
+
+func (l *replayClient) GetChainParams(context.Context) (*ChainParams, error) {
+	var res *ChainParams
+	return res, l.load(0, archiveKindChainParams, &res)
+}
+
+func (l *replayClient) GetMetaByHeight(_ context.Context, h int64) (*HeightMeta, error) {
+	var res *HeightMeta
+	return res, l.load(h, archiveKindMeta, &res)
+}
+
+func (l *replayClient) GetBlockByHeight(_ context.Context, h int64) (*Block, error) {
+	var res *Block
+	return res, l.load(h, archiveKindBlock, &res)
+}
+
+func (l *replayClient) GetTransactionsByHeight(_ context.Context, h int64) ([]*Transaction, error) {
+	h, err := l.height(h)
+	if err != nil {
+		return nil, err
+	}
+
+	res, partial, err := l.archive.readTransactions(h)
+	if err != nil {
+		return nil, l.wrapErr(err, archiveKindTransactions, h)
+	}
+	l.requestCounter.IncrementCounter()
+
+	if partial {
+		return res, ErrContractNotDeployed
+	}
+	return res, nil
+}
+
+func (l *replayClient) GetValidatorGroupsByHeight(_ context.Context, h int64) ([]*ValidatorGroup, error) {
+	var res []*ValidatorGroup
+	return res, l.load(h, archiveKindValidatorGroups, &res)
+}
+
+func (l *replayClient) GetValidatorsByHeight(_ context.Context, h int64) ([]*Validator, error) {
+	var res []*Validator
+	return res, l.load(h, archiveKindValidators, &res)
+}
+
+func (l *replayClient) GetAccountByAddressAndHeight(context.Context, string, int64) (*AccountInfo, error) {
+	return nil, ErrNotArchived
+}
+
+func (l *replayClient) GetIdentityByHeight(_ context.Context, rawAddress string, h int64) (*Identity, error) {
+	var res *Identity
+	return res, l.load(h, archiveKindIdentityPrefix+rawAddress, &res)
+}
+
+func (l *replayClient) GetEpochSummaryByHeight(_ context.Context, h int64) (*EpochSummary, error) {
+	var res *EpochSummary
+	return res, l.load(h, archiveKindEpochSummary, &res)
+}
+
+func (l *replayClient) GetVotersByHeight(_ context.Context, h int64) ([]string, error) {
+	var res []string
+	return res, l.load(h, archiveKindVoters, &res)
+}
+
+// GetVoterRewardsByHeight returns rewards archived for height. They are rewards of voters given when archiving, regardless of given voters
+func (l *replayClient) GetVoterRewardsByHeight(_ context.Context, h int64, _ []string) ([]*VoterReward, error) {
+	var res []*VoterReward
+	return res, l.load(h, archiveKindVoterRewards, &res)
+}
+
+func (l *replayClient) GetElectedValidatorsByHeight(_ context.Context, h int64) ([]*ElectedValidator, error) {
+	var res []*ElectedValidator
+	return res, l.load(h, archiveKindElectedValidators, &res)
+}
+
+func (l *replayClient) GetValidatorSignersByHeight(_ context.Context, h int64) ([]string, error) {
+	var res []string
+	return res, l.load(h, archiveKindValidatorSigners, &res)
+}
+
+// load reads archived response into v. It returns ErrContractNotDeployed for partial responses
+func (l *replayClient) load(h int64, kind string, v interface{}) error {
+	h, err := l.height(h)
+	if err != nil {
+		return err
+	}
+
+	partial, err := l.archive.read(h, kind, v)
+	if err != nil {
+		return l.wrapErr(err, kind, h)
+	}
+	l.requestCounter.IncrementCounter()
+
+	if partial {
+		return ErrContractNotDeployed
+	}
+	return nil
+}
+
+// height resolves latest height (0) to the most recent archived height
+func (l *replayClient) height(h int64) (int64, error) {
+	if h != 0 {
+		return h, nil
+	}
+	return l.archive.lastHeight()
+}
+
+func (l *replayClient) wrapErr(err error, kind string, h int64) error {
+	if err == ErrNotArchived {
+		return err
+	}
+	return errors.Wrap(err, fmt.Sprintf("cannot read archived %s [height=%d]", kind, h))
+}
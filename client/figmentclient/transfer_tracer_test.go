@@ -0,0 +1,65 @@
+package figmentclient
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTransferTracer_matchesKliento(t *testing.T) {
+	t.Parallel()
+
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Version}} {{.Dir}}", "github.com/celo-org/kliento").Output()
+	if err != nil {
+		t.Skipf("cannot locate kliento module: %v", err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		t.Skipf("kliento module source is not available: %s", out)
+	}
+	version, dir := fields[0], fields[1]
+
+	if version != transferTracerKlientoVersion {
+		t.Fatalf("transfer tracer is copied from kliento %s, but go.mod requires %s; update the copy", transferTracerKlientoVersion, version)
+	}
+
+	vars := stringVars(t, filepath.Join(dir, "client", "debug", "tracer.go"))
+	if vars["transferTracer"] != transferTracer {
+		t.Errorf("transfer tracer differs from tracer of kliento %s", version)
+	}
+	if vars["transferTracerTimeout"] != transferTracerTimeout {
+		t.Errorf("unexpected transfer tracer timeout, want %s; got %s", vars["transferTracerTimeout"], transferTracerTimeout)
+	}
+}
+
+// stringVars returns values of package level string variables declared in given file
+func stringVars(t *testing.T, path string) map[string]string {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		t.Fatalf("cannot parse %s: %v", path, err)
+	}
+
+	vars := map[string]string{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, value := range valueSpec.Values {
+				if lit, ok := value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if s, err := strconv.Unquote(lit.Value); err == nil {
+						vars[valueSpec.Names[i].Name] = s
+					}
+				}
+			}
+		}
+	}
+	return vars
+}
@@ -7,6 +7,7 @@ import (
 
 const (
 	OperationTypeInternalTransfer                 = "InternalTransfer"
+	OperationTypeTokenTransfer                    = "TokenTransfer"
 	OperationTypeValidatorGroupVoteCast           = "ValidatorGroupVoteCast"
 	OperationTypeValidatorGroupVoteActivated      = "ValidatorGroupVoteActivated"
 	OperationTypeValidatorGroupPendingVoteRevoked = "ValidatorGroupPendingVoteRevoked"
@@ -34,6 +35,9 @@ type ChainStatus struct {
 	ChainId         uint64 `json:"chain_id"`
 	LastBlockHeight int64  `json:"last_block_height"`
 	LastBlockHash   string `json:"last_block_hash"`
+
+	// Nodes is health of nodes, it is set only by clients of node pool
+	Nodes []NodeHealth `json:"nodes,omitempty"`
 }
 
 type ChainParams struct {
@@ -43,11 +47,14 @@ type ChainParams struct {
 }
 
 type HeightMeta struct {
-	Height int64  `json:"height"`
-	Time   uint64 `json:"time"`
-
-	Epoch       *int64 `json:"epoch"`
-	LastInEpoch *bool  `json:"last_in_epoch"`
+	Height     int64  `json:"height"`
+	Time       uint64 `json:"time"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+
+	Epoch        *int64 `json:"epoch"`
+	LastInEpoch  *bool  `json:"last_in_epoch"`
+	FirstInEpoch *bool  `json:"first_in_epoch"`
 }
 
 type Block struct {
@@ -91,6 +98,7 @@ type Transaction struct {
 	Nonce               uint64   `json:"nonce"`
 	GasPrice            *big.Int `json:"gas_price"`
 	Gas                 uint64   `json:"gas"`
+	FeeCurrency         string   `json:"fee_currency"`
 	GatewayFee          *big.Int `json:"gateway_fee"`
 	GatewayFeeRecipient string   `json:"gateway_fee_recipient"`
 	Index               uint     `json:"index"`
@@ -115,6 +123,15 @@ type Transfer struct {
 	Success bool     `json:"success"`
 }
 
+// TokenTransfer is ERC-20 Transfer event of CELO or stable token
+type TokenTransfer struct {
+	Token        string   `json:"token"`
+	TokenAddress string   `json:"token_address"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Value        *big.Int `json:"value"`
+}
+
 type ValidatorGroup struct {
 	Index               uint64   `json:"index"`
 	Address             string   `json:"address"`
@@ -148,6 +165,31 @@ type AccountInfo struct {
 	StableTokenBalance       *big.Int `json:"stable_token_balance"`
 }
 
+type EpochSummary struct {
+	TargetVotingYield      *big.Int `json:"target_voting_yield"`
+	RewardsMultiplier      *big.Int `json:"rewards_multiplier"`
+	ElectedValidatorsCount int64    `json:"elected_validators_count"`
+	ValidatorRewards       *big.Int `json:"validator_rewards"`
+	VoterRewards           *big.Int `json:"voter_rewards"`
+	CommunityFundRewards   *big.Int `json:"community_fund_rewards"`
+	CarbonFundRewards      *big.Int `json:"carbon_fund_rewards"`
+	TotalVotes             *big.Int `json:"total_votes"`
+}
+
+type VoterReward struct {
+	Account     string   `json:"account"`
+	Group       string   `json:"group"`
+	Reward      *big.Int `json:"reward"`
+	ActiveVotes *big.Int `json:"active_votes"`
+}
+
+type ElectedValidator struct {
+	Address string `json:"address"`
+	Signer  string `json:"signer"`
+	Group   string `json:"group"`
+	Index   int64  `json:"index"`
+}
+
 type Identity struct {
 	Name        string `json:"name"`
 	MetadataUrl string `json:"metadata_url"`
@@ -0,0 +1,95 @@
+package theceloclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	NameTheCeloFixture = "the_celo_fixture_client"
+)
+
+var (
+	_ Client = (*fixtureClient)(nil)
+	_ Client = (*fixtureRecorder)(nil)
+)
+
+// NewFixtureClient creates client which serves proposals from JSON fixture recorded with fixture recorder
+func NewFixtureClient(path string) (*fixtureClient, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read proposals fixture")
+	}
+
+	proposals := &Proposals{}
+	if err := json.Unmarshal(data, proposals); err != nil {
+		return nil, errors.Wrap(err, "cannot parse proposals fixture")
+	}
+
+	return &fixtureClient{proposals: proposals}, nil
+}
+
+type fixtureClient struct {
+	proposals *Proposals
+}
+
+func (l *fixtureClient) GetName() string {
+	return NameTheCeloFixture
+}
+
+func (l *fixtureClient) Close() {}
+
+func (l *fixtureClient) GetAllProposals() (*Proposals, error) {
+	return l.proposals, nil
+}
+
+func (l *fixtureClient) GetProposalByProposalId(proposalId string) (*ProposalDetails, error) {
+	return findProposal(l.proposals, proposalId)
+}
+
+// NewFixtureRecorder creates client which stores proposals returned by given client as JSON fixture
+func NewFixtureRecorder(c Client, path string) *fixtureRecorder {
+	return &fixtureRecorder{
+		Client: c,
+		path:   path,
+	}
+}
+
+type fixtureRecorder struct {
+	Client
+
+	path string
+}
+
+func (l *fixtureRecorder) GetAllProposals() (*Proposals, error) {
+	proposals, err := l.Client.GetAllProposals()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(proposals, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(l.path, data, 0644); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot write proposals fixture [path=%s]", l.path))
+	}
+
+	return proposals, nil
+}
+
+func (l *fixtureRecorder) GetProposalByProposalId(proposalId string) (*ProposalDetails, error) {
+	proposals, err := l.GetAllProposals()
+	if err != nil {
+		return nil, err
+	}
+	return findProposal(proposals, proposalId)
+}
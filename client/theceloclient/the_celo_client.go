@@ -77,6 +77,10 @@ func (l *client) GetProposalByProposalId(proposalId string) (*ProposalDetails, e
 		return nil, err
 	}
 
+	return findProposal(proposals, proposalId)
+}
+
+func findProposal(proposals *Proposals, proposalId string) (*ProposalDetails, error) {
 	proposal, ok := proposals.Items[proposalId]
 	if ok {
 		return &proposal, nil
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"regexp"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -12,12 +14,35 @@ import (
 const (
 	modeDevelopment = "development"
 	modeProduction  = "production"
+
+	ArchiveModeWrite  = "write"
+	ArchiveModeReplay = "replay"
 )
 
 var (
 	errEndpointRequired            = errors.New("proxy url is required")
 	errDatabaseRequired            = errors.New("database credentials are required")
 	errIndexWorkerIntervalRequired = errors.New("index worker interval is required")
+	errFollowPollIntervalInvalid   = errors.New("follow poll interval is invalid")
+	errParallelHeightsInvalid      = errors.New("parallel heights must be greater than 0")
+	errPublisherInvalid            = errors.New("publisher must be one of: stdout, file, nats")
+	errPublisherFileRequired       = errors.New("publisher file is required")
+	errPublisherNatsUrlRequired    = errors.New("publisher nats url is required")
+	errWebhookMaxAttemptsInvalid   = errors.New("webhook max attempts must be greater than 0")
+	errWebhookBackoffInvalid       = errors.New("webhook backoff is invalid")
+	errWebhookTimeoutInvalid       = errors.New("webhook timeout is invalid")
+	errWebhookBatchSizeInvalid     = errors.New("webhook batch size must be greater than 0")
+	errRetryPolicyInvalid          = errors.New("retry policy is invalid")
+	errNodeHealthCheckInvalid      = errors.New("node health check interval and timeout must be valid durations")
+	errMaxValidatorSequences       = errors.New("max validator sequences must be greater than 0")
+	errMissedForMaxThreshold       = errors.New("missed for max threshold must be greater than 0 and not greater than max validator sequences")
+	errMissedInRowThreshold        = errors.New("missed in row threshold must be greater than 0")
+	errGroupRewardChangeThresholds = errors.New("group reward change thresholds must be 3 positive values in ascending order")
+	errArchiveModeInvalid          = errors.New("archive mode must be one of: write, replay")
+	errArchiveDirRequired          = errors.New("archive dir is required")
+	errMissedBlocksRuleInvalid     = errors.New("missed blocks rule must have unique name of lowercase letters, digits or underscores and missed count greater than 0 and not greater than window")
+
+	missedBlocksRuleNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
 )
 
 // Config holds the configuration data
@@ -31,7 +56,10 @@ type Config struct {
 	SummarizeWorkerInterval      string `json:"summarize_worker_interval" envconfig:"SUMMARIZE_WORKER_INTERVAL" default:"@every 20m"`
 	PurgeWorkerInterval          string `json:"purge_worker_interval" envconfig:"PURGE_WORKER_INTERVAL" default:"@every 1h"`
 	UpdateProposalsInterval      string `json:"update_proposals_interval" envconfig:"UPDATE_PROPOSALS_INTERVAL" default:"@every 24h"`
+	DeliverWebhooksInterval      string `json:"deliver_webhooks_interval" envconfig:"DELIVER_WEBHOOKS_INTERVAL" default:"@every 10s"`
+	FollowPollInterval           string `json:"follow_poll_interval" envconfig:"FOLLOW_POLL_INTERVAL" default:"5s"`
 	DefaultBatchSize             int64  `json:"default_batch_size" envconfig:"DEFAULT_BATCH_SIZE" default:"0"`
+	ParallelHeights              int64  `json:"parallel_heights" envconfig:"PARALLEL_HEIGHTS" default:"1"`
 	DatabaseDSN                  string `json:"database_dsn" envconfig:"DATABASE_DSN"`
 	Debug                        bool   `json:"debug" envconfig:"DEBUG"`
 	LogLevel                     string `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
@@ -45,11 +73,85 @@ type Config struct {
 	PurgeHourlySummariesInterval string `json:"purge_hourly_summaries_interval" envconfig:"PURGE_HOURLY_SUMMARIES_INTERVAL" default:"26h"`
 	IndexerConfigFile            string `json:"indexer_config_file" envconfig:"INDEXER_CONFIG_FILE" default:"indexer_config.json"`
 	TheCeloBaseUrl               string `json:"the_celo_base_url" envconfig:"THE_CELO_BASE_URL" default:"https://thecelo.com/api/v0.1"`
+	Publisher                    string `json:"publisher" envconfig:"PUBLISHER"`
+	PublisherFile                string `json:"publisher_file" envconfig:"PUBLISHER_FILE"`
+	PublisherNatsUrl             string `json:"publisher_nats_url" envconfig:"PUBLISHER_NATS_URL"`
+	PublisherSubjectPrefix       string `json:"publisher_subject_prefix" envconfig:"PUBLISHER_SUBJECT_PREFIX" default:"celo"`
+	WebhookMaxAttempts           int64  `json:"webhook_max_attempts" envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"5"`
+	WebhookInitialBackoff        string `json:"webhook_initial_backoff" envconfig:"WEBHOOK_INITIAL_BACKOFF" default:"1s"`
+	WebhookMaxBackoff            string `json:"webhook_max_backoff" envconfig:"WEBHOOK_MAX_BACKOFF" default:"30s"`
+	WebhookTimeout               string `json:"webhook_timeout" envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	WebhookBatchSize             int64  `json:"webhook_batch_size" envconfig:"WEBHOOK_BATCH_SIZE" default:"100"`
+	MaxValidatorSequences        int64  `json:"max_validator_sequences" envconfig:"MAX_VALIDATOR_SEQUENCES" default:"1000"`
+	MissedForMaxThreshold        int64  `json:"missed_for_max_threshold" envconfig:"MISSED_FOR_MAX_THRESHOLD" default:"50"`
+	MissedInRowThreshold         int64  `json:"missed_in_row_threshold" envconfig:"MISSED_IN_ROW_THRESHOLD" default:"50"`
+	// GroupRewardChangeThresholds are lower bounds (in percents) of group_reward_change_1, _2 and _3 system events
+	GroupRewardChangeThresholds []float64         `json:"group_reward_change_thresholds" envconfig:"GROUP_REWARD_CHANGE_THRESHOLDS" default:"0.1,1,10"`
+	MissedBlocksRules           MissedBlocksRules `json:"missed_blocks_rules" envconfig:"MISSED_BLOCKS_RULES"`
+	// ArchiveMode is either write (raw node responses are archived) or replay (responses are served from archive without node)
+	ArchiveMode string `json:"archive_mode" envconfig:"ARCHIVE_MODE"`
+	ArchiveDir  string `json:"archive_dir" envconfig:"ARCHIVE_DIR"`
+	// AdminToken protects admin endpoints, they are disabled when it is not set
+	AdminToken string `json:"admin_token" envconfig:"ADMIN_TOKEN"`
+	// Failed pipeline tasks are retried with exponential backoff. Jitter is a fraction by which backoff is randomized
+	RetryMaxAttempts    int64   `json:"retry_max_attempts" envconfig:"RETRY_MAX_ATTEMPTS" default:"3"`
+	RetryInitialBackoff string  `json:"retry_initial_backoff" envconfig:"RETRY_INITIAL_BACKOFF" default:"200ms"`
+	RetryMaxBackoff     string  `json:"retry_max_backoff" envconfig:"RETRY_MAX_BACKOFF" default:"10s"`
+	RetryJitter         float64 `json:"retry_jitter" envconfig:"RETRY_JITTER" default:"0.2"`
+	// RetryPolicies override retry policy of tasks of given pipeline stages
+	RetryPolicies RetryPolicies `json:"retry_policies" envconfig:"RETRY_POLICIES"`
+	// Nodes of NODE_URL which fail health check or are behind the most recent node by more than NodeMaxLag blocks are not called
+	NodeHealthCheckInterval string `json:"node_health_check_interval" envconfig:"NODE_HEALTH_CHECK_INTERVAL" default:"30s"`
+	NodeHealthCheckTimeout  string `json:"node_health_check_timeout" envconfig:"NODE_HEALTH_CHECK_TIMEOUT" default:"5s"`
+	NodeMaxLag              int64  `json:"node_max_lag" envconfig:"NODE_MAX_LAG" default:"10"`
+}
+
+// MissedBlocksRule describes when system event is created for validator which missed
+// given number of blocks within window of its most recent sequences
+type MissedBlocksRule struct {
+	Name   string `json:"name"`
+	Missed int64  `json:"missed"`
+	Window int64  `json:"window"`
+}
+
+// MissedBlocksRules can be set in environment variable as JSON array
+type MissedBlocksRules []MissedBlocksRule
+
+// Decode implements envconfig.Decoder
+func (r *MissedBlocksRules) Decode(value string) error {
+	return json.Unmarshal([]byte(value), r)
+}
+
+// RetryPolicy describes how failed tasks of pipeline stage are retried. Fields which are not set fall back to defaults
+type RetryPolicy struct {
+	MaxAttempts    int64    `json:"max_attempts"`
+	InitialBackoff string   `json:"initial_backoff"`
+	MaxBackoff     string   `json:"max_backoff"`
+	Jitter         *float64 `json:"jitter"`
+}
+
+// RetryPolicies can be set in environment variable as JSON object keyed by stage name
+// (ie. `{"stage_fetcher":{"max_attempts":5,"max_backoff":"30s"},"stage_persistor":{"max_attempts":10}}`)
+type RetryPolicies map[string]RetryPolicy
+
+// Decode implements envconfig.Decoder
+func (r *RetryPolicies) Decode(value string) error {
+	return json.Unmarshal([]byte(value), r)
 }
 
 // Validate returns an error if config is invalid
 func (c *Config) Validate() error {
-	if c.NodeUrl == "" {
+	switch c.ArchiveMode {
+	case "", ArchiveModeWrite, ArchiveModeReplay:
+	default:
+		return errArchiveModeInvalid
+	}
+
+	if c.ArchiveMode != "" && c.ArchiveDir == "" {
+		return errArchiveDirRequired
+	}
+
+	if c.NodeUrl == "" && c.ArchiveMode != ArchiveModeReplay {
 		return errEndpointRequired
 	}
 
@@ -61,9 +163,177 @@ func (c *Config) Validate() error {
 		return errIndexWorkerIntervalRequired
 	}
 
+	if _, err := time.ParseDuration(c.FollowPollInterval); err != nil {
+		return errFollowPollIntervalInvalid
+	}
+
+	if c.ParallelHeights < 1 {
+		return errParallelHeightsInvalid
+	}
+
+	switch c.Publisher {
+	case "", "stdout":
+	case "file":
+		if c.PublisherFile == "" {
+			return errPublisherFileRequired
+		}
+	case "nats":
+		if c.PublisherNatsUrl == "" {
+			return errPublisherNatsUrlRequired
+		}
+	default:
+		return errPublisherInvalid
+	}
+
+	if c.WebhookMaxAttempts < 1 {
+		return errWebhookMaxAttemptsInvalid
+	}
+
+	if _, err := time.ParseDuration(c.WebhookInitialBackoff); err != nil {
+		return errWebhookBackoffInvalid
+	}
+
+	if _, err := time.ParseDuration(c.WebhookMaxBackoff); err != nil {
+		return errWebhookBackoffInvalid
+	}
+
+	if _, err := time.ParseDuration(c.WebhookTimeout); err != nil {
+		return errWebhookTimeoutInvalid
+	}
+
+	if c.WebhookBatchSize < 1 {
+		return errWebhookBatchSizeInvalid
+	}
+
+	if err := c.validateSystemEvents(); err != nil {
+		return err
+	}
+
+	if _, err := time.ParseDuration(c.NodeHealthCheckInterval); err != nil {
+		return errNodeHealthCheckInvalid
+	}
+
+	if _, err := time.ParseDuration(c.NodeHealthCheckTimeout); err != nil {
+		return errNodeHealthCheckInvalid
+	}
+
+	if err := c.validateRetryPolicies(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Config) validateRetryPolicies() error {
+	policies := []RetryPolicy{c.GetRetryPolicy("")}
+	for stage := range c.RetryPolicies {
+		policies = append(policies, c.GetRetryPolicy(stage))
+	}
+
+	for _, policy := range policies {
+		if policy.MaxAttempts < 1 {
+			return errRetryPolicyInvalid
+		}
+		if _, err := time.ParseDuration(policy.InitialBackoff); err != nil {
+			return errRetryPolicyInvalid
+		}
+		if _, err := time.ParseDuration(policy.MaxBackoff); err != nil {
+			return errRetryPolicyInvalid
+		}
+		if *policy.Jitter < 0 || *policy.Jitter > 1 {
+			return errRetryPolicyInvalid
+		}
+	}
 	return nil
 }
 
+// GetRetryPolicy returns retry policy of given pipeline stage with defaults filled in
+func (c *Config) GetRetryPolicy(stage string) RetryPolicy {
+	jitter := c.RetryJitter
+	policy := RetryPolicy{
+		MaxAttempts:    c.RetryMaxAttempts,
+		InitialBackoff: c.RetryInitialBackoff,
+		MaxBackoff:     c.RetryMaxBackoff,
+		Jitter:         &jitter,
+	}
+
+	override, ok := c.RetryPolicies[stage]
+	if !ok {
+		return policy
+	}
+	if override.MaxAttempts != 0 {
+		policy.MaxAttempts = override.MaxAttempts
+	}
+	if override.InitialBackoff != "" {
+		policy.InitialBackoff = override.InitialBackoff
+	}
+	if override.MaxBackoff != "" {
+		policy.MaxBackoff = override.MaxBackoff
+	}
+	if override.Jitter != nil {
+		policy.Jitter = override.Jitter
+	}
+	return policy
+}
+
+func (c *Config) validateSystemEvents() error {
+	if c.MaxValidatorSequences < 1 {
+		return errMaxValidatorSequences
+	}
+
+	if c.MissedForMaxThreshold < 1 || c.MissedForMaxThreshold > c.MaxValidatorSequences {
+		return errMissedForMaxThreshold
+	}
+
+	if c.MissedInRowThreshold < 1 {
+		return errMissedInRowThreshold
+	}
+
+	if len(c.GroupRewardChangeThresholds) != 3 {
+		return errGroupRewardChangeThresholds
+	}
+	for i, threshold := range c.GroupRewardChangeThresholds {
+		if threshold <= 0 || (i > 0 && threshold <= c.GroupRewardChangeThresholds[i-1]) {
+			return errGroupRewardChangeThresholds
+		}
+	}
+
+	names := map[string]bool{}
+	for _, rule := range c.MissedBlocksRules {
+		if !missedBlocksRuleNameRegexp.MatchString(rule.Name) || names[rule.Name] {
+			return errMissedBlocksRuleInvalid
+		}
+		if rule.Missed < 1 || rule.Missed > rule.Window {
+			return errMissedBlocksRuleInvalid
+		}
+		names[rule.Name] = true
+	}
+
+	return nil
+}
+
+// GetMissedBlocksRules returns configured missed blocks rules.
+// When there are none, it returns unnamed rule created from MissedForMaxThreshold and MaxValidatorSequences
+func (c *Config) GetMissedBlocksRules() []MissedBlocksRule {
+	if len(c.MissedBlocksRules) > 0 {
+		return c.MissedBlocksRules
+	}
+	return []MissedBlocksRule{
+		{Missed: c.MissedForMaxThreshold, Window: c.MaxValidatorSequences},
+	}
+}
+
+// GetMaxMissedBlocksWindow returns number of recent blocks required to check all missed blocks rules
+func (c *Config) GetMaxMissedBlocksWindow() int64 {
+	var maxWindow int64
+	for _, rule := range c.GetMissedBlocksRules() {
+		if rule.Window > maxWindow {
+			maxWindow = rule.Window
+		}
+	}
+	return maxWindow
+}
+
 // IsDevelopment returns true if app is in dev mode
 func (c *Config) IsDevelopment() bool {
 	return c.AppEnv == modeDevelopment
@@ -3,10 +3,13 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/celo-org/kliento/contracts"
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
 	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
 	"github.com/figment-networks/celo-indexer/types"
 
 	"github.com/figment-networks/celo-indexer/model"
@@ -18,21 +21,25 @@ const (
 	ValidatorAggCreatorTaskName      = "ValidatorAggCreator"
 	ValidatorGroupAggCreatorTaskName = "ValidatorGroupAggCreator"
 	ProposalAggCreatorTaskName       = "ProposalAggCreator"
+	VoterAggCreatorTaskName          = "VoterAggCreator"
 )
 
 var (
 	_ pipeline.Task = (*validatorAggCreatorTask)(nil)
 	_ pipeline.Task = (*validatorGroupAggCreatorTask)(nil)
+	_ pipeline.Task = (*voterAggCreatorTask)(nil)
 )
 
-func NewValidatorAggCreatorTask(c figmentclient.Client, validatorAggDb store.ValidatorAgg) *validatorAggCreatorTask {
+func NewValidatorAggCreatorTask(cfg *config.Config, c figmentclient.Client, validatorAggDb store.ValidatorAgg) *validatorAggCreatorTask {
 	return &validatorAggCreatorTask{
+		cfg:            cfg,
 		client:         c,
 		validatorAggDb: validatorAggDb,
 	}
 }
 
 type validatorAggCreatorTask struct {
+	cfg            *config.Config
 	client         figmentclient.Client
 	validatorAggDb store.ValidatorAgg
 }
@@ -99,6 +106,12 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 				validator.AccumulatedUptimeCount = 1
 			}
 
+			validator.RecordRecentBlock(isValidatorMissed(rawValidator), t.cfg.GetMaxMissedBlocksWindow())
+
+			if isProposer(rawValidator, payload.RawBlock) {
+				validator.ProposedBlocksCount = 1
+			}
+
 			// Always get identity for new records
 			identity, err := t.client.GetIdentityByHeight(ctx, validator.Address, payload.CurrentHeight)
 			if err != nil {
@@ -131,8 +144,17 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 				validator.AccumulatedUptimeCount = existing.AccumulatedUptimeCount + 1
 			}
 
+			validator.RecentMissedBlocks = existing.RecentMissedBlocks
+			validator.MissedInRowCount = existing.MissedInRowCount
+			validator.RecordRecentBlock(isValidatorMissed(rawValidator), t.cfg.GetMaxMissedBlocksWindow())
+
+			validator.ProposedBlocksCount = existing.ProposedBlocksCount
+			if isProposer(rawValidator, payload.RawBlock) {
+				validator.ProposedBlocksCount++
+			}
+
 			if shouldFetchIdentities {
-				identity, err := t.client.GetIdentityByHeight(ctx, validator.Address, payload.CurrentHeight)
+				identity, err := t.client.GetIdentityByHeight(ctx, rawValidator.Address, payload.CurrentHeight)
 				if err != nil {
 					return err
 				}
@@ -152,14 +174,16 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 	return nil
 }
 
-func NewValidatorGroupAggCreatorTask(c figmentclient.Client, validatorGroupAggDb store.ValidatorGroupAgg) *validatorGroupAggCreatorTask {
+func NewValidatorGroupAggCreatorTask(cfg *config.Config, c figmentclient.Client, validatorGroupAggDb store.ValidatorGroupAgg) *validatorGroupAggCreatorTask {
 	return &validatorGroupAggCreatorTask{
+		cfg:                 cfg,
 		client:              c,
 		validatorGroupAggDb: validatorGroupAggDb,
 	}
 }
 
 type validatorGroupAggCreatorTask struct {
+	cfg                 *config.Config
 	client              figmentclient.Client
 	validatorGroupAggDb store.ValidatorGroupAgg
 }
@@ -190,6 +214,8 @@ func (t *validatorGroupAggCreatorTask) Run(ctx context.Context, p pipeline.Paylo
 		return err
 	}
 
+	membersAvgSignedMap := getMembersAvgSignedMap(payload.RawValidators)
+
 	existingValidatorGroupAggsMap := make(map[string]*model.ValidatorGroupAgg)
 	for _, validatorGroupAgg := range existingValidatorGroupAggs {
 		vga := validatorGroupAgg
@@ -213,6 +239,8 @@ func (t *validatorGroupAggCreatorTask) Run(ctx context.Context, p pipeline.Paylo
 				Address: rawGroup.Address,
 			}
 
+			group.RecordRecentBlock(isValidatorGroupMissed(rawGroup, membersAvgSignedMap), t.cfg.GetMaxMissedBlocksWindow())
+
 			// Always get identity for new records
 			identity, err := t.client.GetIdentityByHeight(ctx, group.Address, payload.CurrentHeight)
 			if err != nil {
@@ -229,10 +257,15 @@ func (t *validatorGroupAggCreatorTask) Run(ctx context.Context, p pipeline.Paylo
 					RecentAtHeight: payload.Syncable.Height,
 					RecentAt:       *payload.Syncable.Time,
 				},
+
+				RecentMissedBlocks: existing.RecentMissedBlocks,
+				MissedInRowCount:   existing.MissedInRowCount,
 			}
 
+			group.RecordRecentBlock(isValidatorGroupMissed(rawGroup, membersAvgSignedMap), t.cfg.GetMaxMissedBlocksWindow())
+
 			if shouldFetchIdentities {
-				identity, err := t.client.GetIdentityByHeight(ctx, group.Address, payload.CurrentHeight)
+				identity, err := t.client.GetIdentityByHeight(ctx, rawGroup.Address, payload.CurrentHeight)
 				if err != nil {
 					return err
 				}
@@ -251,6 +284,18 @@ func (t *validatorGroupAggCreatorTask) Run(ctx context.Context, p pipeline.Paylo
 	return nil
 }
 
+// isValidatorMissed returns true when validator was elected and did not sign the block.
+// Signed is not set for validators which were not elected, so they cannot miss blocks
+func isValidatorMissed(rawValidator *figmentclient.Validator) bool {
+	return rawValidator.Signed != nil && !*rawValidator.Signed
+}
+
+// isValidatorGroupMissed returns true when validator group sequence of the block would not be validated
+func isValidatorGroupMissed(rawGroup *figmentclient.ValidatorGroup, membersAvgSignedMap map[string]membersAvgSigned) bool {
+	found, ok := membersAvgSignedMap[rawGroup.Address]
+	return !ok || found.count == 0
+}
+
 func NewProposalAggCreatorTask(proposalAggDb store.ProposalAgg) *proposalAggCreatorTask {
 	return &proposalAggCreatorTask{
 		proposalAggDb: proposalAggDb,
@@ -400,3 +445,128 @@ func (t *proposalAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) er
 
 	return nil
 }
+
+func NewVoterAggCreatorTask(voterAggDb store.VoterAgg) *voterAggCreatorTask {
+	return &voterAggCreatorTask{
+		voterAggDb: voterAggDb,
+	}
+}
+
+type voterAggCreatorTask struct {
+	voterAggDb store.VoterAgg
+}
+
+func (t *voterAggCreatorTask) GetName() string {
+	return VoterAggCreatorTaskName
+}
+
+func (t *voterAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	voteChanges := getVoteChanges(payload.RawTransactions, payload.VoterRewardSequences)
+	if len(voteChanges) == 0 {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageAggregator, t.GetName(), payload.CurrentHeight))
+
+	var newVoterAggs []model.VoterAgg
+	var updatedVoterAggs []model.VoterAgg
+	for _, change := range voteChanges {
+		existing, err := t.voterAggDb.FindByAddressAndGroup(change.address, change.group)
+		if err != nil {
+			if err != psql.ErrNotFound {
+				return err
+			}
+
+			// Create new
+			voter := model.VoterAgg{
+				Aggregate: &model.Aggregate{
+					StartedAtHeight: payload.Syncable.Height,
+					StartedAt:       *payload.Syncable.Time,
+					RecentAtHeight:  payload.Syncable.Height,
+					RecentAt:        *payload.Syncable.Time,
+				},
+
+				Address: change.address,
+				Group:   change.group,
+			}
+			voter.AddVotes(change.pending, change.active)
+
+			newVoterAggs = append(newVoterAggs, voter)
+		} else {
+			// Update
+			voter := &model.VoterAgg{
+				Aggregate: &model.Aggregate{
+					RecentAtHeight: payload.Syncable.Height,
+					RecentAt:       *payload.Syncable.Time,
+				},
+
+				PendingVotes: existing.PendingVotes,
+				ActiveVotes:  existing.ActiveVotes,
+			}
+			voter.AddVotes(change.pending, change.active)
+
+			existing.Update(voter)
+
+			updatedVoterAggs = append(updatedVoterAggs, *existing)
+		}
+	}
+	payload.NewVoterAggregates = newVoterAggs
+	payload.UpdatedVoterAggregates = updatedVoterAggs
+
+	return nil
+}
+
+// voteChange is change of pending and active votes of voter for validator group at height
+type voteChange struct {
+	address string
+	group   string
+	pending *big.Int
+	active  *big.Int
+}
+
+// getVoteChanges sums changes of votes from Election events and voter rewards, in order of first change of each voter for group
+func getVoteChanges(rawTransactions []*figmentclient.Transaction, voterRewardSeqs []model.VoterRewardSeq) []*voteChange {
+	var changes []*voteChange
+	changesMap := make(map[string]*voteChange)
+
+	add := func(address string, group string, pending *big.Int, active *big.Int) {
+		key := address + "/" + group
+		change, ok := changesMap[key]
+		if !ok {
+			change = &voteChange{address: address, group: group, pending: new(big.Int), active: new(big.Int)}
+			changesMap[key] = change
+			changes = append(changes, change)
+		}
+		change.pending.Add(change.pending, pending)
+		change.active.Add(change.active, active)
+	}
+
+	zero := new(big.Int)
+	for _, rawTransaction := range rawTransactions {
+		for _, rawOperation := range rawTransaction.Operations {
+			switch rawOperation.Name {
+			case figmentclient.OperationTypeValidatorGroupVoteCast:
+				event := rawOperation.Details.(*contracts.ElectionValidatorGroupVoteCast)
+				add(event.Account.String(), event.Group.String(), event.Value, zero)
+			case figmentclient.OperationTypeValidatorGroupVoteActivated:
+				event := rawOperation.Details.(*contracts.ElectionValidatorGroupVoteActivated)
+				add(event.Account.String(), event.Group.String(), new(big.Int).Neg(event.Value), event.Value)
+			case figmentclient.OperationTypeValidatorGroupPendingVoteRevoked:
+				event := rawOperation.Details.(*contracts.ElectionValidatorGroupPendingVoteRevoked)
+				add(event.Account.String(), event.Group.String(), new(big.Int).Neg(event.Value), zero)
+			case figmentclient.OperationTypeValidatorGroupActiveVoteRevoked:
+				event := rawOperation.Details.(*contracts.ElectionValidatorGroupActiveVoteRevoked)
+				add(event.Account.String(), event.Group.String(), zero, new(big.Int).Neg(event.Value))
+			}
+		}
+	}
+
+	// Rewards are distributed at the end of block, after its transactions
+	for _, voterRewardSeq := range voterRewardSeqs {
+		add(voterRewardSeq.Address, voterRewardSeq.Group, zero, &voterRewardSeq.Amount.Int)
+	}
+
+	return changes
+}
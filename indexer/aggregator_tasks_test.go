@@ -2,14 +2,19 @@ package indexer
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/celo-org/kliento/contracts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
 	clientMock "github.com/figment-networks/celo-indexer/mock/client"
 	mock "github.com/figment-networks/celo-indexer/mock/store"
 	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
 	"github.com/figment-networks/celo-indexer/types"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -19,9 +24,11 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 	syncTime := types.NewTimeFromTime(time.Now())
 	const syncHeight int64 = 31
 	dbErr := errors.New("unexpected err")
+	cfg := &config.Config{MaxValidatorSequences: 3, MissedForMaxThreshold: 2}
 
 	signed := true
 	notSigned := false
+	// Validator which was not elected has no signed flag
 	validator1 := figmentclient.Validator{Address: "acct1", Signed: nil}
 	validator2 := figmentclient.Validator{Address: "acct2", Signed: &signed}
 	validator3 := figmentclient.Validator{Address: "acct3", Signed: &notSigned}
@@ -34,7 +41,7 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 		expectValidators []model.ValidatorAgg
 	}{
 		{
-			description:   "Adds new validator to payload.NewValidatorAggregates",
+			description:   "Adds new validator to payload.NewValidatorAggregates without missed block of not elected validator",
 			rawValidators: []*figmentclient.Validator{&validator1, &validator2, &validator3},
 			syncable: model.Syncable{
 				Height: syncHeight,
@@ -55,6 +62,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: syncHeight,
 					AccumulatedUptime:       0,
 					AccumulatedUptimeCount:  0,
+					RecentMissedBlocks:      "0",
+					MissedInRowCount:        0,
 				},
 				{
 					Aggregate: &model.Aggregate{
@@ -69,6 +78,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: syncHeight,
 					AccumulatedUptime:       1,
 					AccumulatedUptimeCount:  1,
+					RecentMissedBlocks:      "0",
+					MissedInRowCount:        0,
 				},
 				{
 					Aggregate: &model.Aggregate{
@@ -83,6 +94,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: syncHeight,
 					AccumulatedUptime:       0,
 					AccumulatedUptimeCount:  1,
+					RecentMissedBlocks:      "1",
+					MissedInRowCount:        1,
 				},
 			},
 		},
@@ -125,7 +138,7 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 				MetadataUrl: "http://test.com",
 			}, nil).Times(len(tt.rawValidators))
 
-			task := NewValidatorAggCreatorTask(cMock, dbMock)
+			task := NewValidatorAggCreatorTask(cfg, cMock, dbMock)
 			if err := task.Run(ctx, pld); err != tt.expectErr {
 				t.Errorf("unexpected error, got: %v; want: %v", err, tt.expectErr)
 				return
@@ -171,7 +184,7 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 		expectValidators []model.ValidatorAgg
 	}{
 		{
-			description:   "Adds validator to payload.UpdatedValidatorAggregates",
+			description:   "Adds validator to payload.UpdatedValidatorAggregates without missed block of not elected validator",
 			rawValidators: []*figmentclient.Validator{&validator1, &validator2, &validator3},
 			returnValidators: []model.ValidatorAgg{
 				{
@@ -185,6 +198,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: startedAtHeight,
 					AccumulatedUptime:       1,
 					AccumulatedUptimeCount:  1,
+					RecentMissedBlocks:      "11",
+					MissedInRowCount:        2,
 				},
 				{
 					Aggregate: &model.Aggregate{
@@ -197,6 +212,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: startedAtHeight,
 					AccumulatedUptime:       1,
 					AccumulatedUptimeCount:  1,
+					RecentMissedBlocks:      "10",
+					MissedInRowCount:        0,
 				},
 				{
 					Aggregate: &model.Aggregate{
@@ -227,6 +244,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: syncHeight,
 					AccumulatedUptime:       1,
 					AccumulatedUptimeCount:  1,
+					RecentMissedBlocks:      "011",
+					MissedInRowCount:        0,
 				},
 				{
 					Aggregate: &model.Aggregate{
@@ -239,6 +258,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: syncHeight,
 					AccumulatedUptime:       2,
 					AccumulatedUptimeCount:  2,
+					RecentMissedBlocks:      "010",
+					MissedInRowCount:        0,
 				},
 				{
 					Aggregate: &model.Aggregate{
@@ -251,6 +272,8 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 					RecentAsValidatorHeight: syncHeight,
 					AccumulatedUptime:       1,
 					AccumulatedUptimeCount:  2,
+					RecentMissedBlocks:      "1",
+					MissedInRowCount:        1,
 				},
 			},
 		},
@@ -279,7 +302,7 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 				MetadataUrl: "http://test.com",
 			}, nil).Times(len(tt.rawValidators))
 
-			task := NewValidatorAggCreatorTask(cMock, dbMock)
+			task := NewValidatorAggCreatorTask(cfg, cMock, dbMock)
 			if err := task.Run(ctx, pld); err != nil {
 				t.Errorf("unexpected error, got: %v", err)
 				return
@@ -309,3 +332,139 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestVoterAggCreatorTask_Run(t *testing.T) {
+	syncTime := types.NewTimeFromTime(time.Now())
+	startTime := types.NewTimeFromTime(time.Now().Add(-time.Hour))
+	const syncHeight int64 = 31
+	dbErr := errors.New("unexpected err")
+
+	account := common.HexToAddress("0x3C2b1a0f9E8d7C6b5A4f3E2d1C0B9a8F7e6d5C4B")
+	group := common.HexToAddress("0x9C4e1A2B3c5d6E7F8a9B0C1D2E3f4a5b6C7d8e9f")
+
+	operation := func(name string, details interface{}) *figmentclient.Transaction {
+		return &figmentclient.Transaction{Operations: []*figmentclient.Operation{{Name: name, Details: details}}}
+	}
+	voteCast := operation(figmentclient.OperationTypeValidatorGroupVoteCast,
+		&contracts.ElectionValidatorGroupVoteCast{Account: account, Group: group, Value: big.NewInt(100)})
+	voteActivated := operation(figmentclient.OperationTypeValidatorGroupVoteActivated,
+		&contracts.ElectionValidatorGroupVoteActivated{Account: account, Group: group, Value: big.NewInt(60)})
+	activeVoteRevoked := operation(figmentclient.OperationTypeValidatorGroupActiveVoteRevoked,
+		&contracts.ElectionValidatorGroupActiveVoteRevoked{Account: account, Group: group, Value: big.NewInt(500)})
+
+	tests := []struct {
+		description   string
+		rawTxs        []*figmentclient.Transaction
+		voterRewards  []model.VoterRewardSeq
+		existing      *model.VoterAgg
+		dbErr         error
+		expectNew     []model.VoterAgg
+		expectUpdated []model.VoterAgg
+		expectDbCalls int
+		expectErr     error
+	}{
+		{
+			description: "skips height without vote changes",
+		},
+		{
+			description:   "creates voter with pending votes",
+			rawTxs:        []*figmentclient.Transaction{voteCast, voteActivated},
+			dbErr:         psql.ErrNotFound,
+			expectDbCalls: 1,
+			expectNew: []model.VoterAgg{
+				{
+					Aggregate:    &model.Aggregate{StartedAtHeight: syncHeight, StartedAt: *syncTime, RecentAtHeight: syncHeight, RecentAt: *syncTime},
+					Address:      account.String(),
+					Group:        group.String(),
+					PendingVotes: types.NewQuantityFromInt64(40),
+					ActiveVotes:  types.NewQuantityFromInt64(60),
+				},
+			},
+		},
+		{
+			description:  "updates active votes of voter with rewards",
+			voterRewards: []model.VoterRewardSeq{{Address: account.String(), Group: group.String(), Amount: types.NewQuantityFromInt64(5)}},
+			existing: &model.VoterAgg{
+				Aggregate:    &model.Aggregate{StartedAtHeight: 10, StartedAt: *startTime, RecentAtHeight: 20, RecentAt: *startTime},
+				Address:      account.String(),
+				Group:        group.String(),
+				PendingVotes: types.NewQuantityFromInt64(40),
+				ActiveVotes:  types.NewQuantityFromInt64(60),
+			},
+			expectDbCalls: 1,
+			expectUpdated: []model.VoterAgg{
+				{
+					Aggregate:    &model.Aggregate{StartedAtHeight: 10, StartedAt: *startTime, RecentAtHeight: syncHeight, RecentAt: *syncTime},
+					Address:      account.String(),
+					Group:        group.String(),
+					PendingVotes: types.NewQuantityFromInt64(40),
+					ActiveVotes:  types.NewQuantityFromInt64(65),
+				},
+			},
+		},
+		{
+			description: "does not revoke more votes than known",
+			rawTxs:      []*figmentclient.Transaction{activeVoteRevoked},
+			existing: &model.VoterAgg{
+				Aggregate:    &model.Aggregate{StartedAtHeight: 10, StartedAt: *startTime, RecentAtHeight: 20, RecentAt: *startTime},
+				Address:      account.String(),
+				Group:        group.String(),
+				PendingVotes: types.NewQuantityFromInt64(40),
+				ActiveVotes:  types.NewQuantityFromInt64(60),
+			},
+			expectDbCalls: 1,
+			expectUpdated: []model.VoterAgg{
+				{
+					Aggregate:    &model.Aggregate{StartedAtHeight: 10, StartedAt: *startTime, RecentAtHeight: syncHeight, RecentAt: *syncTime},
+					Address:      account.String(),
+					Group:        group.String(),
+					PendingVotes: types.NewQuantityFromInt64(40),
+					ActiveVotes:  types.NewQuantityFromInt64(0),
+				},
+			},
+		},
+		{
+			description:   "returns error if database errors",
+			rawTxs:        []*figmentclient.Transaction{voteCast},
+			dbErr:         dbErr,
+			expectDbCalls: 1,
+			expectErr:     dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dbMock := mock.NewMockVoterAgg(ctrl)
+			dbMock.EXPECT().FindByAddressAndGroup(account.String(), group.String()).Return(tt.existing, tt.dbErr).Times(tt.expectDbCalls)
+
+			pl := &payload{
+				CurrentHeight: syncHeight,
+				Syncable: &model.Syncable{
+					Height: syncHeight,
+					Time:   syncTime,
+				},
+				RawTransactions:      tt.rawTxs,
+				VoterRewardSequences: tt.voterRewards,
+			}
+
+			task := NewVoterAggCreatorTask(dbMock)
+			if err := task.Run(context.Background(), pl); err != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+				return
+			}
+
+			if !reflect.DeepEqual(pl.NewVoterAggregates, tt.expectNew) {
+				t.Errorf("unexpected payload.NewVoterAggregates, want %+v; got %+v", tt.expectNew, pl.NewVoterAggregates)
+			}
+			if !reflect.DeepEqual(pl.UpdatedVoterAggregates, tt.expectUpdated) {
+				t.Errorf("unexpected payload.UpdatedVoterAggregates, want %+v; got %+v", tt.expectUpdated, pl.UpdatedVoterAggregates)
+			}
+		})
+	}
+}
@@ -23,26 +23,26 @@ const (
 
 var (
 	ErrGroupRewardOutsideOfRange = errors.New("group reward is outside of specified buckets")
-
-	MaxValidatorSequences int64 = 1000
-	MissedForMaxThreshold int64 = 50
-	MissedInRowThreshold  int64 = 50
 )
 
 // NewSystemEventCreatorTask creates system events
-func NewSystemEventCreatorTask(cfg *config.Config, validatorSeqDb store.ValidatorSeq, accountActivitySeqDb store.AccountActivitySeq, validatorGroupSeqDb store.ValidatorGroupSeq) *systemEventCreatorTask {
+func NewSystemEventCreatorTask(cfg *config.Config, validatorSeqDb store.ValidatorSeq, accountActivitySeqDb store.AccountActivitySeq, electedValidatorSeqDb store.ElectedValidatorSeq, validatorAggDb store.ValidatorAgg, validatorGroupAggDb store.ValidatorGroupAgg) *systemEventCreatorTask {
 	return &systemEventCreatorTask{
-		validatorSeqDb:       validatorSeqDb,
-		accountActivitySeqDb: accountActivitySeqDb,
-		validatorGroupSeqDb:  validatorGroupSeqDb,
-		cfg:                  cfg,
+		validatorSeqDb:        validatorSeqDb,
+		accountActivitySeqDb:  accountActivitySeqDb,
+		electedValidatorSeqDb: electedValidatorSeqDb,
+		validatorAggDb:        validatorAggDb,
+		validatorGroupAggDb:   validatorGroupAggDb,
+		cfg:                   cfg,
 	}
 }
 
 type systemEventCreatorTask struct {
-	validatorSeqDb       store.ValidatorSeq
-	accountActivitySeqDb store.AccountActivitySeq
-	validatorGroupSeqDb  store.ValidatorGroupSeq
+	validatorSeqDb        store.ValidatorSeq
+	accountActivitySeqDb  store.AccountActivitySeq
+	electedValidatorSeqDb store.ElectedValidatorSeq
+	validatorAggDb        store.ValidatorAgg
+	validatorGroupAggDb   store.ValidatorGroupAgg
 
 	cfg *config.Config
 }
@@ -83,7 +83,25 @@ func (t *systemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) er
 	}
 	payload.SystemEvents = append(payload.SystemEvents, activeSetPresenceChangeSystemEvents...)
 
-	missedBlocksSystemEvents, err := t.getMissedBlocksSystemEvents(currHeightValidatorSequences, currHeightValidatorGroupSequences)
+	prevEpochElectedValidatorSequences, err := t.getPrevEpochElectedValidatorSequences(payload)
+	if err != nil {
+		return err
+	}
+	electionChangeSystemEvents, err := t.getElectionChangeSystemEvents(payload.ElectedValidatorSequences, prevEpochElectedValidatorSequences)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, electionChangeSystemEvents...)
+
+	validatorAggs, err := t.getCurrHeightValidatorAggregates(payload)
+	if err != nil {
+		return err
+	}
+	validatorGroupAggs, err := t.getCurrHeightValidatorGroupAggregates(payload)
+	if err != nil {
+		return err
+	}
+	missedBlocksSystemEvents, err := t.getMissedBlocksSystemEvents(currHeightValidatorSequences, currHeightValidatorGroupSequences, validatorAggs, validatorGroupAggs)
 	if err != nil {
 		return err
 	}
@@ -123,196 +141,241 @@ func (t *systemEventCreatorTask) getPrevHeightValidatorSequences(payload *payloa
 	return prevHeightValidatorSequences, nil
 }
 
-func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidatorSequences []model.ValidatorSeq, currHeightValidatorGroupSequences []model.ValidatorGroupSeq) ([]model.SystemEvent, error) {
-	var systemEvents []model.SystemEvent
-	missedBlocksOfValidatorSequences, err := t.getMissedBlocksOfValidatorSequences(currHeightValidatorSequences)
-	if err != nil {
-		return nil, err
+// getPrevEpochElectedValidatorSequences returns validators elected for previous epoch when validators were elected at current height
+func (t *systemEventCreatorTask) getPrevEpochElectedValidatorSequences(payload *payload) ([]model.ElectedValidatorSeq, error) {
+	if len(payload.ElectedValidatorSequences) == 0 {
+		return nil, nil
 	}
-	systemEvents = append(systemEvents, missedBlocksOfValidatorSequences...)
 
-	missedBlocksOfValidatorGroupSequences, err := t.getMissedBlocksOfValidatorGroupSequences(currHeightValidatorGroupSequences)
+	prevEpoch := payload.ElectedValidatorSequences[0].Epoch - 1
+	prevEpochElectedValidatorSequences, err := t.electedValidatorSeqDb.FindByEpoch(prevEpoch)
 	if err != nil {
-		return nil, err
+		if err != psql.ErrNotFound {
+			return nil, err
+		}
 	}
-	systemEvents = append(systemEvents, missedBlocksOfValidatorGroupSequences...)
-
-	return systemEvents, nil
+	return prevEpochElectedValidatorSequences, nil
 }
 
-func (t systemEventCreatorTask) getMissedBlocksOfValidatorSequences(validatorSequences []model.ValidatorSeq) ([]model.SystemEvent, error) {
-	var systemEvents []model.SystemEvent
+// getElectionChangeSystemEvents compares validators elected for current and previous epoch. Unlike active set presence
+// changes, which are diffed per height, it reports election results once per epoch. Without previous epoch elected set nothing is reported
+func (t *systemEventCreatorTask) getElectionChangeSystemEvents(currEpochElectedValidatorSequences []model.ElectedValidatorSeq, prevEpochElectedValidatorSequences []model.ElectedValidatorSeq) ([]model.SystemEvent, error) {
+	if len(currEpochElectedValidatorSequences) == 0 || len(prevEpochElectedValidatorSequences) == 0 {
+		return nil, nil
+	}
 
-	for _, validatorSequence := range validatorSequences {
-		// When current height validator has validated the block no need to check last records
-		if validatorSequence.IsValidated() {
-			return systemEvents, nil
+	currEpochElected := make(map[string]bool)
+	for _, electedValidatorSequence := range currEpochElectedValidatorSequences {
+		currEpochElected[electedValidatorSequence.Address] = true
+	}
+
+	prevEpochElected := make(map[string]bool)
+	for _, electedValidatorSequence := range prevEpochElectedValidatorSequences {
+		prevEpochElected[electedValidatorSequence.Address] = true
+	}
+
+	var systemEvents []model.SystemEvent
+	for _, electedValidatorSequence := range currEpochElectedValidatorSequences {
+		if prevEpochElected[electedValidatorSequence.Address] {
+			continue
 		}
 
-		lastValidatorSequencesForAddress, err := t.validatorSeqDb.FindLastByAddress(validatorSequence.Address, MaxValidatorSequences)
-		if err != nil {
-			if err == psql.ErrNotFound {
-				return systemEvents, nil
-			} else {
-				return nil, err
-			}
-		} else {
-			var validatorSequencesToCheck []model.ValidatorSeq
-			validatorSequencesToCheck = append([]model.ValidatorSeq{validatorSequence}, lastValidatorSequencesForAddress...)
-			totalMissedCount := t.getTotalMissedValidators(validatorSequencesToCheck)
-
-			logger.Debug(fmt.Sprintf("total missed blocks for last %d blocks for address %s: %d", MaxValidatorSequences, validatorSequence.Address, totalMissedCount))
-
-			if totalMissedCount == MissedForMaxThreshold {
-				newSystemEvent, err := t.newSystemEvent(validatorSequence.Sequence, validatorSequence.Address, model.SystemEventMissedNofM, systemEventRawData{
-					"threshold":               MissedForMaxThreshold,
-					"max_validator_sequences": MaxValidatorSequences,
-				})
-				if err != nil {
-					return nil, err
-				}
+		logger.Debug(fmt.Sprintf("address %s elected for epoch %d", electedValidatorSequence.Address, electedValidatorSequence.Epoch))
 
-				systemEvents = append(systemEvents, *newSystemEvent)
-			}
+		newSystemEvent, err := t.newSystemEvent(electedValidatorSequence.Sequence, electedValidatorSequence.Address, model.SystemEventElected, systemEventRawData{
+			"epoch": electedValidatorSequence.Epoch,
+			"group": electedValidatorSequence.Group,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-			missedInRowCount := t.getMissedInRowOfValidatorSequences(validatorSequencesToCheck, MissedInRowThreshold)
+		systemEvents = append(systemEvents, *newSystemEvent)
+	}
 
-			logger.Debug(fmt.Sprintf("total missed blocks in a row for address %s: %d", validatorSequence.Address, missedInRowCount))
+	// Unelected validators are reported at height at which new elected set took place
+	currSequence := currEpochElectedValidatorSequences[0].Sequence
+	currEpoch := currEpochElectedValidatorSequences[0].Epoch
+	for _, electedValidatorSequence := range prevEpochElectedValidatorSequences {
+		if currEpochElected[electedValidatorSequence.Address] {
+			continue
+		}
 
-			if missedInRowCount == MissedInRowThreshold {
-				newSystemEvent, err := t.newSystemEvent(validatorSequence.Sequence, validatorSequence.Address, model.SystemEventMissedNConsecutive, systemEventRawData{
-					"threshold": MissedInRowThreshold,
-				})
-				if err != nil {
-					return nil, err
-				}
+		logger.Debug(fmt.Sprintf("address %s not elected for epoch %d", electedValidatorSequence.Address, currEpoch))
 
-				systemEvents = append(systemEvents, *newSystemEvent)
-			}
+		newSystemEvent, err := t.newSystemEvent(currSequence, electedValidatorSequence.Address, model.SystemEventUnelected, systemEventRawData{
+			"epoch": currEpoch,
+			"group": electedValidatorSequence.Group,
+		})
+		if err != nil {
+			return nil, err
 		}
+
+		systemEvents = append(systemEvents, *newSystemEvent)
 	}
+
 	return systemEvents, nil
 }
 
-func (t systemEventCreatorTask) getMissedBlocksOfValidatorGroupSequences(validatorGroupSequences []model.ValidatorGroupSeq) ([]model.SystemEvent, error) {
-	var systemEvents []model.SystemEvent
+// getCurrHeightValidatorAggregates returns validator aggregates created by aggregator of current height.
+// When aggregator is not run (ie. only system events are indexed), stored aggregates are used only if they were recorded at current height,
+// because recent missed blocks of aggregates recorded at other heights do not describe window ending at current height
+func (t *systemEventCreatorTask) getCurrHeightValidatorAggregates(payload *payload) ([]model.ValidatorAgg, error) {
+	validatorAggs := append(append([]model.ValidatorAgg{}, payload.NewValidatorAggregates...), payload.UpdatedValidatorAggregates...)
+	if len(validatorAggs) > 0 {
+		return validatorAggs, nil
+	}
 
-	for _, validatorGroupSequence := range validatorGroupSequences {
-		if validatorGroupSequence.IsValidated() {
-			return systemEvents, nil
-		}
+	storedValidatorAggs, err := t.validatorAggDb.All()
+	if err != nil {
+		return nil, err
+	}
 
-		lastValidatorGroupSequencesForAddress, err := t.validatorGroupSeqDb.FindLastByAddress(validatorGroupSequence.Address, MaxValidatorSequences)
-		if err != nil {
-			if err == psql.ErrNotFound {
-				return systemEvents, nil
-			} else {
-				return nil, err
-			}
-		} else {
-			var validatorGroupSequencesToCheck []model.ValidatorGroupSeq
-			validatorGroupSequencesToCheck = append([]model.ValidatorGroupSeq{validatorGroupSequence}, lastValidatorGroupSequencesForAddress...)
-			totalMissedCount := t.getTotalMissedValidatorGroups(validatorGroupSequencesToCheck)
-
-			logger.Debug(fmt.Sprintf("total missed blocks for last %d blocks for address %s: %d", MaxValidatorSequences, validatorGroupSequence.Address, totalMissedCount))
-
-			if totalMissedCount == MissedForMaxThreshold {
-				newSystemEvent, err := t.newSystemEvent(validatorGroupSequence.Sequence, validatorGroupSequence.Address, model.SystemEventMissedNofM, systemEventRawData{
-					"threshold":               MissedForMaxThreshold,
-					"max_validator_sequences": MaxValidatorSequences,
-				})
-				if err != nil {
-					return nil, err
-				}
+	for _, validatorAgg := range storedValidatorAggs {
+		if validatorAgg.RecentAtHeight == payload.CurrentHeight {
+			validatorAggs = append(validatorAggs, validatorAgg)
+		}
+	}
+	return validatorAggs, nil
+}
 
-				systemEvents = append(systemEvents, *newSystemEvent)
-			}
+// getCurrHeightValidatorGroupAggregates returns validator group aggregates created by aggregator of current height or stored at current height
+func (t *systemEventCreatorTask) getCurrHeightValidatorGroupAggregates(payload *payload) ([]model.ValidatorGroupAgg, error) {
+	validatorGroupAggs := append(append([]model.ValidatorGroupAgg{}, payload.NewValidatorGroupAggregates...), payload.UpdatedValidatorGroupAggregates...)
+	if len(validatorGroupAggs) > 0 {
+		return validatorGroupAggs, nil
+	}
 
-			missedInRowCount := t.getMissedInRowOfValidatorGroupSequences(validatorGroupSequencesToCheck, MissedInRowThreshold)
+	storedValidatorGroupAggs, err := t.validatorGroupAggDb.All()
+	if err != nil {
+		return nil, err
+	}
 
-			logger.Debug(fmt.Sprintf("total missed blocks in a row for address %s: %d", validatorGroupSequence.Address, missedInRowCount))
+	for _, validatorGroupAgg := range storedValidatorGroupAggs {
+		if validatorGroupAgg.RecentAtHeight == payload.CurrentHeight {
+			validatorGroupAggs = append(validatorGroupAggs, validatorGroupAgg)
+		}
+	}
+	return validatorGroupAggs, nil
+}
 
-			if missedInRowCount == MissedInRowThreshold {
-				newSystemEvent, err := t.newSystemEvent(validatorGroupSequence.Sequence, validatorGroupSequence.Address, model.SystemEventMissedNConsecutive, systemEventRawData{
-					"threshold": MissedInRowThreshold,
-				})
-				if err != nil {
-					return nil, err
-				}
+func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidatorSequences []model.ValidatorSeq, currHeightValidatorGroupSequences []model.ValidatorGroupSeq, validatorAggs []model.ValidatorAgg, validatorGroupAggs []model.ValidatorGroupAgg) ([]model.SystemEvent, error) {
+	var systemEvents []model.SystemEvent
+	missedBlocksOfValidatorSequences, err := t.getMissedBlocksOfValidatorSequences(currHeightValidatorSequences, validatorAggs)
+	if err != nil {
+		return nil, err
+	}
+	systemEvents = append(systemEvents, missedBlocksOfValidatorSequences...)
 
-				systemEvents = append(systemEvents, *newSystemEvent)
-			}
-		}
+	missedBlocksOfValidatorGroupSequences, err := t.getMissedBlocksOfValidatorGroupSequences(currHeightValidatorGroupSequences, validatorGroupAggs)
+	if err != nil {
+		return nil, err
 	}
+	systemEvents = append(systemEvents, missedBlocksOfValidatorGroupSequences...)
+
 	return systemEvents, nil
 }
 
-// getTotalMissedValidators get total missed count for given slice of validator sequences
-func (t systemEventCreatorTask) getTotalMissedValidators(validatorSequences []model.ValidatorSeq) int64 {
-	var totalMissedCount int64 = 0
+// getMissedBlocksOfValidatorSequences uses recent missed blocks kept in validator aggregates of current height
+func (t systemEventCreatorTask) getMissedBlocksOfValidatorSequences(validatorSequences []model.ValidatorSeq, validatorAggs []model.ValidatorAgg) ([]model.SystemEvent, error) {
+	validatorAggsMap := make(map[string]model.ValidatorAgg)
+	for _, validatorAgg := range validatorAggs {
+		validatorAggsMap[validatorAgg.Address] = validatorAgg
+	}
+
+	var systemEvents []model.SystemEvent
 	for _, validatorSequence := range validatorSequences {
-		if !validatorSequence.IsValidated() {
-			totalMissedCount += 1
+		// When current height validator has validated the block or was not elected no need to check recent blocks
+		if validatorSequence.Signed == nil || validatorSequence.IsValidated() {
+			continue
 		}
-	}
 
-	return totalMissedCount
-}
+		validatorAgg, ok := validatorAggsMap[validatorSequence.Address]
+		if !ok {
+			continue
+		}
 
-// getTotalMissedValidatorGroups get total missed count for given slice of validator group sequences
-func (t systemEventCreatorTask) getTotalMissedValidatorGroups(validatorGroupSequences []model.ValidatorGroupSeq) int64 {
-	var totalMissedCount int64 = 0
-	for _, validatorGroupSequence := range validatorGroupSequences {
-		if !validatorGroupSequence.IsValidated() {
-			totalMissedCount += 1
+		newSystemEvents, err := t.getMissedBlocksSystemEventsForActor(validatorSequence.Sequence, validatorSequence.Address, validatorAgg.RecentMissedBlocks, validatorAgg.MissedInRowCount)
+		if err != nil {
+			return nil, err
 		}
+		systemEvents = append(systemEvents, newSystemEvents...)
 	}
-
-	return totalMissedCount
+	return systemEvents, nil
 }
 
-// getMissedInRowOfValidatorSequences get number of validator sequences missed in the row
-func (t systemEventCreatorTask) getMissedInRowOfValidatorSequences(validatorSequences []model.ValidatorSeq, limit int64) int64 {
-	if int64(len(validatorSequences)) > MissedInRowThreshold {
-		validatorSequences = validatorSequences[:limit]
+// getMissedBlocksOfValidatorGroupSequences uses recent missed blocks kept in validator group aggregates of current height
+func (t systemEventCreatorTask) getMissedBlocksOfValidatorGroupSequences(validatorGroupSequences []model.ValidatorGroupSeq, validatorGroupAggs []model.ValidatorGroupAgg) ([]model.SystemEvent, error) {
+	validatorGroupAggsMap := make(map[string]model.ValidatorGroupAgg)
+	for _, validatorGroupAgg := range validatorGroupAggs {
+		validatorGroupAggsMap[validatorGroupAgg.Address] = validatorGroupAgg
 	}
 
-	var missedInRowCount int64 = 0
-	prevValidated := false
-	for _, validatorSequence := range validatorSequences {
-		if !validatorSequence.IsValidated() {
-			if !prevValidated {
-				missedInRowCount += 1
-			}
-			prevValidated = false
-		} else {
-			prevValidated = true
+	var systemEvents []model.SystemEvent
+	for _, validatorGroupSequence := range validatorGroupSequences {
+		if validatorGroupSequence.IsValidated() {
+			continue
 		}
-	}
 
-	return missedInRowCount
-}
+		validatorGroupAgg, ok := validatorGroupAggsMap[validatorGroupSequence.Address]
+		if !ok {
+			continue
+		}
 
-// getMissedInRowOfValidatorGroupSequences get number of validator group sequences missed in the row
-func (t systemEventCreatorTask) getMissedInRowOfValidatorGroupSequences(validatorSequences []model.ValidatorGroupSeq, limit int64) int64 {
-	if int64(len(validatorSequences)) > MissedInRowThreshold {
-		validatorSequences = validatorSequences[:limit]
+		newSystemEvents, err := t.getMissedBlocksSystemEventsForActor(validatorGroupSequence.Sequence, validatorGroupSequence.Address, validatorGroupAgg.RecentMissedBlocks, validatorGroupAgg.MissedInRowCount)
+		if err != nil {
+			return nil, err
+		}
+		systemEvents = append(systemEvents, newSystemEvents...)
 	}
+	return systemEvents, nil
+}
 
-	var missedInRowCount int64 = 0
-	prevValidated := false
-	for _, validatorSequence := range validatorSequences {
-		if !validatorSequence.IsValidated() {
-			if !prevValidated {
-				missedInRowCount += 1
+// getMissedBlocksSystemEventsForActor creates system events for all missed blocks rules and missed in row threshold reached by actor
+func (t systemEventCreatorTask) getMissedBlocksSystemEventsForActor(seq *model.Sequence, actor string, recentMissedBlocks types.BitWindow, missedInRowCount int64) ([]model.SystemEvent, error) {
+	var systemEvents []model.SystemEvent
+
+	for _, rule := range t.cfg.GetMissedBlocksRules() {
+		totalMissedCount := recentMissedBlocks.Count(rule.Window)
+
+		logger.Debug(fmt.Sprintf("total missed blocks for last %d blocks for address %s: %d", rule.Window, actor, totalMissedCount))
+
+		if totalMissedCount == rule.Missed {
+			newSystemEvent, err := t.newSystemEvent(seq, actor, model.NewMissedNofMKind(rule.Name), getMissedNofMRawData(rule))
+			if err != nil {
+				return nil, err
 			}
-			prevValidated = false
-		} else {
-			prevValidated = true
+
+			systemEvents = append(systemEvents, *newSystemEvent)
 		}
 	}
 
-	return missedInRowCount
+	logger.Debug(fmt.Sprintf("total missed blocks in a row for address %s: %d", actor, missedInRowCount))
+
+	if missedInRowCount == t.cfg.MissedInRowThreshold {
+		newSystemEvent, err := t.newSystemEvent(seq, actor, model.SystemEventMissedNConsecutive, systemEventRawData{
+			"threshold": t.cfg.MissedInRowThreshold,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		systemEvents = append(systemEvents, *newSystemEvent)
+	}
+
+	return systemEvents, nil
+}
+
+// getMissedNofMRawData returns data of missed blocks system event with thresholds of rule which created it
+func getMissedNofMRawData(rule config.MissedBlocksRule) systemEventRawData {
+	data := systemEventRawData{
+		"threshold":               rule.Missed,
+		"max_validator_sequences": rule.Window,
+	}
+	if rule.Name != "" {
+		data["rule"] = rule.Name
+	}
+	return data
 }
 
 func (t *systemEventCreatorTask) getActiveSetPresenceChangeSystemEvents(currHeightValidatorSequences []model.ValidatorSeq, prevHeightValidatorSequences []model.ValidatorSeq) ([]model.SystemEvent, error) {
@@ -448,21 +511,28 @@ func (t *systemEventCreatorTask) getGroupRewardDistributedChange(currValue int64
 	roundedChangeRate := t.getRoundedChangeRate(currValue, prevValue)
 	roundedAbsChangeRate := math.Abs(roundedChangeRate)
 
+	thresholds := t.cfg.GroupRewardChangeThresholds
+
 	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
+	var threshold float64
+	if roundedAbsChangeRate >= thresholds[0] && roundedAbsChangeRate < thresholds[1] {
 		kind = model.SystemEventGroupRewardChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
+		threshold = thresholds[0]
+	} else if roundedAbsChangeRate >= thresholds[1] && roundedAbsChangeRate < thresholds[2] {
 		kind = model.SystemEventGroupRewardChange2
-	} else if roundedAbsChangeRate >= 10 {
+		threshold = thresholds[1]
+	} else if roundedAbsChangeRate >= thresholds[2] {
 		kind = model.SystemEventGroupRewardChange3
+		threshold = thresholds[2]
 	} else {
 		return nil, ErrGroupRewardOutsideOfRange
 	}
 
 	data := systemEventRawData{
-		"before": prevValue,
-		"after":  currValue,
-		"change": roundedChangeRate,
+		"before":    prevValue,
+		"after":     currValue,
+		"change":    roundedChangeRate,
+		"threshold": threshold,
 	}
 	marshaledData, err := json.Marshal(data)
 	if err != nil {
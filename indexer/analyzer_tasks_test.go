@@ -1,10 +1,11 @@
 package indexer
 
 import (
+	"encoding/json"
+
 	"github.com/figment-networks/celo-indexer/config"
 	mock "github.com/figment-networks/celo-indexer/mock/store"
 	"github.com/figment-networks/celo-indexer/model"
-	"github.com/figment-networks/celo-indexer/store/psql"
 	"github.com/figment-networks/celo-indexer/types"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -23,7 +24,8 @@ var (
 	ErrCouldNotFindByAddress = errors.New("could not find test")
 
 	testCfg = &config.Config{
-		FirstBlockHeight: 1,
+		FirstBlockHeight:            1,
+		GroupRewardChangeThresholds: []float64{0.1, 1, 10},
 	}
 )
 
@@ -103,7 +105,7 @@ func TestSystemEventCreatorTask_GroupRewardDistributedChangeSystemEvents(t *test
 				Height: currSyncable.Height,
 				Time:   currSyncable.Time,
 			}
-			task := NewSystemEventCreatorTask(testCfg, nil, nil, nil)
+			task := NewSystemEventCreatorTask(testCfg, nil, nil, nil, nil, nil)
 			createdSystemEvents, _ := task.getValueChangeForAccountActivityByKind(heightMeta, currHeightAccountActivitySequences, prevHeightAccountActivitySequences, OperationTypeValidatorEpochPaymentDistributedForGroup)
 
 			if len(createdSystemEvents) != tt.expectedCount {
@@ -254,7 +256,7 @@ func TestSystemEventCreatorTask_getActiveSetPresenceChangeSystemEvents(t *testin
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			task := NewSystemEventCreatorTask(testCfg, nil, nil, nil)
+			task := NewSystemEventCreatorTask(testCfg, nil, nil, nil, nil, nil)
 			createdSystemEvents, _ := task.getActiveSetPresenceChangeSystemEvents(tt.currSeqs, tt.prevSeqs)
 
 			if len(createdSystemEvents) != tt.expectedCount {
@@ -271,332 +273,337 @@ func TestSystemEventCreatorTask_getActiveSetPresenceChangeSystemEvents(t *testin
 	}
 }
 
+func TestSystemEventCreatorTask_getElectionChangeSystemEvents(t *testing.T) {
+	currSeq := &model.Sequence{
+		Height: 20,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+	prevSeq := &model.Sequence{
+		Height: 10,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 22, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		description    string
+		prevSeqs       []model.ElectedValidatorSeq
+		currSeqs       []model.ElectedValidatorSeq
+		expectedKinds  []model.SystemEventKind
+		expectedActors []string
+	}{
+		{
+			description: "returns no system events when previous epoch elected set is unknown",
+			currSeqs: []model.ElectedValidatorSeq{
+				{Sequence: currSeq, Epoch: 2, Address: testValidatorAddress},
+			},
+		},
+		{
+			description: "returns no system events when validator is elected for both epochs",
+			prevSeqs: []model.ElectedValidatorSeq{
+				{Sequence: prevSeq, Epoch: 1, Address: testValidatorAddress},
+			},
+			currSeqs: []model.ElectedValidatorSeq{
+				{Sequence: currSeq, Epoch: 2, Address: testValidatorAddress},
+			},
+		},
+		{
+			description: "returns elected and unelected system events when elected set changes",
+			prevSeqs: []model.ElectedValidatorSeq{
+				{Sequence: prevSeq, Epoch: 1, Address: testValidatorAddress},
+				{Sequence: prevSeq, Epoch: 1, Address: "address1"},
+			},
+			currSeqs: []model.ElectedValidatorSeq{
+				{Sequence: currSeq, Epoch: 2, Address: testValidatorAddress},
+				{Sequence: currSeq, Epoch: 2, Address: "address2"},
+			},
+			expectedKinds:  []model.SystemEventKind{model.SystemEventElected, model.SystemEventUnelected},
+			expectedActors: []string{"address2", "address1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			task := NewSystemEventCreatorTask(testCfg, nil, nil, nil, nil, nil)
+			createdSystemEvents, err := task.getElectionChangeSystemEvents(tt.currSeqs, tt.prevSeqs)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(createdSystemEvents) != len(tt.expectedKinds) {
+				t.Errorf("unexpected system event count, want %v; got %v", len(tt.expectedKinds), len(createdSystemEvents))
+				return
+			}
+
+			for i, kind := range tt.expectedKinds {
+				if createdSystemEvents[i].Kind != kind || createdSystemEvents[i].Actor != tt.expectedActors[i] {
+					t.Errorf("unexpected system event, want %v of %v; got %v of %v", kind, tt.expectedActors[i], createdSystemEvents[i].Kind, createdSystemEvents[i].Actor)
+				}
+				if createdSystemEvents[i].Height != currSeq.Height {
+					t.Errorf("unexpected system event height, want %v; got %v", currSeq.Height, createdSystemEvents[i].Height)
+				}
+			}
+		})
+	}
+}
+
 func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorSequences(t *testing.T) {
 	tests := []struct {
 		description           string
 		maxValidatorSequences int64
 		missedInRowThreshold  int64
 		missedForMaxThreshold int64
-		prevHeightList        []model.ValidatorSeq
 		currHeightList        []model.ValidatorSeq
-		lastForValidatorList  [][]model.ValidatorSeq
-		errs                  []error
+		aggregates            []model.ValidatorAgg
 		expectedCount         int
 		expectedKinds         []model.SystemEventKind
-		expectedErr           error
 	}{
 		{
-			description:           "returns no system events when validator does not have any previous sequences in db",
+			description:           "returns no system events when validator does not have aggregate",
 			maxValidatorSequences: 5,
 			missedInRowThreshold:  2,
 			missedForMaxThreshold: 2,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{},
-			},
 			expectedCount: 0,
 		},
 		{
-			description:           "returns no system events when validator does not have any missed blocks in a row",
+			description:           "returns no system events when validator missed 2 blocks in a row",
 			maxValidatorSequences: 5,
-			missedInRowThreshold:  2,
-			missedForMaxThreshold: 2,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
+			missedInRowThreshold:  3,
+			missedForMaxThreshold: 5,
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11000", 2),
 			},
 			expectedCount: 0,
 		},
 		{
-			description:           "returns no system events when validator missed 2 blocks in a row",
+			description:           "returns one missed_n_consecutive system events when validator missed 3 blocks in a row",
 			maxValidatorSequences: 5,
 			missedInRowThreshold:  3,
 			missedForMaxThreshold: 5,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11100", 3),
 			},
-			expectedCount: 0,
+			expectedCount: 1,
+			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNConsecutive},
 		},
 		{
-			description:           "returns one missed_n_consecutive system events when validator missed >= 3 blocks in a row",
-			maxValidatorSequences: 5,
+			description:           "returns one missed_n_consecutive system events when validator missed more blocks in a row than fit in window",
+			maxValidatorSequences: 2,
 			missedInRowThreshold:  3,
 			missedForMaxThreshold: 5,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11", 3),
 			},
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNConsecutive},
 		},
 		{
-			description:           "returns no missed_n_consecutive system events when validator missed >= 3 blocks in a row in the past but current is validated",
+			description:           "returns no missed_n_consecutive system events when validator already missed more blocks in a row than threshold",
 			maxValidatorSequences: 5,
 			missedInRowThreshold:  3,
 			missedForMaxThreshold: 5,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
+				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11110", 4),
 			},
 			expectedCount: 0,
 		},
 		{
-			description:           "returns one missed_n_of_m system events when validator missed 3 blocks",
+			description:           "returns no system events when current is validated",
 			maxValidatorSequences: 5,
-			missedInRowThreshold:  50,
+			missedInRowThreshold:  3,
 			missedForMaxThreshold: 3,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, false),
-			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
-			},
-			expectedCount: 1,
-			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
-		},
-		{
-			description:           "returns one missed_n_of_m system events when validator missed 3 blocks and max < last list",
-			maxValidatorSequences: 3,
-			missedInRowThreshold:  50,
-			missedForMaxThreshold: 3,
-			prevHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, true),
 			},
-			currHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, false),
-			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "01110", 0),
 			},
-			expectedCount: 1,
-			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
+			expectedCount: 0,
 		},
 		{
-			description:           "returns no missed_n_of_m system events when count of recent not validated > maxValidatorSequences",
+			description:           "returns no system events when current is not elected",
 			maxValidatorSequences: 5,
-			missedInRowThreshold:  50,
-			missedForMaxThreshold: 3,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
+			missedInRowThreshold:  3,
+			missedForMaxThreshold: 5,
 			currHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, false),
+				{Sequence: &model.Sequence{Height: testHeight}, Address: testValidatorAddress},
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11100", 3),
 			},
 			expectedCount: 0,
 		},
 		{
-			description:           "returns no missed_n_of_m system events when current is validated",
+			description:           "returns one missed_n_of_m system events when validator missed 3 blocks",
 			maxValidatorSequences: 5,
 			missedInRowThreshold:  50,
 			missedForMaxThreshold: 3,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
+				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-				},
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11010", 2),
 			},
-			expectedCount: 0,
+			expectedCount: 1,
+			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
 		{
-			description:           "returns error when first call to FindLastByAddress fails",
+			description:           "returns one missed_n_of_m system events when validator missed 3 blocks and max < window length",
 			maxValidatorSequences: 3,
 			missedInRowThreshold:  50,
 			missedForMaxThreshold: 3,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				nil,
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11101", 3),
 			},
-			errs:          []error{ErrCouldNotFindByAddress},
-			expectedCount: 0,
-			expectedErr:   ErrCouldNotFindByAddress,
+			expectedCount: 1,
+			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
 		{
-			description:           "returns error when second call to FindLastByAddress fails",
+			description:           "returns no missed_n_of_m system events when count of recent not validated > missedForMaxThreshold",
 			maxValidatorSequences: 5,
-			missedInRowThreshold:  3,
-			missedForMaxThreshold: 5,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-				newValidatorSeq("address1", 1000, false),
-			},
+			missedInRowThreshold:  50,
+			missedForMaxThreshold: 3,
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
-				newValidatorSeq("address1", 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
-				nil,
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "11111", 5),
 			},
-			errs:          []error{nil, ErrCouldNotFindByAddress},
 			expectedCount: 0,
-			expectedErr:   ErrCouldNotFindByAddress,
 		},
 		{
-			description:           "returns partial system events when second call to FindLastByAddress fails with ErrNotFound",
+			description:           "returns system events only for validators with aggregates",
 			maxValidatorSequences: 3,
 			missedInRowThreshold:  50,
 			missedForMaxThreshold: 3,
-			prevHeightList: []model.ValidatorSeq{
-				newValidatorSeq(testValidatorAddress, 1000, true),
-				newValidatorSeq("address1", 1000, true),
-			},
 			currHeightList: []model.ValidatorSeq{
 				newValidatorSeq(testValidatorAddress, 1000, false),
 				newValidatorSeq("address1", 1000, false),
 			},
-			lastForValidatorList: [][]model.ValidatorSeq{
-				{
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, false),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-					newValidatorSeq(testValidatorAddress, 1000, true),
-				},
-				nil,
+			aggregates: []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, "111", 3),
 			},
-			errs:          []error{nil, psql.ErrNotFound},
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
 	}
 
 	for _, tt := range tests {
+		tt := tt
 		t.Run(tt.description, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			validatorSeqStoreMock := mock.NewMockValidatorSeq(ctrl)
-			accountActivitySeqStoreMock := mock.NewMockAccountActivitySeq(ctrl)
+			t.Parallel()
 
-			MaxValidatorSequences = tt.maxValidatorSequences
-			MissedInRowThreshold = tt.missedInRowThreshold
-			MissedForMaxThreshold = tt.missedForMaxThreshold
+			cfg := &config.Config{
+				FirstBlockHeight:      testCfg.FirstBlockHeight,
+				MaxValidatorSequences: tt.maxValidatorSequences,
+				MissedInRowThreshold:  tt.missedInRowThreshold,
+				MissedForMaxThreshold: tt.missedForMaxThreshold,
+			}
 
-			var mockCalls []*gomock.Call
-			for i, validatorSeqs := range tt.lastForValidatorList {
-				validatorSeq := tt.currHeightList[i]
-				if validatorSeq.Signed == nil || !*validatorSeq.Signed {
-					call := validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any())
+			task := NewSystemEventCreatorTask(cfg, nil, nil, nil, nil, nil)
+			createdSystemEvents, err := task.getMissedBlocksOfValidatorSequences(tt.currHeightList, tt.aggregates)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
 
-					if len(tt.errs) >= i+1 && tt.errs[i] != nil {
-						call = call.Return(nil, tt.errs[i])
-					} else {
-						call = call.Return(validatorSeqs, nil)
-					}
+			if len(createdSystemEvents) != tt.expectedCount {
+				t.Errorf("unexpected system event count, want %v; got %v", tt.expectedCount, len(createdSystemEvents))
+				return
+			}
 
-					mockCalls = append(mockCalls, call)
+			for i, kind := range tt.expectedKinds {
+				if createdSystemEvents[i].Kind != kind {
+					t.Errorf("unexpected system event kind, want %v; got %v", kind, createdSystemEvents[i].Kind)
 				}
 			}
-			gomock.InOrder(mockCalls...)
+		})
+	}
+}
 
-			task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock, accountActivitySeqStoreMock, nil)
-			createdSystemEvents, err := task.getMissedBlocksOfValidatorSequences(tt.currHeightList)
-			if err == nil && tt.expectedErr != nil {
-				t.Errorf("should return error")
-				return
+func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForMissedBlocksRules(t *testing.T) {
+	rules := config.MissedBlocksRules{
+		{Name: "2_of_3", Missed: 2, Window: 3},
+		{Name: "3_of_5", Missed: 3, Window: 5},
+	}
+
+	tests := []struct {
+		description        string
+		recentMissedBlocks types.BitWindow
+		expectedKinds      []model.SystemEventKind
+	}{
+		{
+			description:        "returns system event for every matching rule",
+			recentMissedBlocks: "11001",
+			expectedKinds:      []model.SystemEventKind{"missed_n_of_m_2_of_3", "missed_n_of_m_3_of_5"},
+		},
+		{
+			description:        "returns system event only for rule which window contains enough missed blocks",
+			recentMissedBlocks: "10011",
+			expectedKinds:      []model.SystemEventKind{"missed_n_of_m_3_of_5"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{
+				FirstBlockHeight:     testCfg.FirstBlockHeight,
+				MissedInRowThreshold: 50,
+				MissedBlocksRules:    rules,
 			}
-			if err != nil && tt.expectedErr != err {
-				t.Errorf("unexpected error, want %v; got %v", tt.expectedErr, err)
+
+			task := NewSystemEventCreatorTask(cfg, nil, nil, nil, nil, nil)
+			createdSystemEvents, err := task.getMissedBlocksOfValidatorSequences([]model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, false),
+			}, []model.ValidatorAgg{
+				newValidatorAgg(testValidatorAddress, tt.recentMissedBlocks, 1),
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
 				return
 			}
 
-			if len(createdSystemEvents) != tt.expectedCount {
-				t.Errorf("unexpected system event count, want %v; got %v", tt.expectedCount, len(createdSystemEvents))
+			if len(createdSystemEvents) != len(tt.expectedKinds) {
+				t.Errorf("unexpected system event count, want %v; got %v", len(tt.expectedKinds), len(createdSystemEvents))
 				return
 			}
 
 			for i, kind := range tt.expectedKinds {
-				if len(createdSystemEvents) > 0 && createdSystemEvents[i].Kind != kind {
+				if createdSystemEvents[i].Kind != kind {
 					t.Errorf("unexpected system event kind, want %v; got %v", kind, createdSystemEvents[i].Kind)
 				}
+
+				var data map[string]interface{}
+				if err := json.Unmarshal(createdSystemEvents[i].Data.RawMessage, &data); err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				if model.NewMissedNofMKind(data["rule"].(string)) != kind {
+					t.Errorf("unexpected rule in system event data: %v", data["rule"])
+				}
 			}
 		})
 	}
@@ -614,6 +621,73 @@ func newValidatorSeq(address string, score int64, signed bool) model.ValidatorSe
 	}
 }
 
+func newValidatorAgg(address string, recentMissedBlocks types.BitWindow, missedInRowCount int64) model.ValidatorAgg {
+	return model.ValidatorAgg{
+		Address:            address,
+		RecentMissedBlocks: recentMissedBlocks,
+		MissedInRowCount:   missedInRowCount,
+	}
+}
+
+func TestSystemEventCreatorTask_getCurrHeightValidatorAggregates(t *testing.T) {
+	storedAgg := func(address string, recentAtHeight int64) model.ValidatorAgg {
+		agg := newValidatorAgg(address, "1", 1)
+		agg.Aggregate = &model.Aggregate{RecentAtHeight: recentAtHeight}
+		return agg
+	}
+
+	tests := []struct {
+		description     string
+		payloadAggs     []model.ValidatorAgg
+		storedAggs      []model.ValidatorAgg
+		expectDbCall    bool
+		expectAddresses []string
+	}{
+		{
+			description:     "returns aggregates created by aggregator",
+			payloadAggs:     []model.ValidatorAgg{storedAgg("address1", testHeight)},
+			expectAddresses: []string{"address1"},
+		},
+		{
+			description:     "returns stored aggregates recorded at current height when aggregator is not run",
+			storedAggs:      []model.ValidatorAgg{storedAgg("address1", testHeight), storedAgg("address2", testHeight+10)},
+			expectDbCall:    true,
+			expectAddresses: []string{"address1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorAggDbMock := mock.NewMockValidatorAgg(ctrl)
+			if tt.expectDbCall {
+				validatorAggDbMock.EXPECT().All().Return(tt.storedAggs, nil).Times(1)
+			}
+
+			task := NewSystemEventCreatorTask(testCfg, nil, nil, nil, validatorAggDbMock, nil)
+			validatorAggs, err := task.getCurrHeightValidatorAggregates(&payload{CurrentHeight: testHeight, UpdatedValidatorAggregates: tt.payloadAggs})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(validatorAggs) != len(tt.expectAddresses) {
+				t.Errorf("unexpected aggregates count, want %v; got %v", len(tt.expectAddresses), len(validatorAggs))
+				return
+			}
+			for i, address := range tt.expectAddresses {
+				if validatorAggs[i].Address != address {
+					t.Errorf("unexpected aggregate address, want %v; got %v", address, validatorAggs[i].Address)
+				}
+			}
+		})
+	}
+}
+
 func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorGroupSequences(t *testing.T) {
 	tests := []struct {
 		description                string
@@ -621,42 +695,18 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorGroupSequ
 		missedInRowThreshold       int64
 		missedForMaxThreshold      int64
 		currHeightList             []model.ValidatorGroupSeq
-		lastForValidatorGroupList  [][]model.ValidatorGroupSeq
-		errs                       []error
+		aggregates                 []model.ValidatorGroupAgg
 		expectedCount              int
 		expectedKinds              []model.SystemEventKind
-		expectedErr                error
 	}{
 		{
-			description:                "returns no system events when validator group does not have any previous sequences in db",
+			description:                "returns no system events when validator group does not have aggregate",
 			maxValidatorGroupSequences: 5,
 			missedInRowThreshold:       2,
 			missedForMaxThreshold:      2,
 			currHeightList: []model.ValidatorGroupSeq{
 				newValidatorGroupSeq(testValidatorGroupAddress, 10, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{},
-			},
-			expectedCount: 0,
-		},
-		{
-			description:                "returns no system events when validator group does not have any missed blocks in a row",
-			maxValidatorGroupSequences: 5,
-			missedInRowThreshold:       2,
-			missedForMaxThreshold:      2,
-			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
-			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-				},
-			},
 			expectedCount: 0,
 		},
 		{
@@ -667,50 +717,35 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorGroupSequ
 			currHeightList: []model.ValidatorGroupSeq{
 				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-				},
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "11000", 2),
 			},
 			expectedCount: 0,
 		},
 		{
-			description:                "returns one missed_n_consecutive system events when validator group missed >= 3 blocks in a row",
+			description:                "returns one missed_n_consecutive system events when validator group missed 3 blocks in a row",
 			maxValidatorGroupSequences: 5,
 			missedInRowThreshold:       3,
 			missedForMaxThreshold:      5,
 			currHeightList: []model.ValidatorGroupSeq{
 				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
-				},
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "11100", 3),
 			},
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNConsecutive},
 		},
 		{
-			description:                "returns no missed_n_consecutive system events when validator group missed >= 3 blocks in a row in the past but current is validated",
+			description:                "returns no system events when current is validated",
 			maxValidatorGroupSequences: 5,
 			missedInRowThreshold:       3,
-			missedForMaxThreshold:      5,
+			missedForMaxThreshold:      3,
 			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
+				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0.1),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-				},
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "01110", 0),
 			},
 			expectedCount: 0,
 		},
@@ -720,175 +755,74 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorGroupSequ
 			missedInRowThreshold:       50,
 			missedForMaxThreshold:      3,
 			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0),
+				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-				},
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "11010", 2),
 			},
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
 		{
-			description:                "returns one missed_n_of_m system events when validator group missed 3 blocks and max < last list",
+			description:                "returns one missed_n_of_m system events when validator group missed 3 blocks and max < window length",
 			maxValidatorGroupSequences: 3,
 			missedInRowThreshold:       50,
 			missedForMaxThreshold:      3,
 			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0),
+				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-				},
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "11101", 3),
 			},
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
 		{
-			description:                "returns no missed_n_of_m system events when count of recent not validated > maxValidatorGroupSequences",
-			maxValidatorGroupSequences: 5,
-			missedInRowThreshold:       50,
-			missedForMaxThreshold:      3,
-			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-				},
-			},
-			expectedCount: 0,
-		},
-		{
-			description:                "returns no missed_n_of_m system events when current is validated",
+			description:                "returns no missed_n_of_m system events when count of recent not validated > missedForMaxThreshold",
 			maxValidatorGroupSequences: 5,
 			missedInRowThreshold:       50,
 			missedForMaxThreshold:      3,
 			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-				},
-			},
-			expectedCount: 0,
-		},
-		{
-			description:                "returns error when first call to FindLastByAddress fails",
-			maxValidatorGroupSequences: 3,
-			missedInRowThreshold:       50,
-			missedForMaxThreshold:      3,
-			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				nil,
-			},
-			errs:          []error{ErrCouldNotFindByAddress},
-			expectedCount: 0,
-			expectedErr:   ErrCouldNotFindByAddress,
-		},
-		{
-			description:                "returns error when second call to FindLastByAddress fails",
-			maxValidatorGroupSequences: 5,
-			missedInRowThreshold:       3,
-			missedForMaxThreshold:      5,
-			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-				newValidatorGroupSeq("address1", 1000, 0),
+				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-				},
-				nil,
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "11111", 5),
 			},
-			errs:          []error{nil, ErrCouldNotFindByAddress},
 			expectedCount: 0,
-			expectedErr:   ErrCouldNotFindByAddress,
 		},
 		{
-			description:                "returns partial system events when second call to FindLastByAddress fails with ErrNotFound",
+			description:                "returns system events only for validator groups with aggregates",
 			maxValidatorGroupSequences: 3,
 			missedInRowThreshold:       50,
 			missedForMaxThreshold:      3,
 			currHeightList: []model.ValidatorGroupSeq{
-				newValidatorGroupSeq(testValidatorAddress, 1000, 0),
+				newValidatorGroupSeq(testValidatorGroupAddress, 1000, 0),
 				newValidatorGroupSeq("address1", 1000, 0),
 			},
-			lastForValidatorGroupList: [][]model.ValidatorGroupSeq{
-				{
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-					newValidatorGroupSeq(testValidatorAddress, 1000, 0.1),
-				},
-				nil,
+			aggregates: []model.ValidatorGroupAgg{
+				newValidatorGroupAgg(testValidatorGroupAddress, "111", 3),
 			},
-			errs:          []error{nil, psql.ErrNotFound},
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
 	}
 
 	for _, tt := range tests {
+		tt := tt
 		t.Run(tt.description, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			validatorGroupSeqStoreMock := mock.NewMockValidatorGroupSeq(ctrl)
-			accountActivitySeqStoreMock := mock.NewMockAccountActivitySeq(ctrl)
-
-			MaxValidatorSequences = tt.maxValidatorGroupSequences
-			MissedInRowThreshold = tt.missedInRowThreshold
-			MissedForMaxThreshold = tt.missedForMaxThreshold
-
-			var mockCalls []*gomock.Call
-			for i, validatorGroupSeqs := range tt.lastForValidatorGroupList {
-				validatorGroupSeq := tt.currHeightList[i]
-				if !validatorGroupSeq.IsValidated() {
-					call := validatorGroupSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any())
-
-					if len(tt.errs) >= i+1 && tt.errs[i] != nil {
-						call = call.Return(nil, tt.errs[i])
-					} else {
-						call = call.Return(validatorGroupSeqs, nil)
-					}
+			t.Parallel()
 
-					mockCalls = append(mockCalls, call)
-				}
+			cfg := &config.Config{
+				FirstBlockHeight:      testCfg.FirstBlockHeight,
+				MaxValidatorSequences: tt.maxValidatorGroupSequences,
+				MissedInRowThreshold:  tt.missedInRowThreshold,
+				MissedForMaxThreshold: tt.missedForMaxThreshold,
 			}
-			gomock.InOrder(mockCalls...)
 
-			task := NewSystemEventCreatorTask(testCfg, nil, accountActivitySeqStoreMock, validatorGroupSeqStoreMock)
-			createdSystemEvents, err := task.getMissedBlocksOfValidatorGroupSequences(tt.currHeightList)
-			if err == nil && tt.expectedErr != nil {
-				t.Errorf("should return error")
-				return
-			}
-			if err != nil && tt.expectedErr != err {
-				t.Errorf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			task := NewSystemEventCreatorTask(cfg, nil, nil, nil, nil, nil)
+			createdSystemEvents, err := task.getMissedBlocksOfValidatorGroupSequences(tt.currHeightList, tt.aggregates)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
 				return
 			}
 
@@ -898,7 +832,7 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorGroupSequ
 			}
 
 			for i, kind := range tt.expectedKinds {
-				if len(createdSystemEvents) > 0 && createdSystemEvents[i].Kind != kind {
+				if createdSystemEvents[i].Kind != kind {
 					t.Errorf("unexpected system event kind, want %v; got %v", kind, createdSystemEvents[i].Kind)
 				}
 			}
@@ -906,6 +840,14 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEventsForValidatorGroupSequ
 	}
 }
 
+func newValidatorGroupAgg(address string, recentMissedBlocks types.BitWindow, missedInRowCount int64) model.ValidatorGroupAgg {
+	return model.ValidatorGroupAgg{
+		Address:            address,
+		RecentMissedBlocks: recentMissedBlocks,
+		MissedInRowCount:   missedInRowCount,
+	}
+}
+
 func newValidatorGroupSeq(address string, membersCount int, membersAvgSigned float64) model.ValidatorGroupSeq {
 	return model.ValidatorGroupSeq{
 		Sequence: &model.Sequence{
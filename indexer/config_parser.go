@@ -19,6 +19,8 @@ const (
 
 var (
 	_ ConfigParser = (*configParser)(nil)
+
+	ErrTargetNotFound = errors.New("target does not exist")
 )
 
 type ConfigParser interface {
@@ -204,7 +206,7 @@ func (o *configParser) getTasksByTargetId(targetId int64) ([]pipeline.TaskName,
 			return getUniqueTaskNames(t.Tasks), nil
 		}
 	}
-	return nil, errors.New(fmt.Sprintf("target id %d does not exists", targetId))
+	return nil, errors.Wrap(ErrTargetNotFound, fmt.Sprintf("invalid target id %d", targetId))
 }
 
 // appendSharedTasks appends shared tasks
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/figment-networks/celo-indexer/utils/test"
+	"github.com/figment-networks/indexing-engine/pipeline"
 )
 
 func TestConfigParser(t *testing.T) {
@@ -508,3 +509,47 @@ func TestConfigParser_IsAnyVersionSequential(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigParser_SystemEventsTarget(t *testing.T) {
+	parser, err := NewConfigParser("../indexer_config.json")
+	if err != nil {
+		t.Fatalf("NewConfigParser should not return error: err=%+v", err)
+	}
+
+	tasks, err := parser.GetTasksByTargetIds([]int64{7})
+	if err != nil {
+		t.Fatalf("GetTasksByTargetIds should not return error: err=%+v", err)
+	}
+
+	// Missed blocks of validators and groups are detected from sequences of current height
+	required := []pipeline.TaskName{
+		TaskNameBlockFetcher,
+		TaskNameValidatorsFetcher,
+		TaskNameValidatorGroupsFetcher,
+		ValidatorSignersSyncerTaskName,
+		ValidatorSeqCreatorTaskName,
+		ValidatorGroupSeqCreatorTaskName,
+		TaskNameSystemEventCreator,
+	}
+	for _, name := range required {
+		var found bool
+		for _, task := range tasks {
+			if task == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("missing task in system events target: %s", name)
+		}
+	}
+
+	// Target is backfilled in parallel and reindexed, so it cannot update aggregates
+	for _, task := range tasks {
+		for _, name := range aggregatePersistorTaskNames {
+			if task == name {
+				t.Errorf("unexpected aggregate persistor in system events target: %s", name)
+			}
+		}
+	}
+}
@@ -10,10 +10,12 @@ import (
 )
 
 const (
-	TaskNameBlockFetcher           = "BlockFetcher"
-	TaskNameValidatorsFetcher      = "ValidatorsFetcher"
-	TaskNameValidatorGroupsFetcher = "ValidatorGroupsFetcher"
-	TaskNameTransactionsFetcher    = "TransactionsFetcher"
+	TaskNameBlockFetcher             = "BlockFetcher"
+	TaskNameValidatorsFetcher        = "ValidatorsFetcher"
+	TaskNameValidatorGroupsFetcher   = "ValidatorGroupsFetcher"
+	TaskNameTransactionsFetcher      = "TransactionsFetcher"
+	TaskNameEpochSummaryFetcher      = "EpochSummaryFetcher"
+	TaskNameElectedValidatorsFetcher = "ElectedValidatorsFetcher"
 )
 
 func NewBlockFetcherTask(client figmentclient.Client) pipeline.Task {
@@ -163,3 +165,87 @@ func (t *TransactionsFetcherTask) Run(ctx context.Context, p pipeline.Payload) e
 	payload.RawTransactions = transactions
 	return nil
 }
+
+func NewEpochSummaryFetcherTask(client figmentclient.Client) pipeline.Task {
+	return &EpochSummaryFetcherTask{client: client}
+}
+
+type EpochSummaryFetcherTask struct {
+	client figmentclient.Client
+}
+
+func (t *EpochSummaryFetcherTask) GetName() string {
+	return TaskNameEpochSummaryFetcher
+}
+
+func (t *EpochSummaryFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	// Epoch rewards are distributed at last height of epoch only
+	if payload.HeightMeta.LastInEpoch == nil || !*payload.HeightMeta.LastInEpoch {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageFetcher, t.GetName(), payload.CurrentHeight))
+
+	epochSummary, err := t.client.GetEpochSummaryByHeight(ctx, payload.CurrentHeight)
+	if err != nil {
+		if err == figmentclient.ErrContractNotDeployed {
+			logger.Info(err.Error())
+		} else {
+			return err
+		}
+	}
+
+	logger.DebugJSON(epochSummary,
+		logger.Field("process", "pipeline"),
+		logger.Field("stage", "fetcher"),
+		logger.Field("request", "epoch_summary"),
+		logger.Field("height", payload.CurrentHeight),
+	)
+
+	payload.RawEpochSummary = epochSummary
+	return nil
+}
+
+func NewElectedValidatorsFetcherTask(client figmentclient.Client) pipeline.Task {
+	return &ElectedValidatorsFetcherTask{client: client}
+}
+
+type ElectedValidatorsFetcherTask struct {
+	client figmentclient.Client
+}
+
+func (t *ElectedValidatorsFetcherTask) GetName() string {
+	return TaskNameElectedValidatorsFetcher
+}
+
+func (t *ElectedValidatorsFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	// Validators are elected once per epoch, elected set is in place from first height of epoch
+	if payload.HeightMeta.FirstInEpoch == nil || !*payload.HeightMeta.FirstInEpoch {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageFetcher, t.GetName(), payload.CurrentHeight))
+
+	electedValidators, err := t.client.GetElectedValidatorsByHeight(ctx, payload.CurrentHeight)
+	if err != nil {
+		if err == figmentclient.ErrContractNotDeployed {
+			logger.Info(err.Error())
+		} else {
+			return err
+		}
+	}
+
+	logger.DebugJSON(electedValidators,
+		logger.Field("process", "pipeline"),
+		logger.Field("stage", "fetcher"),
+		logger.Field("request", "elected_validators"),
+		logger.Field("height", payload.CurrentHeight),
+	)
+
+	payload.RawElectedValidators = electedValidators
+	return nil
+}
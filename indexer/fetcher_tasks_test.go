@@ -51,3 +51,103 @@ func TestBlockFetcher_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestEpochSummaryFetcher_Run(t *testing.T) {
+	lastInEpoch := true
+	notLastInEpoch := false
+
+	tests := []struct {
+		description        string
+		lastInEpoch        *bool
+		returnEpochSummary *figmentclient.EpochSummary
+		returnErr          error
+		expectedCalls      int
+		result             error
+	}{
+		{"skips height without epoch", nil, nil, nil, 0, nil},
+		{"skips height which is not last in epoch", &notLastInEpoch, nil, nil, 0, nil},
+		{"returns error if client errors", &lastInEpoch, nil, errors.New("test error"), 1, errors.New("test error")},
+		{"ignores contract not deployed error", &lastInEpoch, nil, figmentclient.ErrContractNotDeployed, 1, nil},
+		{"updates payload.RawEpochSummary", &lastInEpoch, &figmentclient.EpochSummary{ElectedValidatorsCount: 3}, nil, 1, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			mockClient := mock.NewMockClient(ctrl)
+			task := NewEpochSummaryFetcherTask(mockClient)
+
+			pl := &payload{CurrentHeight: 20, HeightMeta: HeightMeta{LastInEpoch: tt.lastInEpoch}}
+
+			mockClient.EXPECT().GetEpochSummaryByHeight(ctx, pl.CurrentHeight).Return(tt.returnEpochSummary, tt.returnErr).Times(tt.expectedCalls)
+
+			result := task.Run(ctx, pl)
+			if (result == nil) != (tt.result == nil) {
+				t.Errorf("want %v; got %v", tt.result, result)
+				return
+			}
+
+			if tt.result != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(pl.RawEpochSummary, tt.returnEpochSummary) {
+				t.Errorf("want: %+v, got: %+v", tt.returnEpochSummary, pl.RawEpochSummary)
+			}
+		})
+	}
+}
+
+func TestElectedValidatorsFetcher_Run(t *testing.T) {
+	firstInEpoch := true
+	notFirstInEpoch := false
+
+	tests := []struct {
+		description             string
+		firstInEpoch            *bool
+		returnElectedValidators []*figmentclient.ElectedValidator
+		returnErr               error
+		expectedCalls           int
+		result                  error
+	}{
+		{"skips height without epoch", nil, nil, nil, 0, nil},
+		{"skips height which is not first in epoch", &notFirstInEpoch, nil, nil, 0, nil},
+		{"returns error if client errors", &firstInEpoch, nil, errors.New("test error"), 1, errors.New("test error")},
+		{"ignores contract not deployed error", &firstInEpoch, nil, figmentclient.ErrContractNotDeployed, 1, nil},
+		{"updates payload.RawElectedValidators", &firstInEpoch, []*figmentclient.ElectedValidator{{Address: "address", Index: 0}}, nil, 1, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			mockClient := mock.NewMockClient(ctrl)
+			task := NewElectedValidatorsFetcherTask(mockClient)
+
+			pl := &payload{CurrentHeight: 20, HeightMeta: HeightMeta{FirstInEpoch: tt.firstInEpoch}}
+
+			mockClient.EXPECT().GetElectedValidatorsByHeight(ctx, pl.CurrentHeight).Return(tt.returnElectedValidators, tt.returnErr).Times(tt.expectedCalls)
+
+			result := task.Run(ctx, pl)
+			if (result == nil) != (tt.result == nil) {
+				t.Errorf("want %v; got %v", tt.result, result)
+				return
+			}
+
+			if tt.result != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(pl.RawElectedValidators, tt.returnElectedValidators) {
+				t.Errorf("want: %+v, got: %+v", tt.returnElectedValidators, pl.RawElectedValidators)
+			}
+		})
+	}
+}
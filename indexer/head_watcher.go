@@ -0,0 +1,81 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	celoTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+func newHeadWatcher(client figmentclient.Client, pollInterval time.Duration) *headWatcher {
+	return &headWatcher{
+		client:       client,
+		pollInterval: pollInterval,
+		heads:        make(chan int64, 1),
+	}
+}
+
+// headWatcher notifies about new chain heads using subscription with fallback to polling
+type headWatcher struct {
+	client       figmentclient.Client
+	pollInterval time.Duration
+
+	heads chan int64
+}
+
+// run watches for new heads until context is done
+func (w *headWatcher) run(ctx context.Context) {
+	headers := make(chan *celoTypes.Header)
+	sub, err := w.client.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		logger.Info(fmt.Sprintf("new head subscription not available, falling back to polling [interval=%s] [err=%+v]", w.pollInterval, err))
+		w.poll(ctx)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-sub.Err():
+			logger.Info(fmt.Sprintf("new head subscription failed, falling back to polling [interval=%s] [err=%+v]", w.pollInterval, err))
+			w.poll(ctx)
+			return
+		case header := <-headers:
+			w.notify(header.Number.Int64())
+		}
+	}
+}
+
+// poll checks chain status in intervals until context is done
+func (w *headWatcher) poll(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			chainStatus, err := w.client.GetChainStatus(ctx)
+			if err != nil {
+				logger.Error(err)
+				continue
+			}
+			w.notify(chainStatus.LastBlockHeight)
+		}
+	}
+}
+
+// notify replaces pending head with the new one so watcher never blocks when indexing falls behind
+func (w *headWatcher) notify(height int64) {
+	select {
+	case <-w.heads:
+	default:
+	}
+	w.heads <- height
+}
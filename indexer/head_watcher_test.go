@@ -0,0 +1,64 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	figmentClientMock "github.com/figment-networks/celo-indexer/mock/client"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHeadWatcher_run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("falls back to polling when subscription is not available", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		clientMock := figmentClientMock.NewMockClient(ctrl)
+		clientMock.EXPECT().SubscribeNewHead(ctx, gomock.Any()).Return(nil, errors.New("notifications not supported")).Times(1)
+		clientMock.EXPECT().GetChainStatus(ctx).Return(&figmentclient.ChainStatus{LastBlockHeight: 100}, nil).MinTimes(1)
+
+		watcher := newHeadWatcher(clientMock, time.Millisecond)
+		go watcher.run(ctx)
+
+		select {
+		case height := <-watcher.heads:
+			if height != 100 {
+				t.Errorf("unexpected head, want %v; got %v", 100, height)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("head was not received")
+		}
+	})
+}
+
+func TestHeadWatcher_notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps only most recent head", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := newHeadWatcher(nil, time.Second)
+
+		watcher.notify(10)
+		watcher.notify(11)
+		watcher.notify(12)
+
+		if height := <-watcher.heads; height != 12 {
+			t.Errorf("unexpected head, want %v; got %v", 12, height)
+		}
+
+		select {
+		case height := <-watcher.heads:
+			t.Errorf("unexpected pending head %v", height)
+		default:
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"encoding/json"
+
+	"github.com/figment-networks/celo-indexer/publisher"
+)
+
+const (
+	SubjectBlockSequences              = "block_sequences"
+	SubjectAccountActivitySequences    = "account_activity_sequences"
+	SubjectGovernanceActivitySequences = "governance_activity_sequences"
+	SubjectTransactionSequences        = "transaction_sequences"
+	SubjectEpochSummaries              = "epoch_summaries"
+	SubjectVoterRewardSequences        = "voter_reward_sequences"
+	SubjectElectedValidatorSequences   = "elected_validator_sequences"
+	SubjectSystemEvents                = "system_events"
+)
+
+// heightMessage is a body of message published for indexed height
+type heightMessage struct {
+	Height int64       `json:"height"`
+	Data   interface{} `json:"data"`
+}
+
+// toHeightMessages maps data indexed for height to messages. Data which was not indexed for height is skipped
+func toHeightMessages(p *payload) ([]publisher.Message, error) {
+	var messages []publisher.Message
+
+	add := func(subject string, data interface{}) error {
+		raw, err := json.Marshal(heightMessage{Height: p.CurrentHeight, Data: data})
+		if err != nil {
+			return err
+		}
+		messages = append(messages, publisher.Message{Subject: subject, Data: raw})
+		return nil
+	}
+
+	blockSeq := p.NewBlockSequence
+	if blockSeq == nil {
+		blockSeq = p.UpdatedBlockSequence
+	}
+	if blockSeq != nil {
+		if err := add(SubjectBlockSequences, blockSeq); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.AccountActivitySequences) > 0 {
+		if err := add(SubjectAccountActivitySequences, p.AccountActivitySequences); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.GovernanceActivitySequences) > 0 {
+		if err := add(SubjectGovernanceActivitySequences, p.GovernanceActivitySequences); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.TransactionSequences) > 0 {
+		if err := add(SubjectTransactionSequences, p.TransactionSequences); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.EpochSummary != nil {
+		if err := add(SubjectEpochSummaries, p.EpochSummary); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.VoterRewardSequences) > 0 {
+		if err := add(SubjectVoterRewardSequences, p.VoterRewardSequences); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.ElectedValidatorSequences) > 0 {
+		if err := add(SubjectElectedValidatorSequences, p.ElectedValidatorSequences); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.SystemEvents) > 0 {
+		if err := add(SubjectSystemEvents, p.SystemEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	return messages, nil
+}
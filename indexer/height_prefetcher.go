@@ -0,0 +1,77 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+var (
+	// prefetchedStages are stages which do not depend on previous height, so they can run for many heights in parallel
+	prefetchedStages = []pipeline.StageName{pipeline.StageSetup, pipeline.StageFetcher, pipeline.StageParser}
+)
+
+func newHeightPrefetcher(cfg *config.Config, client figmentclient.Client, options *pipeline.Options, parallelHeights int64, startHeight int64, endHeight int64) *heightPrefetcher {
+	return &heightPrefetcher{
+		stages: []pipeline.Stage{
+			pipeline.NewStageWithTasks(pipeline.StageSetup, setupTasks(cfg, client)...),
+			pipeline.NewAsyncStageWithTasks(pipeline.StageFetcher, fetcherTasks(cfg, client)...),
+			pipeline.NewAsyncStageWithTasks(pipeline.StageParser, parserTasks(cfg)...),
+		},
+		options:         options,
+		parallelHeights: parallelHeights,
+		endHeight:       endHeight,
+
+		nextHeight: startHeight,
+		results:    map[int64]chan *payload{},
+	}
+}
+
+// heightPrefetcher runs prefetched stages for upcoming heights using a bounded pool of workers
+type heightPrefetcher struct {
+	stages          []pipeline.Stage
+	options         *pipeline.Options
+	parallelHeights int64
+	endHeight       int64
+
+	nextHeight int64
+	results    map[int64]chan *payload
+}
+
+// schedule starts prefetching of heights which are less than parallelHeights ahead of given height
+func (f *heightPrefetcher) schedule(ctx context.Context, height int64) {
+	for ; f.nextHeight < height+f.parallelHeights && f.nextHeight <= f.endHeight; f.nextHeight++ {
+		result := make(chan *payload, 1)
+		f.results[f.nextHeight] = result
+
+		go f.prefetch(ctx, f.nextHeight, result)
+	}
+}
+
+// get waits for payload of given height. It returns nil when height could not be prefetched
+func (f *heightPrefetcher) get(height int64) *payload {
+	result, ok := f.results[height]
+	if !ok {
+		return nil
+	}
+	delete(f.results, height)
+
+	return <-result
+}
+
+func (f *heightPrefetcher) prefetch(ctx context.Context, height int64, result chan<- *payload) {
+	p := &payload{CurrentHeight: height, Prefetched: true}
+
+	for _, stage := range f.stages {
+		if err := stage.Run(ctx, p, f.options); err != nil {
+			logger.Info(fmt.Sprintf("prefetching failed, height will be fetched sequentially [height=%d] [err=%+v]", height, err))
+			result <- nil
+			return
+		}
+	}
+	result <- p
+}
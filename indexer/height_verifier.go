@@ -0,0 +1,320 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"sort"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/metrics"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+const (
+	DiscrepancyKindMissing    DiscrepancyKind = "missing"
+	DiscrepancyKindUnexpected DiscrepancyKind = "unexpected"
+	DiscrepancyKindMismatch   DiscrepancyKind = "mismatch"
+
+	// signersIndexVersion is the index version since which block proposer, round, signers and validator proposed flag are indexed
+	signersIndexVersion int64 = 9
+)
+
+var (
+	// verifiedTasks are tasks fetching raw data required to map verified sequences
+	verifiedTasks = []pipeline.TaskName{
+		TaskNameHeightMetaRetriever,
+		TaskNameBlockFetcher,
+		TaskNameValidatorsFetcher,
+		TaskNameValidatorGroupsFetcher,
+		TaskNameTransactionsFetcher,
+		TaskNameElectedValidatorsFetcher,
+		ValidatorSignersSyncerTaskName,
+	}
+)
+
+type DiscrepancyKind string
+
+// Discrepancy describes stored record which does not match record mapped from node data
+type Discrepancy struct {
+	Height   int64           `json:"height"`
+	Table    string          `json:"table"`
+	Kind     DiscrepancyKind `json:"kind"`
+	Stored   interface{}     `json:"stored,omitempty"`
+	Expected interface{}     `json:"expected,omitempty"`
+}
+
+func newHeightVerifier(
+	cfg *config.Config,
+	client figmentclient.Client,
+	syncableDb store.Syncables,
+	blockSeqDb store.BlockSeq,
+	validatorSeqDb store.ValidatorSeq,
+	validatorGroupSeqDb store.ValidatorGroupSeq,
+	accountActivitySeqDb store.AccountActivitySeq,
+	electedValidatorSeqDb store.ElectedValidatorSeq,
+) *heightVerifier {
+	return &heightVerifier{
+		stages: []pipeline.Stage{
+			pipeline.NewStageWithTasks(pipeline.StageSetup, setupTasks(cfg, client)...),
+			pipeline.NewAsyncStageWithTasks(pipeline.StageFetcher, fetcherTasks(cfg, client)...),
+			pipeline.NewStageWithTasks(pipeline.StageSyncer, NewValidatorSignersSyncerTask(client, electedValidatorSeqDb)),
+		},
+		options: &pipeline.Options{
+			TaskWhitelist: verifiedTasks,
+		},
+
+		syncableDb:           syncableDb,
+		blockSeqDb:           blockSeqDb,
+		validatorSeqDb:       validatorSeqDb,
+		validatorGroupSeqDb:  validatorGroupSeqDb,
+		accountActivitySeqDb: accountActivitySeqDb,
+	}
+}
+
+// heightVerifier compares stored sequences with sequences mapped from data fetched from the node
+type heightVerifier struct {
+	stages  []pipeline.Stage
+	options *pipeline.Options
+
+	syncableDb           store.Syncables
+	blockSeqDb           store.BlockSeq
+	validatorSeqDb       store.ValidatorSeq
+	validatorGroupSeqDb  store.ValidatorGroupSeq
+	accountActivitySeqDb store.AccountActivitySeq
+}
+
+// verify returns discrepancies found for given height
+func (v *heightVerifier) verify(ctx context.Context, height int64) ([]Discrepancy, error) {
+	syncable, err := v.syncableDb.FindByHeight(height)
+	if err != nil {
+		if err == psql.ErrNotFound {
+			return v.record([]Discrepancy{{Height: height, Table: model.Syncable{}.TableName(), Kind: DiscrepancyKindMissing}}), nil
+		}
+		return nil, err
+	}
+
+	p := &payload{CurrentHeight: height}
+	for _, stage := range v.stages {
+		if err := stage.Run(ctx, p, v.options); err != nil {
+			return nil, err
+		}
+	}
+
+	var discrepancies []Discrepancy
+
+	blockDiscrepancies, err := v.verifyBlockSeq(syncable, p)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies = append(discrepancies, blockDiscrepancies...)
+
+	validatorDiscrepancies, err := v.verifyValidatorSeqs(syncable, p)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies = append(discrepancies, validatorDiscrepancies...)
+
+	validatorGroupDiscrepancies, err := v.verifyValidatorGroupSeqs(syncable, p)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies = append(discrepancies, validatorGroupDiscrepancies...)
+
+	accountActivityDiscrepancies, err := v.verifyAccountActivitySeqs(syncable, p)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies = append(discrepancies, accountActivityDiscrepancies...)
+
+	return v.record(discrepancies), nil
+}
+
+func (v *heightVerifier) verifyBlockSeq(syncable *model.Syncable, p *payload) ([]Discrepancy, error) {
+	table := model.BlockSeq{}.TableName()
+
+	expected, err := ToBlockSequence(syncable, p.RawBlock)
+	if err != nil {
+		return nil, err
+	}
+	if syncable.IndexVersion < signersIndexVersion {
+		expected.Proposer = ""
+		expected.Round = 0
+		expected.SignersBitmap = ""
+	}
+
+	stored, err := v.blockSeqDb.FindByHeight(syncable.Height)
+	if err != nil {
+		if err == psql.ErrNotFound {
+			return []Discrepancy{{Height: syncable.Height, Table: table, Kind: DiscrepancyKindMissing, Expected: expected}}, nil
+		}
+		return nil, err
+	}
+
+	updated := *stored
+	updated.Update(*expected)
+	if !stored.Equal(*expected) || isChanged(stored, &updated) {
+		return []Discrepancy{{Height: syncable.Height, Table: table, Kind: DiscrepancyKindMismatch, Stored: stored, Expected: expected}}, nil
+	}
+	return nil, nil
+}
+
+func (v *heightVerifier) verifyValidatorSeqs(syncable *model.Syncable, p *payload) ([]Discrepancy, error) {
+	expectedSeqs, err := ToValidatorSequence(syncable, p.RawValidators, p.RawBlock)
+	if err != nil {
+		return nil, err
+	}
+	if syncable.IndexVersion < signersIndexVersion {
+		for i := range expectedSeqs {
+			expectedSeqs[i].Proposed = false
+		}
+	}
+
+	storedSeqs, err := v.validatorSeqDb.FindByHeight(syncable.Height)
+	if err != nil && err != psql.ErrNotFound {
+		return nil, err
+	}
+
+	return diffSequences(syncable.Height, model.ValidatorSeq{}.TableName(), len(storedSeqs), len(expectedSeqs),
+		func(s, e int) bool { return storedSeqs[s].Equal(expectedSeqs[e]) },
+		func(s, e int) bool {
+			updated := storedSeqs[s]
+			updated.Update(expectedSeqs[e])
+			return isChanged(&storedSeqs[s], &updated)
+		},
+		func(s int) interface{} { return &storedSeqs[s] },
+		func(e int) interface{} { return &expectedSeqs[e] },
+	), nil
+}
+
+func (v *heightVerifier) verifyValidatorGroupSeqs(syncable *model.Syncable, p *payload) ([]Discrepancy, error) {
+	expectedSeqs, err := ToValidatorGroupSequence(syncable, p.RawValidatorGroups, p.RawValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	storedSeqs, err := v.validatorGroupSeqDb.FindByHeight(syncable.Height)
+	if err != nil && err != psql.ErrNotFound {
+		return nil, err
+	}
+
+	return diffSequences(syncable.Height, model.ValidatorGroupSeq{}.TableName(), len(storedSeqs), len(expectedSeqs),
+		func(s, e int) bool { return storedSeqs[s].Equal(expectedSeqs[e]) },
+		func(s, e int) bool {
+			updated := storedSeqs[s]
+			updated.Update(expectedSeqs[e])
+			return isChanged(&storedSeqs[s], &updated)
+		},
+		func(s int) interface{} { return &storedSeqs[s] },
+		func(e int) interface{} { return &expectedSeqs[e] },
+	), nil
+}
+
+func (v *heightVerifier) verifyAccountActivitySeqs(syncable *model.Syncable, p *payload) ([]Discrepancy, error) {
+	expectedSeqs, err := ToAccountActivitySequence(syncable, p.RawTransactions)
+	if err != nil {
+		return nil, err
+	}
+
+	storedSeqs, err := v.accountActivitySeqDb.FindByHeight(syncable.Height)
+	if err != nil && err != psql.ErrNotFound {
+		return nil, err
+	}
+
+	return diffSequences(syncable.Height, model.AccountActivitySeq{}.TableName(), len(storedSeqs), len(expectedSeqs),
+		func(s, e int) bool { return storedSeqs[s].Equal(expectedSeqs[e]) },
+		func(s, e int) bool {
+			updated := storedSeqs[s]
+			updated.Update(expectedSeqs[e])
+			return isChanged(&storedSeqs[s], &updated)
+		},
+		func(s int) interface{} { return &storedSeqs[s] },
+		func(e int) interface{} { return &expectedSeqs[e] },
+	), nil
+}
+
+// diffSequences pairs stored and expected records of one height using their Equal methods.
+// Paired records are reported when updating stored record with expected values changes it
+func diffSequences(
+	height int64,
+	table string,
+	storedLen int,
+	expectedLen int,
+	equal func(s, e int) bool,
+	changed func(s, e int) bool,
+	stored func(s int) interface{},
+	expected func(e int) interface{},
+) []Discrepancy {
+	var discrepancies []Discrepancy
+
+	matched := make([]bool, storedLen)
+	for e := 0; e < expectedLen; e++ {
+		found := false
+		for s := 0; s < storedLen; s++ {
+			if matched[s] || !equal(s, e) {
+				continue
+			}
+			matched[s], found = true, true
+
+			if changed(s, e) {
+				discrepancies = append(discrepancies, Discrepancy{Height: height, Table: table, Kind: DiscrepancyKindMismatch, Stored: stored(s), Expected: expected(e)})
+			}
+			break
+		}
+		if !found {
+			discrepancies = append(discrepancies, Discrepancy{Height: height, Table: table, Kind: DiscrepancyKindMissing, Expected: expected(e)})
+		}
+	}
+
+	for s := 0; s < storedLen; s++ {
+		if !matched[s] {
+			discrepancies = append(discrepancies, Discrepancy{Height: height, Table: table, Kind: DiscrepancyKindUnexpected, Stored: stored(s)})
+		}
+	}
+	return discrepancies
+}
+
+// record increments mismatch metric for every discrepancy
+func (v *heightVerifier) record(discrepancies []Discrepancy) []Discrepancy {
+	for _, d := range discrepancies {
+		metrics.VerifyMismatchCount.WithLabels(d.Table, string(d.Kind)).Inc()
+	}
+	return discrepancies
+}
+
+// isChanged checks if updating stored record with mapped values changed any of its fields
+func isChanged(stored interface{}, updated interface{}) bool {
+	storedJson, err := json.Marshal(stored)
+	if err != nil {
+		return true
+	}
+	updatedJson, err := json.Marshal(updated)
+	if err != nil {
+		return true
+	}
+	return string(storedJson) != string(updatedJson)
+}
+
+// sampleHeights returns sorted random sample of unique heights within given range
+func sampleHeights(startHeight int64, endHeight int64, sampleSize int64, rnd *rand.Rand) []int64 {
+	count := endHeight - startHeight + 1
+	if sampleSize > count {
+		sampleSize = count
+	}
+
+	picked := make(map[int64]bool, sampleSize)
+	for int64(len(picked)) < sampleSize {
+		picked[startHeight+rnd.Int63n(count)] = true
+	}
+
+	heights := make([]int64, 0, sampleSize)
+	for height := range picked {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
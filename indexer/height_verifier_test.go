@@ -0,0 +1,241 @@
+package indexer
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHeightVerifier_verifyValidatorSeqs(t *testing.T) {
+	const height int64 = 20
+	t.Parallel()
+
+	syncTime := types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+	signed := true
+
+	rawValidator := func(address string, score int64) *figmentclient.Validator {
+		return &figmentclient.Validator{Address: address, Affiliation: "group", Score: big.NewInt(score), Signed: &signed}
+	}
+	storedValidator := func(address string, score int64) model.ValidatorSeq {
+		return model.ValidatorSeq{
+			Sequence:    &model.Sequence{Height: height, Time: *syncTime},
+			Address:     address,
+			Affiliation: "group",
+			Score:       types.NewQuantityFromInt64(score),
+			Signed:      &signed,
+		}
+	}
+
+	proposedValidator := func(address string, score int64) model.ValidatorSeq {
+		seq := storedValidator(address, score)
+		seq.Proposed = true
+		return seq
+	}
+	rawBlock := &figmentclient.Block{Height: height, Coinbase: "addr1"}
+
+	tests := []struct {
+		description   string
+		indexVersion  int64
+		rawValidators []*figmentclient.Validator
+		rawBlock      *figmentclient.Block
+		stored        []model.ValidatorSeq
+		expectKinds   []DiscrepancyKind
+	}{
+		{
+			description:   "returns no discrepancies when stored sequences match",
+			rawValidators: []*figmentclient.Validator{rawValidator("addr1", 10), rawValidator("addr2", 20)},
+			stored:        []model.ValidatorSeq{storedValidator("addr2", 20), storedValidator("addr1", 10)},
+		},
+		{
+			description:   "returns mismatch when stored data differs",
+			rawValidators: []*figmentclient.Validator{rawValidator("addr1", 10)},
+			stored:        []model.ValidatorSeq{storedValidator("addr1", 11)},
+			expectKinds:   []DiscrepancyKind{DiscrepancyKindMismatch},
+		},
+		{
+			description:   "returns missing and unexpected sequences",
+			rawValidators: []*figmentclient.Validator{rawValidator("addr1", 10)},
+			stored:        []model.ValidatorSeq{storedValidator("addr2", 10)},
+			expectKinds:   []DiscrepancyKind{DiscrepancyKindMissing, DiscrepancyKindUnexpected},
+		},
+		{
+			description:   "returns mismatch when stored proposed flag differs",
+			indexVersion:  signersIndexVersion,
+			rawValidators: []*figmentclient.Validator{rawValidator("addr1", 10)},
+			rawBlock:      rawBlock,
+			stored:        []model.ValidatorSeq{storedValidator("addr1", 10)},
+			expectKinds:   []DiscrepancyKind{DiscrepancyKindMismatch},
+		},
+		{
+			description:   "returns no discrepancies when stored proposed flag matches",
+			indexVersion:  signersIndexVersion,
+			rawValidators: []*figmentclient.Validator{rawValidator("addr1", 10)},
+			rawBlock:      rawBlock,
+			stored:        []model.ValidatorSeq{proposedValidator("addr1", 10)},
+		},
+		{
+			description:   "skips proposed flag when stored index version did not index it",
+			indexVersion:  signersIndexVersion - 1,
+			rawValidators: []*figmentclient.Validator{rawValidator("addr1", 10)},
+			rawBlock:      rawBlock,
+			stored:        []model.ValidatorSeq{storedValidator("addr1", 10)},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqDbMock := mock.NewMockValidatorSeq(ctrl)
+			validatorSeqDbMock.EXPECT().FindByHeight(height).Return(tt.stored, nil).Times(1)
+
+			verifier := &heightVerifier{validatorSeqDb: validatorSeqDbMock}
+			syncable := &model.Syncable{Height: height, Time: syncTime, IndexVersion: tt.indexVersion}
+
+			discrepancies, err := verifier.verifyValidatorSeqs(syncable, &payload{RawValidators: tt.rawValidators, RawBlock: tt.rawBlock})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(discrepancies) != len(tt.expectKinds) {
+				t.Errorf("unexpected discrepancies count, want %d; got %d", len(tt.expectKinds), len(discrepancies))
+				return
+			}
+			for i, discrepancy := range discrepancies {
+				if discrepancy.Kind != tt.expectKinds[i] {
+					t.Errorf("unexpected discrepancy kind, want %s; got %s", tt.expectKinds[i], discrepancy.Kind)
+				}
+				if discrepancy.Height != height {
+					t.Errorf("unexpected discrepancy height, want %d; got %d", height, discrepancy.Height)
+				}
+			}
+		})
+	}
+}
+
+func TestHeightVerifier_verifyBlockSeq(t *testing.T) {
+	const height int64 = 20
+	t.Parallel()
+
+	syncTime := types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+
+	rawBlock := &figmentclient.Block{
+		Height:   height,
+		Hash:     "hash",
+		Coinbase: "proposer",
+		Extra: figmentclient.BlockExtra{
+			ParentAggregatedSeal: figmentclient.IstanbulAggregatedSeal{Bitmap: big.NewInt(5), Round: 1},
+		},
+	}
+	storedBlock := func(proposer string, round uint64, signersBitmap string) *model.BlockSeq {
+		return &model.BlockSeq{
+			Sequence:      &model.Sequence{Height: height, Time: *syncTime},
+			Hash:          "hash",
+			Proposer:      proposer,
+			Round:         round,
+			SignersBitmap: signersBitmap,
+		}
+	}
+
+	tests := []struct {
+		description  string
+		indexVersion int64
+		stored       *model.BlockSeq
+		expectKinds  []DiscrepancyKind
+	}{
+		{
+			description:  "returns no discrepancies when stored sequence matches",
+			indexVersion: signersIndexVersion,
+			stored:       storedBlock("proposer", 1, "101"),
+		},
+		{
+			description:  "returns mismatch when stored signers differ",
+			indexVersion: signersIndexVersion,
+			stored:       storedBlock("", 0, ""),
+			expectKinds:  []DiscrepancyKind{DiscrepancyKindMismatch},
+		},
+		{
+			description:  "skips signers when stored index version did not index them",
+			indexVersion: signersIndexVersion - 1,
+			stored:       storedBlock("", 0, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			blockSeqDbMock := mock.NewMockBlockSeq(ctrl)
+			blockSeqDbMock.EXPECT().FindByHeight(height).Return(tt.stored, nil).Times(1)
+
+			verifier := &heightVerifier{blockSeqDb: blockSeqDbMock}
+			syncable := &model.Syncable{Height: height, Time: syncTime, IndexVersion: tt.indexVersion}
+
+			discrepancies, err := verifier.verifyBlockSeq(syncable, &payload{RawBlock: rawBlock})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(discrepancies) != len(tt.expectKinds) {
+				t.Errorf("unexpected discrepancies count, want %d; got %d", len(tt.expectKinds), len(discrepancies))
+				return
+			}
+			for i, discrepancy := range discrepancies {
+				if discrepancy.Kind != tt.expectKinds[i] {
+					t.Errorf("unexpected discrepancy kind, want %s; got %s", tt.expectKinds[i], discrepancy.Kind)
+				}
+			}
+		})
+	}
+}
+
+func TestSampleHeights(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		sampleSize  int64
+		expectLen   int
+	}{
+		{"returns sample of requested size", 5, 5},
+		{"returns all heights when sample is bigger than range", 50, 11},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			heights := sampleHeights(10, 20, tt.sampleSize, rand.New(rand.NewSource(1)))
+
+			if len(heights) != tt.expectLen {
+				t.Errorf("unexpected sample size, want %d; got %d", tt.expectLen, len(heights))
+			}
+			for i, height := range heights {
+				if height < 10 || height > 20 {
+					t.Errorf("height %d out of range", height)
+				}
+				if i > 0 && heights[i-1] >= height {
+					t.Errorf("heights are not sorted and unique: %v", heights)
+				}
+			}
+		})
+	}
+}
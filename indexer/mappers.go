@@ -3,6 +3,9 @@ package indexer
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"strings"
+
 	"github.com/celo-org/kliento/contracts"
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/model"
@@ -13,6 +16,8 @@ import (
 var (
 	OperationTypeInternalTransferReceived                     = fmt.Sprintf("%sReceived", figmentclient.OperationTypeInternalTransfer)
 	OperationTypeInternalTransferSent                         = fmt.Sprintf("%sSent", figmentclient.OperationTypeInternalTransfer)
+	OperationTypeTokenTransferReceived                        = fmt.Sprintf("%sReceived", figmentclient.OperationTypeTokenTransfer)
+	OperationTypeTokenTransferSent                            = fmt.Sprintf("%sSent", figmentclient.OperationTypeTokenTransfer)
 	OperationTypeValidatorGroupVoteCastReceived               = fmt.Sprintf("%sReceived", figmentclient.OperationTypeValidatorGroupVoteCast)
 	OperationTypeValidatorGroupVoteCastSent                   = fmt.Sprintf("%sSent", figmentclient.OperationTypeValidatorGroupVoteCast)
 	OperationTypeValidatorGroupVoteActivatedReceived          = fmt.Sprintf("%sReceived", figmentclient.OperationTypeValidatorGroupVoteActivated)
@@ -36,10 +41,15 @@ func ToBlockSequence(syncable *model.Syncable, rawBlock *figmentclient.Block) (*
 			Time:   *syncable.Time,
 		},
 
+		Hash:            rawBlock.Hash,
+		ParentHash:      rawBlock.ParentHash,
 		TxCount:         rawBlock.TxCount,
 		Size:            rawBlock.Size,
 		GasUsed:         rawBlock.GasUsed,
 		TotalDifficulty: rawBlock.TotalDifficulty,
+		Proposer:        rawBlock.Coinbase,
+		Round:           rawBlock.Extra.ParentAggregatedSeal.Round,
+		SignersBitmap:   toSignersBitmap(rawBlock.Extra.ParentAggregatedSeal.Bitmap),
 	}
 
 	if !e.Valid() {
@@ -49,7 +59,32 @@ func ToBlockSequence(syncable *model.Syncable, rawBlock *figmentclient.Block) (*
 	return e, nil
 }
 
-func ToValidatorSequence(syncable *model.Syncable, rawValidators []*figmentclient.Validator) ([]model.ValidatorSeq, error) {
+// toSignersBitmap maps aggregated seal bitmap to string of bits, where n-th bit is set when n-th elected validator signed the block
+func toSignersBitmap(bitmap *big.Int) string {
+	if bitmap == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for i := 0; i < bitmap.BitLen(); i++ {
+		if bitmap.Bit(i) == 1 {
+			b.WriteString("1")
+		} else {
+			b.WriteString("0")
+		}
+	}
+	return b.String()
+}
+
+// isProposer returns whether block was proposed by validator. Coinbase of block is either signer or account of validator
+func isProposer(rawValidator *figmentclient.Validator, rawBlock *figmentclient.Block) bool {
+	if rawBlock == nil || rawBlock.Coinbase == "" {
+		return false
+	}
+	return strings.EqualFold(rawBlock.Coinbase, rawValidator.Signer) || strings.EqualFold(rawBlock.Coinbase, rawValidator.Address)
+}
+
+func ToValidatorSequence(syncable *model.Syncable, rawValidators []*figmentclient.Validator, rawBlock *figmentclient.Block) ([]model.ValidatorSeq, error) {
 	var validators []model.ValidatorSeq
 	for _, rawValidator := range rawValidators {
 		e := model.ValidatorSeq{
@@ -61,6 +96,7 @@ func ToValidatorSequence(syncable *model.Syncable, rawValidators []*figmentclien
 			Address:     rawValidator.Address,
 			Affiliation: rawValidator.Affiliation,
 			Signed:      rawValidator.Signed,
+			Proposed:    isProposer(rawValidator, rawBlock),
 			Score:       types.NewQuantity(rawValidator.Score),
 		}
 
@@ -150,6 +186,103 @@ func ToAccountActivitySequence(syncable *model.Syncable, rawTransactions []*figm
 	return accountActivities, nil
 }
 
+func ToEpochSummary(syncable *model.Syncable, rawEpochSummary *figmentclient.EpochSummary) *model.EpochSummary {
+	return &model.EpochSummary{
+		Sequence: &model.Sequence{
+			Height: syncable.Height,
+			Time:   *syncable.Time,
+		},
+
+		Epoch:                  *syncable.Epoch,
+		TargetVotingYield:      quantityOrZero(rawEpochSummary.TargetVotingYield),
+		RewardsMultiplier:      quantityOrZero(rawEpochSummary.RewardsMultiplier),
+		ElectedValidatorsCount: rawEpochSummary.ElectedValidatorsCount,
+		ValidatorRewards:       quantityOrZero(rawEpochSummary.ValidatorRewards),
+		VoterRewards:           quantityOrZero(rawEpochSummary.VoterRewards),
+		CommunityFundRewards:   quantityOrZero(rawEpochSummary.CommunityFundRewards),
+		CarbonFundRewards:      quantityOrZero(rawEpochSummary.CarbonFundRewards),
+		TotalVotes:             quantityOrZero(rawEpochSummary.TotalVotes),
+	}
+}
+
+func ToVoterRewardSequence(syncable *model.Syncable, rawVoterRewards []*figmentclient.VoterReward) []model.VoterRewardSeq {
+	var voterRewards []model.VoterRewardSeq
+	for _, rawVoterReward := range rawVoterRewards {
+		voterRewards = append(voterRewards, model.VoterRewardSeq{
+			Sequence: &model.Sequence{
+				Height: syncable.Height,
+				Time:   *syncable.Time,
+			},
+
+			Epoch:       *syncable.Epoch,
+			Address:     rawVoterReward.Account,
+			Group:       rawVoterReward.Group,
+			Amount:      quantityOrZero(rawVoterReward.Reward),
+			ActiveVotes: quantityOrZero(rawVoterReward.ActiveVotes),
+		})
+	}
+	return voterRewards
+}
+
+func ToElectedValidatorSequence(syncable *model.Syncable, rawElectedValidators []*figmentclient.ElectedValidator) []model.ElectedValidatorSeq {
+	var electedValidators []model.ElectedValidatorSeq
+	for _, rawElectedValidator := range rawElectedValidators {
+		electedValidators = append(electedValidators, model.ElectedValidatorSeq{
+			Sequence: &model.Sequence{
+				Height: syncable.Height,
+				Time:   *syncable.Time,
+			},
+
+			Epoch:   *syncable.Epoch,
+			Address: rawElectedValidator.Address,
+			Signer:  rawElectedValidator.Signer,
+			Group:   rawElectedValidator.Group,
+			Index:   rawElectedValidator.Index,
+		})
+	}
+	return electedValidators
+}
+
+func ToTransactionSequence(syncable *model.Syncable, rawTransactions []*figmentclient.Transaction) ([]model.TransactionSeq, error) {
+	var transactions []model.TransactionSeq
+	for _, rawTransaction := range rawTransactions {
+		marshaledOperations, err := json.Marshal(rawTransaction.Operations)
+		if err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, model.TransactionSeq{
+			Sequence: &model.Sequence{
+				Height: syncable.Height,
+				Time:   *syncable.Time,
+			},
+
+			Hash:                rawTransaction.Hash,
+			Index:               int64(rawTransaction.Index),
+			FromAddress:         rawTransaction.Address,
+			ToAddress:           rawTransaction.To,
+			Nonce:               rawTransaction.Nonce,
+			Gas:                 rawTransaction.Gas,
+			GasPrice:            quantityOrZero(rawTransaction.GasPrice),
+			GasUsed:             rawTransaction.GasUsed,
+			FeeCurrency:         rawTransaction.FeeCurrency,
+			GatewayFee:          quantityOrZero(rawTransaction.GatewayFee),
+			GatewayFeeRecipient: rawTransaction.GatewayFeeRecipient,
+			Success:             rawTransaction.Success,
+			Operations:          types.Jsonb{RawMessage: marshaledOperations},
+		})
+	}
+	return transactions, nil
+}
+
+// quantityOrZero returns zero quantity for values missing in raw data (ie. gateway fee of transactions without one)
+func quantityOrZero(i *big.Int) types.Quantity {
+	if i == nil {
+		return types.NewQuantityFromInt64(0)
+	}
+	return types.NewQuantity(i)
+}
+
 func operationToAccountActivitySequence(sequence *model.Sequence, rawOperation *figmentclient.Operation, txHash string) ([]model.AccountActivitySeq, error) {
 	var accountActivities []model.AccountActivitySeq
 
@@ -160,7 +293,7 @@ func operationToAccountActivitySequence(sequence *model.Sequence, rawOperation *
 
 	switch rawOperation.Name {
 
-	// Internal transfer
+	// Internal transfer. Traced transfers are the only record of CELO moved by transaction, so they are listed as CELO transfers
 	case figmentclient.OperationTypeInternalTransfer:
 		event := rawOperation.Details.(*figmentclient.Transfer)
 
@@ -168,6 +301,8 @@ func operationToAccountActivitySequence(sequence *model.Sequence, rawOperation *
 			Sequence:        sequence,
 			TransactionHash: txHash,
 			Address:         event.From,
+			Counterparty:    event.To,
+			Token:           figmentclient.GoldTokenSymbol,
 			Amount:          types.NewQuantity(event.Value),
 			Kind:            OperationTypeInternalTransferSent,
 			Data:            types.Jsonb{RawMessage: marshaledData},
@@ -177,11 +312,39 @@ func operationToAccountActivitySequence(sequence *model.Sequence, rawOperation *
 			Sequence:        sequence,
 			TransactionHash: txHash,
 			Address:         event.To,
+			Counterparty:    event.From,
+			Token:           figmentclient.GoldTokenSymbol,
 			Amount:          types.NewQuantity(event.Value),
 			Kind:            OperationTypeInternalTransferReceived,
 			Data:            types.Jsonb{RawMessage: marshaledData},
 		})
 
+	// Token transfer
+	case figmentclient.OperationTypeTokenTransfer:
+		event := rawOperation.Details.(*figmentclient.TokenTransfer)
+
+		accountActivities = append(accountActivities, model.AccountActivitySeq{
+			Sequence:        sequence,
+			TransactionHash: txHash,
+			Address:         event.From,
+			Counterparty:    event.To,
+			Token:           event.Token,
+			Amount:          types.NewQuantity(event.Value),
+			Kind:            OperationTypeTokenTransferSent,
+			Data:            types.Jsonb{RawMessage: marshaledData},
+		})
+
+		accountActivities = append(accountActivities, model.AccountActivitySeq{
+			Sequence:        sequence,
+			TransactionHash: txHash,
+			Address:         event.To,
+			Counterparty:    event.From,
+			Token:           event.Token,
+			Amount:          types.NewQuantity(event.Value),
+			Kind:            OperationTypeTokenTransferReceived,
+			Data:            types.Jsonb{RawMessage: marshaledData},
+		})
+
 	//Election
 	case figmentclient.OperationTypeValidatorGroupVoteCast:
 		// vote() [ValidatorGroupVoteCast] => lockNonVoting->lockVotingPending
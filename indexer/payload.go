@@ -15,23 +15,48 @@ func NewPayloadFactory() *payloadFactory {
 	return &payloadFactory{}
 }
 
-type payloadFactory struct{}
+type payloadFactory struct {
+	preloaded map[int64]*payload
+}
 
 func (pf *payloadFactory) GetPayload(currentHeight int64) pipeline.Payload {
+	if p, ok := pf.preloaded[currentHeight]; ok {
+		delete(pf.preloaded, currentHeight)
+		return p
+	}
+
 	return &payload{
 		CurrentHeight: currentHeight,
 	}
 }
 
+// reset drops payloads which were preloaded but never requested
+func (pf *payloadFactory) reset() {
+	pf.preloaded = nil
+}
+
+// preload makes factory return given payload when it is asked for payload at its height
+func (pf *payloadFactory) preload(p *payload) {
+	if pf.preloaded == nil {
+		pf.preloaded = map[int64]*payload{}
+	}
+	pf.preloaded[p.CurrentHeight] = p
+}
+
 type payload struct {
 	CurrentHeight int64
 
+	// Prefetched is true when setup, fetcher and parser stages were run in parallel with other heights
+	Prefetched bool
+
 	// Fetcher stage
-	HeightMeta         HeightMeta
-	RawBlock           *figmentclient.Block
-	RawValidators      []*figmentclient.Validator
-	RawValidatorGroups []*figmentclient.ValidatorGroup
-	RawTransactions    []*figmentclient.Transaction
+	HeightMeta           HeightMeta
+	RawBlock             *figmentclient.Block
+	RawValidators        []*figmentclient.Validator
+	RawValidatorGroups   []*figmentclient.ValidatorGroup
+	RawTransactions      []*figmentclient.Transaction
+	RawEpochSummary      *figmentclient.EpochSummary
+	RawElectedValidators []*figmentclient.ElectedValidator
 
 	// Syncer stage
 	Syncable *model.Syncable
@@ -46,6 +71,8 @@ type payload struct {
 	UpdatedValidatorGroupAggregates []model.ValidatorGroupAgg
 	NewProposalAggregates           []model.ProposalAgg
 	UpdatedProposalAggregates       []model.ProposalAgg
+	NewVoterAggregates              []model.VoterAgg
+	UpdatedVoterAggregates          []model.VoterAgg
 
 	// Sequencer stage
 	NewBlockSequence            *model.BlockSeq
@@ -54,6 +81,10 @@ type payload struct {
 	ValidatorGroupSequences     []model.ValidatorGroupSeq
 	AccountActivitySequences    []model.AccountActivitySeq
 	GovernanceActivitySequences []model.GovernanceActivitySeq
+	TransactionSequences        []model.TransactionSeq
+	EpochSummary                *model.EpochSummary
+	VoterRewardSequences        []model.VoterRewardSeq
+	ElectedValidatorSequences   []model.ElectedValidatorSeq
 
 	// Analyzer
 	SystemEvents []model.SystemEvent
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/figment-networks/celo-indexer/model"
 	"github.com/figment-networks/celo-indexer/store"
 	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/figment-networks/celo-indexer/webhook"
 	"github.com/figment-networks/indexing-engine/pipeline"
 )
 
@@ -20,6 +22,11 @@ const (
 	ProposalAggPersistorTaskName           = "ProposalAggPersistor"
 	TaskNameSystemEventPersistor           = "SystemEventPersistor"
 	GovernanceActivitySeqPersistorTaskName = "GovernanceActivitySeqPersistor"
+	TransactionSeqPersistorTaskName        = "TransactionSeqPersistor"
+	EpochSummaryPersistorTaskName          = "EpochSummaryPersistor"
+	VoterRewardSeqPersistorTaskName        = "VoterRewardSeqPersistor"
+	VoterAggPersistorTaskName              = "VoterAggPersistor"
+	ElectedValidatorSeqPersistorTaskName   = "ElectedValidatorSeqPersistor"
 )
 
 // NewSyncerPersistorTask is responsible for storing syncable to persistence layer
@@ -136,6 +143,132 @@ func (t *accountActivitySeqPersistorTask) Run(ctx context.Context, p pipeline.Pa
 	return nil
 }
 
+// NewTransactionSeqPersistorTask is responsible for storing transactions to persistence layer
+func NewTransactionSeqPersistorTask(transactionSeqDb store.TransactionSeq) pipeline.Task {
+	return &transactionSeqPersistorTask{
+		transactionSeqDb: transactionSeqDb,
+	}
+}
+
+type transactionSeqPersistorTask struct {
+	transactionSeqDb store.TransactionSeq
+}
+
+func (t *transactionSeqPersistorTask) GetName() string {
+	return TransactionSeqPersistorTaskName
+}
+
+func (t *transactionSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	// Delete transactions of current height first so reindexing height does not duplicate them
+	_, err := t.transactionSeqDb.DeleteForHeight(payload.CurrentHeight)
+	if err != nil {
+		return err
+	}
+
+	if err := t.transactionSeqDb.BulkUpsert(payload.TransactionSequences); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewEpochSummaryPersistorTask is responsible for storing epoch summaries to persistence layer
+func NewEpochSummaryPersistorTask(epochSummaryDb store.EpochSummary) pipeline.Task {
+	return &epochSummaryPersistorTask{
+		epochSummaryDb: epochSummaryDb,
+	}
+}
+
+type epochSummaryPersistorTask struct {
+	epochSummaryDb store.EpochSummary
+}
+
+func (t *epochSummaryPersistorTask) GetName() string {
+	return EpochSummaryPersistorTaskName
+}
+
+func (t *epochSummaryPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	if payload.EpochSummary == nil {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	return t.epochSummaryDb.BulkUpsert([]model.EpochSummary{*payload.EpochSummary})
+}
+
+// NewVoterRewardSeqPersistorTask is responsible for storing voter rewards to persistence layer
+func NewVoterRewardSeqPersistorTask(voterRewardSeqDb store.VoterRewardSeq) pipeline.Task {
+	return &voterRewardSeqPersistorTask{
+		voterRewardSeqDb: voterRewardSeqDb,
+	}
+}
+
+type voterRewardSeqPersistorTask struct {
+	voterRewardSeqDb store.VoterRewardSeq
+}
+
+func (t *voterRewardSeqPersistorTask) GetName() string {
+	return VoterRewardSeqPersistorTaskName
+}
+
+func (t *voterRewardSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	if payload.HeightMeta.LastInEpoch == nil || !*payload.HeightMeta.LastInEpoch {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	// Delete rewards of current height first so reindexing height does not duplicate them
+	_, err := t.voterRewardSeqDb.DeleteForHeight(payload.CurrentHeight)
+	if err != nil {
+		return err
+	}
+
+	return t.voterRewardSeqDb.BulkUpsert(payload.VoterRewardSequences)
+}
+
+// NewElectedValidatorSeqPersistorTask is responsible for storing elected validators to persistence layer
+func NewElectedValidatorSeqPersistorTask(electedValidatorSeqDb store.ElectedValidatorSeq) pipeline.Task {
+	return &electedValidatorSeqPersistorTask{
+		electedValidatorSeqDb: electedValidatorSeqDb,
+	}
+}
+
+type electedValidatorSeqPersistorTask struct {
+	electedValidatorSeqDb store.ElectedValidatorSeq
+}
+
+func (t *electedValidatorSeqPersistorTask) GetName() string {
+	return ElectedValidatorSeqPersistorTaskName
+}
+
+func (t *electedValidatorSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	if payload.HeightMeta.FirstInEpoch == nil || !*payload.HeightMeta.FirstInEpoch {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	// Delete elected validators of current height first so validators no longer elected after reindexing are not kept
+	_, err := t.electedValidatorSeqDb.DeleteForHeight(payload.CurrentHeight)
+	if err != nil {
+		return err
+	}
+
+	return t.electedValidatorSeqDb.BulkUpsert(payload.ElectedValidatorSequences)
+}
+
 // NewValidatorGroupSeqPersistorTask is responsible for storing validator era info to persistence layer
 func NewValidatorGroupSeqPersistorTask(validatorGroupSeqDb store.ValidatorGroupSeq) pipeline.Task {
 	return &validatorGroupSeqPersistorTask{
@@ -268,15 +401,52 @@ func (t *proposalAggPersistorTask) Run(ctx context.Context, p pipeline.Payload)
 	return nil
 }
 
-//NewSystemEventPersistorTask psql system events to persistance layer
-func NewSystemEventPersistorTask(systemEventDb store.SystemEvents) pipeline.Task {
+// NewVoterAggPersistorTask is responsible for storing voter aggregates to persistence layer
+func NewVoterAggPersistorTask(voterAggDb store.VoterAgg) pipeline.Task {
+	return &voterAggPersistorTask{
+		voterAggDb: voterAggDb,
+	}
+}
+
+type voterAggPersistorTask struct {
+	voterAggDb store.VoterAgg
+}
+
+func (t *voterAggPersistorTask) GetName() string {
+	return VoterAggPersistorTaskName
+}
+
+func (t *voterAggPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, aggregate := range payload.NewVoterAggregates {
+		if err := t.voterAggDb.Create(&aggregate); err != nil {
+			return err
+		}
+	}
+
+	for _, aggregate := range payload.UpdatedVoterAggregates {
+		if err := t.voterAggDb.Save(&aggregate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//NewSystemEventPersistorTask psql system events to persistance layer and enqueues them for webhook subscriptions
+func NewSystemEventPersistorTask(systemEventDb store.SystemEvents, dispatcher webhook.Dispatcher) pipeline.Task {
 	return &systemEventPersistorTask{
 		systemEventDb: systemEventDb,
+		dispatcher:    dispatcher,
 	}
 }
 
 type systemEventPersistorTask struct {
 	systemEventDb store.SystemEvents
+	dispatcher    webhook.Dispatcher
 }
 
 func (t *systemEventPersistorTask) GetName() string {
@@ -292,7 +462,18 @@ func (t *systemEventPersistorTask) Run(ctx context.Context, p pipeline.Payload)
 		return err
 	}
 
-	return nil
+	if t.dispatcher == nil {
+		return nil
+	}
+
+	// Only events of live indexing are sent to webhooks, backfill, reindex and repair runs recreate old events
+	report, ok := ctx.Value(CtxReport).(*model.Report)
+	if !ok || report.Kind != model.ReportKindIndex {
+		return nil
+	}
+
+	// Already enqueued events are skipped by dispatcher, so it is safe to enqueue again when task is retried
+	return t.dispatcher.Enqueue(payload.SystemEvents)
 }
 
 // NewGovernanceActivitySeqPersistorTask is responsible for storing validator info to persistence layer
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	mock "github.com/figment-networks/celo-indexer/mock/store"
+	webhookMock "github.com/figment-networks/celo-indexer/mock/webhook"
 	"github.com/figment-networks/celo-indexer/model"
 	"github.com/figment-networks/celo-indexer/types"
 	"github.com/golang/mock/gomock"
@@ -199,4 +200,111 @@ func TestValidatorGroupSeqPersistor_Run(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestTransactionSeqPersistor_Run(t *testing.T) {
+	seqs := []model.TransactionSeq{
+		{Sequence: &model.Sequence{Height: 20}, Hash: "tx1", Index: 0},
+		{Sequence: &model.Sequence{Height: 20}, Hash: "tx2", Index: 1},
+	}
+	var deleted int64 = 2
+
+	tests := []struct {
+		description string
+		deleteErr   error
+		upsertErr   error
+	}{
+		{"replaces transaction sequences of height", nil, nil},
+		{"returns error if delete fails", fmt.Errorf("db err"), nil},
+		{"returns error if upsert fails", nil, fmt.Errorf("db err")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			dbMock := mock.NewMockTransactionSeq(ctrl)
+
+			task := NewTransactionSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:        20,
+				TransactionSequences: seqs,
+			}
+
+			dbMock.EXPECT().DeleteForHeight(int64(20)).Return(&deleted, tt.deleteErr).Times(1)
+			expectErr := tt.deleteErr
+			if tt.deleteErr == nil {
+				dbMock.EXPECT().BulkUpsert(seqs).Return(tt.upsertErr).Times(1)
+				expectErr = tt.upsertErr
+			}
+
+			if err := task.Run(ctx, pl); err != expectErr {
+				t.Errorf("want %v; got %v", expectErr, err)
+			}
+		})
+	}
+}
+
+func TestSystemEventPersistor_Run(t *testing.T) {
+	events := []model.SystemEvent{
+		{Height: 20, Actor: "acct1", Kind: model.SystemEventMissedNofM},
+		{Height: 20, Actor: "acct2", Kind: model.SystemEventJoinedActiveSet},
+	}
+
+	tests := []struct {
+		description   string
+		report        *model.Report
+		dbErr         error
+		enqueueErr    error
+		expectEnqueue bool
+	}{
+		{"enqueues system events after they are stored", &model.Report{Kind: model.ReportKindIndex}, nil, nil, true},
+		{"returns error if database errors", &model.Report{Kind: model.ReportKindIndex}, fmt.Errorf("db err"), nil, false},
+		{"returns error if dispatcher errors", &model.Report{Kind: model.ReportKindIndex}, nil, fmt.Errorf("enqueue err"), true},
+		{"does not enqueue system events of backfill", &model.Report{Kind: model.ReportKindSequentialReindex}, nil, nil, false},
+		{"does not enqueue system events of reindex", &model.Report{Kind: model.ReportKindRangeReindex}, nil, nil, false},
+		{"does not enqueue system events of repair", &model.Report{Kind: model.ReportKindRepair}, nil, nil, false},
+		{"does not enqueue system events without report", nil, nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			if tt.report != nil {
+				ctx = context.WithValue(ctx, CtxReport, tt.report)
+			}
+
+			dbMock := mock.NewMockSystemEvents(ctrl)
+			dispatcherMock := webhookMock.NewMockDispatcher(ctrl)
+
+			task := NewSystemEventPersistorTask(dbMock, dispatcherMock)
+
+			pl := &payload{
+				Syncable:     &model.Syncable{Height: 20},
+				SystemEvents: events,
+			}
+
+			dbMock.EXPECT().BulkUpsert(events).Return(tt.dbErr).Times(1)
+			if tt.expectEnqueue {
+				dispatcherMock.EXPECT().Enqueue(events).Return(tt.enqueueErr).Times(1)
+			}
+
+			expectErr := tt.dbErr
+			if expectErr == nil {
+				expectErr = tt.enqueueErr
+			}
+			if err := task.Run(ctx, pl); err != expectErr {
+				t.Errorf("want %v; got %v", expectErr, err)
+			}
+		})
+	}
+}
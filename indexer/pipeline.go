@@ -2,20 +2,26 @@ package indexer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math/rand"
+	"time"
 
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/config"
 	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/publisher"
 	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
 	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/figment-networks/celo-indexer/webhook"
 	"github.com/figment-networks/indexing-engine/pipeline"
 	"github.com/pkg/errors"
 )
 
 const (
-	CtxReport  = "context_report"
-	maxRetries = 3
+	CtxReport = "context_report"
 )
 
 var (
@@ -24,6 +30,7 @@ var (
 	ErrIsPristine          = errors.New("cannot run because database is empty")
 	ErrIndexCannotBeRun    = errors.New("cannot run index process")
 	ErrBackfillCannotBeRun = errors.New("cannot run backfill process")
+	ErrNoReindexTargets    = errors.New("at least one target id is required to reindex")
 )
 
 type indexingPipeline struct {
@@ -42,10 +49,17 @@ type indexingPipeline struct {
 	proposalAggDb           store.ProposalAgg
 	systemEventDb           store.SystemEvents
 	governanceActivitySeqDb store.GovernanceActivitySeq
-
-	status       *pipelineStatus
-	configParser ConfigParser
-	pipeline     pipeline.CustomPipeline
+	transactionSeqDb        store.TransactionSeq
+	epochSummaryDb          store.EpochSummary
+	voterRewardSeqDb        store.VoterRewardSeq
+	voterAggDb              store.VoterAgg
+	electedValidatorSeqDb   store.ElectedValidatorSeq
+
+	publisher      publisher.Publisher
+	status         *pipelineStatus
+	configParser   ConfigParser
+	pipeline       pipeline.CustomPipeline
+	payloadFactory *payloadFactory
 }
 
 func NewPipeline(
@@ -64,47 +78,68 @@ func NewPipeline(
 	proposalAggDb store.ProposalAgg,
 	systemEventDb store.SystemEvents,
 	governanceActivitySeqDb store.GovernanceActivitySeq,
+	transactionSeqDb store.TransactionSeq,
+	epochSummaryDb store.EpochSummary,
+	voterRewardSeqDb store.VoterRewardSeq,
+	voterAggDb store.VoterAgg,
+	electedValidatorSeqDb store.ElectedValidatorSeq,
+	webhookSubscriptionDb store.WebhookSubscriptions,
+	webhookDeliveryDb store.WebhookDeliveries,
 ) (*indexingPipeline, error) {
-	p := pipeline.NewCustom(NewPayloadFactory())
+	dispatcherConfig, err := webhook.NewDispatcherConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dispatcher := webhook.NewDispatcher(webhookSubscriptionDb, webhookDeliveryDb, dispatcherConfig)
+
+	payloadFactory := NewPayloadFactory()
+	p := pipeline.NewCustom(payloadFactory)
 
 	// Setup logger
 	p.SetLogger(NewLogger())
 
 	// Setup stage
 	p.AddStage(
-		pipeline.NewStageWithTasks(pipeline.StageSetup, pipeline.RetryingTask(NewHeightMetaRetrieverTask(client.WithAssignedNode(0)), isTransient, maxRetries)),
+		pipeline.NewStageWithTasks(pipeline.StageSetup, setupTasks(cfg, client)...),
 	)
 
 	// Fetcher stage
 	p.AddStage(
-		pipeline.NewAsyncStageWithTasks(pipeline.StageFetcher,
-			pipeline.RetryingTask(NewBlockFetcherTask(client.WithAssignedNode(0)), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorFetcherTask(client.WithAssignedNode(1)), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorGroupFetcherTask(client.WithAssignedNode(2)), isTransient, maxRetries),
-			pipeline.RetryingTask(NewTransactionFetcherTask(client.WithAssignedNode(3)), isTransient, maxRetries),
-		),
+		pipeline.NewAsyncStageWithTasks(pipeline.StageFetcher, fetcherTasks(cfg, client)...),
 	)
 
 	p.AddStage(
-		pipeline.NewAsyncStageWithTasks(pipeline.StageParser,
-			pipeline.RetryingTask(NewGovernanceLogsParserTask(), isTransient, maxRetries),
-		),
+		pipeline.NewAsyncStageWithTasks(pipeline.StageParser, parserTasks(cfg)...),
 	)
 
+	syncerRetryPolicy := newRetryPolicy(cfg, pipeline.StageSyncer)
+	sequencerRetryPolicy := newRetryPolicy(cfg, pipeline.StageSequencer)
+	aggregatorRetryPolicy := newRetryPolicy(cfg, pipeline.StageAggregator)
+	analyzerRetryPolicy := newRetryPolicy(cfg, StageAnalyzer)
+	persistorRetryPolicy := newRetryPolicy(cfg, pipeline.StagePersistor)
+
 	// Syncer stage
 	p.AddStage(
-		pipeline.NewStageWithTasks(pipeline.StageSyncer, pipeline.RetryingTask(NewMainSyncerTask(syncableDb), isTransient, maxRetries)),
+		pipeline.NewStageWithTasks(
+			pipeline.StageSyncer,
+			newRetryingTask(NewMainSyncerTask(syncableDb), syncerRetryPolicy),
+			newRetryingTask(NewValidatorSignersSyncerTask(client, electedValidatorSeqDb), syncerRetryPolicy),
+		),
 	)
 
 	// Set sequencer stage
 	p.AddStage(
 		pipeline.NewAsyncStageWithTasks(
 			pipeline.StageSequencer,
-			pipeline.RetryingTask(NewBlockSeqCreatorTask(blockSeqDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorSeqCreatorTask(cfg), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorGroupSeqCreatorTask(cfg), isTransient, maxRetries),
-			pipeline.RetryingTask(NewAccountActivitySeqCreatorTask(cfg), isTransient, maxRetries),
-			pipeline.RetryingTask(NewGovernanceActivitySeqCreatorTask(cfg), isTransient, maxRetries),
+			newRetryingTask(NewBlockSeqCreatorTask(blockSeqDb), sequencerRetryPolicy),
+			newRetryingTask(NewValidatorSeqCreatorTask(cfg), sequencerRetryPolicy),
+			newRetryingTask(NewValidatorGroupSeqCreatorTask(cfg), sequencerRetryPolicy),
+			newRetryingTask(NewAccountActivitySeqCreatorTask(cfg), sequencerRetryPolicy),
+			newRetryingTask(NewGovernanceActivitySeqCreatorTask(cfg), sequencerRetryPolicy),
+			newRetryingTask(NewTransactionSeqCreatorTask(), sequencerRetryPolicy),
+			newRetryingTask(NewEpochSummaryCreatorTask(), sequencerRetryPolicy),
+			newRetryingTask(NewVoterRewardSeqCreatorTask(client.WithAssignedNode(2)), sequencerRetryPolicy),
+			newRetryingTask(NewElectedValidatorSeqCreatorTask(), sequencerRetryPolicy),
 		),
 	)
 
@@ -112,9 +147,10 @@ func NewPipeline(
 	p.AddStage(
 		pipeline.NewAsyncStageWithTasks(
 			pipeline.StageAggregator,
-			pipeline.RetryingTask(NewValidatorAggCreatorTask(client.WithAssignedNode(0), validatorAggDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorGroupAggCreatorTask(client.WithAssignedNode(1), validatorGroupAggDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewProposalAggCreatorTask(proposalAggDb), isTransient, maxRetries),
+			newRetryingTask(NewValidatorAggCreatorTask(cfg, client.WithAssignedNode(0), validatorAggDb), aggregatorRetryPolicy),
+			newRetryingTask(NewValidatorGroupAggCreatorTask(cfg, client.WithAssignedNode(1), validatorGroupAggDb), aggregatorRetryPolicy),
+			newRetryingTask(NewProposalAggCreatorTask(proposalAggDb), aggregatorRetryPolicy),
+			newRetryingTask(NewVoterAggCreatorTask(voterAggDb), aggregatorRetryPolicy),
 		),
 	)
 
@@ -122,7 +158,7 @@ func NewPipeline(
 	p.AddStage(
 		pipeline.NewStageWithTasks(
 			StageAnalyzer,
-			pipeline.RetryingTask(NewSystemEventCreatorTask(cfg, validatorSeqDb, accountActivitySeqDb, validatorGroupSeqDb), isTransient, maxRetries),
+			newRetryingTask(NewSystemEventCreatorTask(cfg, validatorSeqDb, accountActivitySeqDb, electedValidatorSeqDb, validatorAggDb, validatorGroupAggDb), analyzerRetryPolicy),
 		),
 	)
 
@@ -130,16 +166,21 @@ func NewPipeline(
 	p.AddStage(
 		pipeline.NewAsyncStageWithTasks(
 			pipeline.StagePersistor,
-			pipeline.RetryingTask(NewSyncerPersistorTask(syncableDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewSystemEventPersistorTask(systemEventDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewBlockSeqPersistorTask(blockSeqDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorSeqPersistorTask(validatorSeqDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorGroupSeqPersistorTask(validatorGroupSeqDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewAccountActivitySeqPersistorTask(accountActivitySeqDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewGovernanceActivitySeqPersistorTask(governanceActivitySeqDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorAggPersistorTask(validatorAggDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewValidatorGroupAggPersistorTask(validatorGroupAggDb), isTransient, maxRetries),
-			pipeline.RetryingTask(NewProposalAggPersistorTask(proposalAggDb), isTransient, maxRetries),
+			newRetryingTask(NewSyncerPersistorTask(syncableDb), persistorRetryPolicy),
+			newRetryingTask(NewSystemEventPersistorTask(systemEventDb, dispatcher), persistorRetryPolicy),
+			newRetryingTask(NewBlockSeqPersistorTask(blockSeqDb), persistorRetryPolicy),
+			newRetryingTask(NewValidatorSeqPersistorTask(validatorSeqDb), persistorRetryPolicy),
+			newRetryingTask(NewValidatorGroupSeqPersistorTask(validatorGroupSeqDb), persistorRetryPolicy),
+			newRetryingTask(NewAccountActivitySeqPersistorTask(accountActivitySeqDb), persistorRetryPolicy),
+			newRetryingTask(NewGovernanceActivitySeqPersistorTask(governanceActivitySeqDb), persistorRetryPolicy),
+			newRetryingTask(NewTransactionSeqPersistorTask(transactionSeqDb), persistorRetryPolicy),
+			newRetryingTask(NewEpochSummaryPersistorTask(epochSummaryDb), persistorRetryPolicy),
+			newRetryingTask(NewVoterRewardSeqPersistorTask(voterRewardSeqDb), persistorRetryPolicy),
+			newRetryingTask(NewElectedValidatorSeqPersistorTask(electedValidatorSeqDb), persistorRetryPolicy),
+			newRetryingTask(NewValidatorAggPersistorTask(validatorAggDb), persistorRetryPolicy),
+			newRetryingTask(NewValidatorGroupAggPersistorTask(validatorGroupAggDb), persistorRetryPolicy),
+			newRetryingTask(NewProposalAggPersistorTask(proposalAggDb), persistorRetryPolicy),
+			newRetryingTask(NewVoterAggPersistorTask(voterAggDb), persistorRetryPolicy),
 		),
 	)
 
@@ -149,6 +190,11 @@ func NewPipeline(
 		return nil, err
 	}
 
+	pub, err := publisher.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	statusChecker := pipelineStatusChecker{syncableDb, configParser.GetCurrentVersionId()}
 	pipelineStatus, err := statusChecker.getStatus()
 	if err != nil {
@@ -171,24 +217,126 @@ func NewPipeline(
 		proposalAggDb:           proposalAggDb,
 		systemEventDb:           systemEventDb,
 		governanceActivitySeqDb: governanceActivitySeqDb,
-
-		pipeline:     p,
-		status:       pipelineStatus,
-		configParser: configParser,
+		transactionSeqDb:        transactionSeqDb,
+		epochSummaryDb:          epochSummaryDb,
+		voterRewardSeqDb:        voterRewardSeqDb,
+		voterAggDb:              voterAggDb,
+		electedValidatorSeqDb:   electedValidatorSeqDb,
+
+		publisher:      pub,
+		pipeline:       p,
+		payloadFactory: payloadFactory,
+		status:         pipelineStatus,
+		configParser:   configParser,
 	}, nil
 }
 
+// setupTasks returns tasks of setup stage
+func setupTasks(cfg *config.Config, client figmentclient.Client) []pipeline.Task {
+	return []pipeline.Task{
+		newRetryingTask(NewHeightMetaRetrieverTask(client.WithAssignedNode(0)), newRetryPolicy(cfg, pipeline.StageSetup)),
+	}
+}
+
+// fetcherTasks returns tasks of fetcher stage
+func fetcherTasks(cfg *config.Config, client figmentclient.Client) []pipeline.Task {
+	policy := newRetryPolicy(cfg, pipeline.StageFetcher)
+	return []pipeline.Task{
+		newRetryingTask(NewBlockFetcherTask(client.WithAssignedNode(0)), policy),
+		newRetryingTask(NewValidatorFetcherTask(client.WithAssignedNode(1)), policy),
+		newRetryingTask(NewValidatorGroupFetcherTask(client.WithAssignedNode(2)), policy),
+		newRetryingTask(NewTransactionFetcherTask(client.WithAssignedNode(3)), policy),
+		newRetryingTask(NewEpochSummaryFetcherTask(client.WithAssignedNode(4)), policy),
+		newRetryingTask(NewElectedValidatorsFetcherTask(client.WithAssignedNode(1)), policy),
+	}
+}
+
+// parserTasks returns tasks of parser stage
+func parserTasks(cfg *config.Config) []pipeline.Task {
+	return []pipeline.Task{
+		newRetryingTask(NewGovernanceLogsParserTask(), newRetryPolicy(cfg, pipeline.StageParser)),
+	}
+}
+
 type IndexConfig struct {
-	BatchSize   int64
-	StartHeight int64
+	BatchSize       int64
+	StartHeight     int64
+	ParallelHeights int64
 }
 
 // Start starts indexing process
 func (p *indexingPipeline) Start(ctx context.Context, indexCfg IndexConfig) error {
+	defer p.closePublisher()
+
 	if err := p.canRunIndex(); err != nil {
 		return err
 	}
 
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindIndex,
+		indexVersion: p.configParser.GetCurrentVersionId(),
+		reportDb:     p.reportDb,
+	}
+
+	result, err := p.index(ctx, indexCfg, reportCreator)
+
+	// Report is created only when there are heights to index
+	if reportCreator.report == nil {
+		return err
+	}
+
+	// Errors of indexed heights are not returned, they are recorded in report
+	reportErr := result.pipelineErr
+	if err != nil {
+		reportErr = err
+	}
+	if completeErr := reportCreator.complete(result.totalCount, result.successCount, reportErr); completeErr != nil && err == nil {
+		return completeErr
+	}
+	return err
+}
+
+// indexResult describes heights indexed by index
+type indexResult struct {
+	totalCount   int64
+	successCount int64
+
+	// pipelineErr is error of the last pipeline run, which is recorded in report
+	pipelineErr error
+}
+
+// index indexes one batch of heights within given report. Report is created by the first pipeline run and its end height moves with every run.
+// When chain reorganization is detected, indexed data above fork point is rolled back and indexing continues from it in the same report
+func (p *indexingPipeline) index(ctx context.Context, indexCfg IndexConfig, reportCreator *reportCreator) (indexResult, error) {
+	var result indexResult
+	for {
+		totalCount, successCount, err := p.runIndexPipeline(ctx, indexCfg, reportCreator)
+		result.totalCount += totalCount
+		result.successCount += successCount
+		if totalCount == 0 {
+			return result, err
+		}
+		result.pipelineErr = err
+
+		var reorgErr *ChainReorganizationError
+		if !errors.As(err, &reorgErr) {
+			return result, nil
+		}
+
+		forkHeight, err := p.rollback(ctx, reorgErr.Height)
+		if err != nil {
+			return result, err
+		}
+
+		logger.Info(fmt.Sprintf("restarting pipeline after rollback [fork_height=%d]", forkHeight))
+
+		indexCfg.StartHeight = forkHeight + 1
+	}
+}
+
+// runIndexPipeline runs pipeline for one batch of heights. It returns numbers of all and successfully indexed heights together with error of pipeline.
+// Errors returned before pipeline is started are returned with no heights
+func (p *indexingPipeline) runIndexPipeline(ctx context.Context, indexCfg IndexConfig, reportCreator *reportCreator) (int64, int64, error) {
 	indexVersion := p.configParser.GetCurrentVersionId()
 
 	source, err := NewIndexSource(p.cfg, p.client, p.syncableDb, &IndexSourceConfig{
@@ -196,21 +344,19 @@ func (p *indexingPipeline) Start(ctx context.Context, indexCfg IndexConfig) erro
 		StartHeight: indexCfg.StartHeight,
 	})
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
-	sink := NewSink(p.syncableDb, p.databaseDb, p.client, indexVersion)
+	sink := NewSink(p.syncableDb, p.databaseDb, p.client, p.publisher, indexVersion)
 
-	reportCreator := &reportCreator{
-		kind:         model.ReportKindIndex,
-		indexVersion: indexVersion,
-		startHeight:  source.startHeight,
-		endHeight:    source.endHeight,
-		reportDb:     p.reportDb,
-	}
-
-	if err := reportCreator.create(); err != nil {
-		return err
+	if reportCreator.report == nil {
+		reportCreator.startHeight = source.startHeight
+		reportCreator.endHeight = source.endHeight
+		if err := reportCreator.create(); err != nil {
+			return 0, 0, err
+		}
+	} else {
+		reportCreator.report.EndHeight = source.endHeight
 	}
 
 	versionIds := p.configParser.GetAllVersionedVersionIds()
@@ -220,18 +366,174 @@ func (p *indexingPipeline) Start(ctx context.Context, indexCfg IndexConfig) erro
 	}
 	pipelineOptions, err := pipelineOptionsCreator.parse()
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
-	logger.Info(fmt.Sprintf("starting pipeline [start=%d] [end=%d]", source.startHeight, source.endHeight))
+	logger.Info(fmt.Sprintf("starting pipeline [start=%d] [end=%d] [parallel_heights=%d]", source.startHeight, source.endHeight, indexCfg.ParallelHeights))
 
 	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
-	err = p.pipeline.Start(ctxWithReport, source, sink, pipelineOptions)
+
+	var pipelineSource pipeline.Source = source
+	if indexCfg.ParallelHeights > 1 {
+		prefetchCtx, cancel := context.WithCancel(ctxWithReport)
+		defer cancel()
+
+		p.payloadFactory.reset()
+		prefetcher := newHeightPrefetcher(p.cfg, p.client, pipelineOptions, indexCfg.ParallelHeights, source.startHeight, source.endHeight)
+		pipelineSource = NewParallelIndexSource(prefetchCtx, source, prefetcher, p.payloadFactory)
+	}
+
+	err = p.pipeline.Start(ctxWithReport, pipelineSource, sink, pipelineOptions)
 
 	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
 
-	err = reportCreator.complete(source.Len(), sink.successCount, err)
-	return err
+	return source.Len(), sink.successCount, err
+}
+
+// rollback removes indexed data above fork point of chain reorganization detected at given height
+func (p *indexingPipeline) rollback(ctx context.Context, height int64) (int64, error) {
+	handler := &reorgHandler{
+		client: p.client,
+
+		syncableDb:              p.syncableDb,
+		blockSeqDb:              p.blockSeqDb,
+		validatorSeqDb:          p.validatorSeqDb,
+		validatorGroupSeqDb:     p.validatorGroupSeqDb,
+		accountActivitySeqDb:    p.accountActivitySeqDb,
+		governanceActivitySeqDb: p.governanceActivitySeqDb,
+		transactionSeqDb:        p.transactionSeqDb,
+		epochSummaryDb:          p.epochSummaryDb,
+		voterRewardSeqDb:        p.voterRewardSeqDb,
+		electedValidatorSeqDb:   p.electedValidatorSeqDb,
+		systemEventDb:           p.systemEventDb,
+		validatorAggDb:          p.validatorAggDb,
+		validatorGroupAggDb:     p.validatorGroupAggDb,
+		proposalAggDb:           p.proposalAggDb,
+		voterAggDb:              p.voterAggDb,
+	}
+
+	forkHeight, forkTime, err := handler.findForkHeight(ctx, height)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := handler.rollback(forkHeight, *forkTime); err != nil {
+		return 0, err
+	}
+	return forkHeight, nil
+}
+
+type FollowConfig struct {
+	BatchSize       int64
+	ParallelHeights int64
+	PollInterval    time.Duration
+}
+
+// Follow keeps indexing new heights as soon as they are produced. Failed batches are logged and indexed again after backoff,
+// so follow mode stops only when context is done
+func (p *indexingPipeline) Follow(ctx context.Context, followCfg FollowConfig) error {
+	defer p.closePublisher()
+
+	if err := p.canRunIndex(); err != nil {
+		return err
+	}
+
+	watcher := newHeadWatcher(p.client, followCfg.PollInterval)
+	go watcher.run(ctx)
+
+	startHeight, err := p.mostRecentHeight()
+	if err != nil {
+		return err
+	}
+	if startHeight == 0 {
+		startHeight = p.cfg.FirstBlockHeight
+	} else {
+		startHeight++
+	}
+
+	// All batches indexed by follow mode are recorded in one report, completed when follow mode stops
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindIndex,
+		indexVersion: p.configParser.GetCurrentVersionId(),
+		startHeight:  startHeight,
+		endHeight:    startHeight,
+		reportDb:     p.reportDb,
+	}
+	if err := reportCreator.create(); err != nil {
+		return err
+	}
+
+	var totalCount, successCount int64
+	defer func() {
+		if err := reportCreator.complete(totalCount, successCount, nil); err != nil {
+			logger.Error(err)
+		}
+	}()
+
+	backoffPolicy := newRetryPolicy(p.cfg, "")
+	var failedAttempts int64
+	for {
+		prevHeight, err := p.mostRecentHeight()
+		if err == nil {
+			var result indexResult
+			result, err = p.index(ctx, IndexConfig{
+				BatchSize:       followCfg.BatchSize,
+				ParallelHeights: followCfg.ParallelHeights,
+			}, reportCreator)
+			totalCount += result.totalCount
+			successCount += result.successCount
+			if err == nil {
+				err = result.pipelineErr
+			}
+			if err == ErrNothingToProcess {
+				err = nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var currentHeight int64
+		if err == nil {
+			currentHeight, err = p.mostRecentHeight()
+		}
+
+		if err != nil {
+			failedAttempts++
+			delay := backoffPolicy.backoff(failedAttempts, rand.Float64)
+			logger.Error(errors.Wrap(err, fmt.Sprintf("follow mode failed to index new heights, retrying [attempt=%d] [delay=%s]", failedAttempts, delay)))
+
+			if err := sleep(ctx, delay); err != nil {
+				return err
+			}
+			continue
+		}
+		failedAttempts = 0
+
+		// Keep indexing without waiting for new heads until pipeline catches up with the chain
+		if currentHeight > prevHeight {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case height := <-watcher.heads:
+			logger.Info(fmt.Sprintf("received new head [height=%d] [indexed_height=%d]", height, currentHeight))
+		}
+	}
+}
+
+func (p *indexingPipeline) mostRecentHeight() (int64, error) {
+	syncable, err := p.syncableDb.FindMostRecent()
+	if err != nil {
+		if err == psql.ErrNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return syncable.Height, nil
 }
 
 func (p *indexingPipeline) canRunIndex() error {
@@ -250,6 +552,8 @@ type BackfillConfig struct {
 }
 
 func (p *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillConfig) error {
+	defer p.closePublisher()
+
 	if err := p.canRunBackfill(backfillCfg.Parallel); err != nil {
 		return err
 	}
@@ -261,7 +565,7 @@ func (p *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 		return err
 	}
 
-	sink := NewSink(p.syncableDb, p.databaseDb, p.client, indexVersion)
+	sink := NewSink(p.syncableDb, p.databaseDb, p.client, p.publisher, indexVersion)
 
 	kind := model.ReportKindSequentialReindex
 	if backfillCfg.Parallel {
@@ -328,6 +632,182 @@ func (p *indexingPipeline) canRunBackfill(isParallel bool) error {
 	return nil
 }
 
+type ReindexConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	TargetIds   []int64
+}
+
+// Reindex deletes data of given targets within height range and indexes it again
+func (p *indexingPipeline) Reindex(ctx context.Context, reindexCfg ReindexConfig) error {
+	defer p.closePublisher()
+
+	if len(reindexCfg.TargetIds) == 0 {
+		return ErrNoReindexTargets
+	}
+
+	indexVersion := p.configParser.GetCurrentVersionId()
+
+	source, err := NewReindexSource(p.syncableDb, &ReindexSourceConfig{
+		StartHeight: reindexCfg.StartHeight,
+		EndHeight:   reindexCfg.EndHeight,
+	})
+	if err != nil {
+		return err
+	}
+
+	sink := NewSink(p.syncableDb, p.databaseDb, p.client, p.publisher, indexVersion)
+
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:     p.configParser,
+		desiredTargetIds: reindexCfg.TargetIds,
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
+	if err != nil {
+		return err
+	}
+
+	cleaner := &rangeCleaner{
+		blockSeqDb:              p.blockSeqDb,
+		validatorSeqDb:          p.validatorSeqDb,
+		validatorGroupSeqDb:     p.validatorGroupSeqDb,
+		accountActivitySeqDb:    p.accountActivitySeqDb,
+		governanceActivitySeqDb: p.governanceActivitySeqDb,
+		transactionSeqDb:        p.transactionSeqDb,
+		epochSummaryDb:          p.epochSummaryDb,
+		voterRewardSeqDb:        p.voterRewardSeqDb,
+		electedValidatorSeqDb:   p.electedValidatorSeqDb,
+		systemEventDb:           p.systemEventDb,
+	}
+	if err := cleaner.clean(pipelineOptions.TaskWhitelist, source.startHeight, source.endHeight); err != nil {
+		return err
+	}
+
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindRangeReindex,
+		indexVersion: indexVersion,
+		startHeight:  source.startHeight,
+		endHeight:    source.endHeight,
+		reportDb:     p.reportDb,
+	}
+
+	if err := reportCreator.create(); err != nil {
+		return err
+	}
+
+	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
+
+	logger.Info(fmt.Sprintf("starting pipeline reindex [start=%d] [end=%d] [targets=%v]", source.startHeight, source.endHeight, reindexCfg.TargetIds))
+
+	err = p.pipeline.Start(ctxWithReport, source, sink, pipelineOptions)
+
+	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
+
+	return reportCreator.complete(source.Len(), sink.successCount, err)
+}
+
+// Repair indexes heights which are missing or were not processed within indexed range
+func (p *indexingPipeline) Repair(ctx context.Context) error {
+	defer p.closePublisher()
+
+	if err := p.canRunIndex(); err != nil {
+		return err
+	}
+
+	indexVersion := p.configParser.GetCurrentVersionId()
+
+	source, err := NewRepairSource(p.syncableDb)
+	if err != nil {
+		return err
+	}
+
+	sink := NewSink(p.syncableDb, p.databaseDb, p.client, p.publisher, indexVersion)
+
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindRepair,
+		indexVersion: indexVersion,
+		startHeight:  source.startHeight,
+		endHeight:    source.endHeight,
+		reportDb:     p.reportDb,
+	}
+
+	if err := reportCreator.create(); err != nil {
+		return err
+	}
+
+	versionIds := p.configParser.GetAllVersionedVersionIds()
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:      p.configParser,
+		desiredVersionIds: versionIds,
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
+	if err != nil {
+		return err
+	}
+
+	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
+
+	logger.Info(fmt.Sprintf("starting pipeline repair [gaps=%d] [heights=%d] [start=%d] [end=%d]", len(source.gaps), source.Len(), source.startHeight, source.endHeight))
+
+	err = p.pipeline.Start(ctxWithReport, source, sink, pipelineOptions)
+
+	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
+
+	return reportCreator.complete(source.Len(), sink.successCount, err)
+}
+
+type VerifyConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	SampleSize  int64
+	Output      io.Writer
+}
+
+// Verify compares stored sequences with data from the node and writes discrepancies as JSON lines to output.
+// All heights within range are verified unless sample size is given
+func (p *indexingPipeline) Verify(ctx context.Context, verifyCfg VerifyConfig) error {
+	if verifyCfg.EndHeight < verifyCfg.StartHeight {
+		return ErrHeightRangeInvalid
+	}
+
+	verifier := newHeightVerifier(p.cfg, p.client, p.syncableDb, p.blockSeqDb, p.validatorSeqDb, p.validatorGroupSeqDb, p.accountActivitySeqDb, p.electedValidatorSeqDb)
+	encoder := json.NewEncoder(verifyCfg.Output)
+
+	var heights []int64
+	if verifyCfg.SampleSize > 0 {
+		heights = sampleHeights(verifyCfg.StartHeight, verifyCfg.EndHeight, verifyCfg.SampleSize, rand.New(rand.NewSource(time.Now().UnixNano())))
+	} else {
+		for height := verifyCfg.StartHeight; height <= verifyCfg.EndHeight; height++ {
+			heights = append(heights, height)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("starting verification [start=%d] [end=%d] [heights=%d]", verifyCfg.StartHeight, verifyCfg.EndHeight, len(heights)))
+
+	var discrepancyCount int
+	for _, height := range heights {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		discrepancies, err := verifier.verify(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		for _, discrepancy := range discrepancies {
+			if err := encoder.Encode(discrepancy); err != nil {
+				return err
+			}
+		}
+		discrepancyCount += len(discrepancies)
+	}
+
+	logger.Info(fmt.Sprintf("verification completed [heights=%d] [discrepancies=%d]", len(heights), discrepancyCount))
+
+	return nil
+}
+
 type RunConfig struct {
 	Height            int64
 	DesiredVersionIDs []int64
@@ -366,6 +846,12 @@ func (p *indexingPipeline) Run(ctx context.Context, runCfg RunConfig) (*payload,
 	return payload, nil
 }
 
-func isTransient(error) bool {
-	return true
+// closePublisher closes connection of publisher. Publisher reconnects when it is used again
+func (p *indexingPipeline) closePublisher() {
+	if p.publisher == nil {
+		return
+	}
+	if err := p.publisher.Close(); err != nil {
+		logger.Error(err)
+	}
 }
@@ -0,0 +1,561 @@
+package indexer
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/client/theceloclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/memory"
+	"github.com/figment-networks/celo-indexer/usecase/governance"
+	"github.com/pkg/errors"
+)
+
+const (
+	fixturesNodeDir       = "testdata/fixtures/node"
+	fixturesProposalsPath = "testdata/fixtures/thecelo/proposals.json"
+
+	// Fixtures span end of first epoch (17280) together with queued proposal going through governance stages
+	fixturesFirstHeight int64 = 17278
+	fixturesLastHeight  int64 = 17282
+)
+
+var (
+	recordNodeUrl    = flag.String("fixtures.node", "", "record pipeline fixtures from given node url before running end-to-end tests")
+	recordTheCeloUrl = flag.String("fixtures.thecelo", "", "record proposals fixture from given The Celo url before running end-to-end tests")
+)
+
+func TestPipeline_Start(t *testing.T) {
+	recordFixtures(t)
+
+	db := memory.New()
+	runFixturesPipeline(t, db)
+
+	t.Run("marks all heights as processed", func(t *testing.T) {
+		for h := fixturesFirstHeight; h <= fixturesLastHeight; h++ {
+			syncable, err := db.GetCore().Syncables.FindByHeight(h)
+			if err != nil {
+				t.Fatalf("syncable not found [height=%d]: %v", h, err)
+			}
+			if syncable.ProcessedAt == nil || syncable.Hash == "" {
+				t.Errorf("syncable not processed [height=%d]: %+v", h, syncable)
+			}
+		}
+	})
+
+	t.Run("creates sequences", func(t *testing.T) {
+		for h := fixturesFirstHeight; h <= fixturesLastHeight; h++ {
+			if _, err := db.GetBlocks().BlockSeq.FindByHeight(h); err != nil {
+				t.Errorf("block sequence not found [height=%d]: %v", h, err)
+			}
+		}
+
+		validatorSeqs, _ := db.GetValidators().ValidatorSeq.FindByHeight(fixturesLastHeight)
+		if len(validatorSeqs) != 3 {
+			t.Errorf("unexpected validator sequences count, want 3; got %d", len(validatorSeqs))
+		}
+		validatorGroupSeqs, _ := db.GetValidatorGroups().ValidatorGroupSeq.FindByHeight(fixturesLastHeight)
+		if len(validatorGroupSeqs) != 2 {
+			t.Errorf("unexpected validator group sequences count, want 2; got %d", len(validatorGroupSeqs))
+		}
+
+		epochActivities, _ := db.GetAccounts().AccountActivitySeq.FindByHeight(17280)
+		kinds := map[string]bool{}
+		for _, activity := range epochActivities {
+			kinds[activity.Kind] = true
+		}
+		for _, kind := range []string{OperationTypeValidatorEpochPaymentDistributedForGroup, OperationTypeValidatorEpochPaymentDistributedForValidator} {
+			if !kinds[kind] {
+				t.Errorf("account activity of kind %s not found at end of epoch", kind)
+			}
+		}
+
+		governanceActivities, _ := db.GetGovernance().GovernanceActivitySeq.FindLastByProposalId(7, 0)
+		if len(governanceActivities) != 4 {
+			t.Errorf("unexpected governance activities count, want 4; got %d", len(governanceActivities))
+		}
+
+		transactions, _ := db.GetTransactions().TransactionSeq.FindByHeight(17280)
+		if len(transactions) == 0 {
+			t.Fatalf("transaction sequences not found at end of epoch")
+		}
+		transaction, err := db.GetTransactions().TransactionSeq.FindByHash(transactions[0].Hash)
+		if err != nil {
+			t.Fatalf("transaction sequence not found by hash: %v", err)
+		}
+		if transaction.FromAddress == "" || transaction.GasPrice.Int64() == 0 || len(transaction.Operations.RawMessage) == 0 {
+			t.Errorf("unexpected transaction sequence: %+v", transaction)
+		}
+
+		epochSummary, err := db.GetEpochs().EpochSummary.FindByEpoch(1)
+		if err != nil {
+			t.Fatalf("epoch summary not found: %v", err)
+		}
+		if epochSummary.Height != 17280 || epochSummary.ElectedValidatorsCount != 3 || epochSummary.VoterRewards.String() != "6150000000000000000000" {
+			t.Errorf("unexpected epoch summary: %+v", epochSummary)
+		}
+		if summaries, _, _ := db.GetEpochs().EpochSummary.All(0, nil); len(summaries) != 1 {
+			t.Errorf("unexpected epoch summaries count, want 1; got %d", len(summaries))
+		}
+
+		voterRewards, err := db.GetAccounts().VoterRewardSeq.FindByHeight(17280)
+		if err != nil {
+			t.Fatalf("cannot find voter rewards: %v", err)
+		}
+		if len(voterRewards) != 1 || voterRewards[0].Epoch != 1 || voterRewards[0].Group != fixturesGroup || voterRewards[0].Amount.String() != "41000000000000000000" {
+			t.Errorf("unexpected voter rewards: %+v", voterRewards)
+		}
+		rewards, _, err := db.GetAccounts().VoterRewardSeq.FindByAddress(voterRewards[0].Address, store.AddressHistoryQuery{})
+		if err != nil || len(rewards) != 1 {
+			t.Errorf("voter rewards not found by address: %v", err)
+		}
+
+		electedValidators, err := db.GetEpochs().ElectedValidatorSeq.FindByEpoch(2)
+		if err != nil {
+			t.Fatalf("cannot find elected validators: %v", err)
+		}
+		if len(electedValidators) != 3 || electedValidators[0].Height != 17281 || electedValidators[1].Address != fixturesMissingValidator || electedValidators[2].Group != fixturesGroup {
+			t.Errorf("unexpected elected validators: %+v", electedValidators)
+		}
+		if prevElectedValidators, _ := db.GetEpochs().ElectedValidatorSeq.FindByEpoch(1); len(prevElectedValidators) != 0 {
+			t.Errorf("unexpected elected validators of epoch which started before first indexed height: %+v", prevElectedValidators)
+		}
+
+		block, err := db.GetBlocks().BlockSeq.FindByHeight(fixturesLastHeight)
+		if err != nil {
+			t.Fatalf("block sequence not found: %v", err)
+		}
+		if block.Proposer != fixturesJoinedValidator || block.Round != 1 || block.SignersBitmap != "101" {
+			t.Errorf("unexpected proposer and signers of block: %+v", block)
+		}
+		for i, electedValidator := range electedValidators {
+			if signed := block.IsSigner(electedValidator.Index); signed != (electedValidator.Address != fixturesMissingValidator) {
+				t.Errorf("unexpected signed flag of elected validator %d: %v", i, signed)
+			}
+		}
+	})
+
+	t.Run("paginates address history", func(t *testing.T) {
+		query := store.AddressHistoryQuery{Limit: 3}
+		firstPage, cursor, err := db.GetTransactions().TransactionSeq.FindByAddress(fixturesSender, query)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(firstPage) != 3 || firstPage[0].Height != fixturesLastHeight || cursor == nil {
+			t.Fatalf("unexpected first page of transactions: %+v [cursor=%v]", firstPage, cursor)
+		}
+
+		query.Cursor = cursor
+		secondPage, cursor, _ := db.GetTransactions().TransactionSeq.FindByAddress(fixturesSender, query)
+		if len(secondPage) != 1 || secondPage[0].Height != fixturesFirstHeight || cursor != nil {
+			t.Errorf("unexpected second page of transactions: %+v [cursor=%v]", secondPage, cursor)
+		}
+
+		// Reindexed height gets new ids but keeps its place in history
+		reindexed, _ := db.GetTransactions().TransactionSeq.FindByHeight(fixturesFirstHeight)
+		db.GetTransactions().TransactionSeq.DeleteForHeight(fixturesFirstHeight)
+		db.GetTransactions().TransactionSeq.BulkUpsert(reindexed)
+
+		query.Cursor = nil
+		firstPage, cursor, _ = db.GetTransactions().TransactionSeq.FindByAddress(fixturesSender, query)
+		if len(firstPage) != 3 || firstPage[0].Height != fixturesLastHeight || cursor == nil {
+			t.Fatalf("unexpected first page of transactions after reindex: %+v [cursor=%v]", firstPage, cursor)
+		}
+		query.Cursor = cursor
+		secondPage, _, _ = db.GetTransactions().TransactionSeq.FindByAddress(fixturesSender, query)
+		if len(secondPage) != 1 || secondPage[0].Height != fixturesFirstHeight {
+			t.Errorf("unexpected second page of transactions after reindex: %+v", secondPage)
+		}
+
+		endHeight := int64(17281)
+		filtered, _, _ := db.GetTransactions().TransactionSeq.FindByAddress(fixturesSender, store.AddressHistoryQuery{
+			Kinds:     []string{figmentclient.OperationTypeInternalTransfer},
+			Direction: store.AddressHistoryDirectionSent,
+			EndHeight: &endHeight,
+		})
+		if len(filtered) != 1 || filtered[0].Height != fixturesFirstHeight {
+			t.Errorf("unexpected filtered transactions: %+v", filtered)
+		}
+
+		activities, _, _ := db.GetAccounts().AccountActivitySeq.FindByAddress(fixturesSender, store.AddressHistoryQuery{
+			Direction: store.AddressHistoryDirectionSent,
+		})
+		if len(activities) == 0 {
+			t.Errorf("sent account activities not found")
+		}
+		for _, activity := range activities {
+			if !strings.HasSuffix(activity.Kind, "Sent") {
+				t.Errorf("unexpected account activity kind for sent direction: %s", activity.Kind)
+			}
+		}
+	})
+
+	t.Run("indexes token transfers", func(t *testing.T) {
+		received, _, err := db.GetAccounts().AccountActivitySeq.FindByAddress(fixturesSender, store.AddressHistoryQuery{
+			Kinds: []string{OperationTypeTokenTransferReceived},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(received) != 1 || received[0].Token != "cUSD" || received[0].Counterparty != "0x3C2b1a0f9E8d7C6b5A4f3E2d1C0B9a8F7e6d5C4B" {
+			t.Fatalf("unexpected received token transfers: %+v", received)
+		}
+
+		transfers, cursor, err := db.GetAccounts().AccountActivitySeq.FindTransfers("cUSD", fixturesSender, store.AddressHistoryQuery{Limit: 10})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(transfers) != 1 || cursor != nil {
+			t.Fatalf("unexpected transfers: %+v [cursor=%v]", transfers, cursor)
+		}
+		if transfers[0].Kind != OperationTypeTokenTransferSent || transfers[0].Counterparty != fixturesSender || transfers[0].Amount.String() != "2500000000000000000" {
+			t.Errorf("unexpected transfer: %+v", transfers[0])
+		}
+
+		// CELO transfers are traced internal transfers
+		celoTransfers, _, _ := db.GetAccounts().AccountActivitySeq.FindTransfers("CELO", "", store.AddressHistoryQuery{})
+		if len(celoTransfers) == 0 {
+			t.Errorf("CELO transfers not found")
+		}
+		for _, transfer := range celoTransfers {
+			if transfer.Kind != OperationTypeInternalTransferSent {
+				t.Errorf("unexpected CELO transfer: %+v", transfer)
+			}
+		}
+	})
+
+	t.Run("creates aggregates", func(t *testing.T) {
+		missing, err := db.GetValidators().ValidatorAgg.FindByAddress(fixturesMissingValidator)
+		if err != nil {
+			t.Fatalf("validator aggregate not found: %v", err)
+		}
+		if missing.RecentName != "Validator Two" || missing.StartedAtHeight != fixturesFirstHeight || missing.RecentAtHeight != fixturesLastHeight {
+			t.Errorf("unexpected validator aggregate: %+v", missing)
+		}
+		if missing.AccumulatedUptime != 0 || missing.AccumulatedUptimeCount != 5 || missing.MissedInRowCount != 5 {
+			t.Errorf("unexpected uptime of validator aggregate: %+v", missing)
+		}
+
+		joined, err := db.GetValidators().ValidatorAgg.FindByAddress(fixturesJoinedValidator)
+		if err != nil {
+			t.Fatalf("validator aggregate not found: %v", err)
+		}
+		if joined.StartedAtHeight != 17280 || joined.AccumulatedUptime != 3 || joined.AccumulatedUptimeCount != 3 {
+			t.Errorf("unexpected validator aggregate: %+v", joined)
+		}
+		if missing.ProposedBlocksCount != 0 || joined.ProposedBlocksCount != 1 {
+			t.Errorf("unexpected proposed blocks counts, want 0 and 1; got %d and %d", missing.ProposedBlocksCount, joined.ProposedBlocksCount)
+		}
+
+		group, err := db.GetValidatorGroups().ValidatorGroupAgg.FindByAddress(fixturesGroup)
+		if err != nil {
+			t.Fatalf("validator group aggregate not found: %v", err)
+		}
+		if group.RecentName != "Group One" || group.RecentAtHeight != fixturesLastHeight {
+			t.Errorf("unexpected validator group aggregate: %+v", group)
+		}
+
+		proposal, err := db.GetGovernance().ProposalAgg.FindByProposalId(7)
+		if err != nil {
+			t.Fatalf("proposal aggregate not found: %v", err)
+		}
+		if proposal.StartedAtHeight != fixturesFirstHeight || proposal.RecentAtHeight != fixturesLastHeight {
+			t.Errorf("unexpected proposal aggregate heights: %+v", proposal)
+		}
+		if proposal.YesVotesTotal != 1 || proposal.VotesTotal != 1 {
+			t.Errorf("unexpected proposal aggregate votes: %+v", proposal)
+		}
+
+		voter, err := db.GetAccounts().VoterAgg.FindByAddressAndGroup(fixturesVoter, fixturesGroup)
+		if err != nil {
+			t.Fatalf("voter aggregate not found: %v", err)
+		}
+		if voter.StartedAtHeight != 17279 || voter.RecentAtHeight != 17280 {
+			t.Errorf("unexpected voter aggregate heights: %+v", voter)
+		}
+		// Activated votes grow by voter reward at the end of epoch
+		if voter.PendingVotes.String() != "0" || voter.ActiveVotes.String() != "541000000000000000000" {
+			t.Errorf("unexpected voter aggregate votes: %+v", voter)
+		}
+
+		voters, err := db.GetAccounts().VoterAgg.FindTopByGroup(fixturesGroup, 10)
+		if err != nil {
+			t.Fatalf("cannot find voters of group: %v", err)
+		}
+		if len(voters) != 2 || voters[0].Address != fixturesSender || voters[0].PendingVotes.String() != "1000000000000000000000" || voters[1].Address != fixturesVoter {
+			t.Errorf("unexpected voters of group: %+v", voters)
+		}
+	})
+
+	t.Run("creates system events", func(t *testing.T) {
+		tests := []struct {
+			actor  string
+			height int64
+			kind   model.SystemEventKind
+		}{
+			{actor: fixturesJoinedValidator, height: 17280, kind: model.SystemEventJoinedActiveSet},
+			{actor: fixturesGroup, height: 17280, kind: model.SystemEventGroupRewardChange3},
+			{actor: fixturesMissingValidator, height: 17280, kind: model.NewMissedNofMKind("short")},
+			{actor: fixturesMissingValidator, height: 17281, kind: model.SystemEventMissedNConsecutive},
+		}
+
+		for _, tt := range tests {
+			if _, err := db.GetCore().SystemEvents.FindUnique(tt.height, tt.actor, tt.kind); err != nil {
+				t.Errorf("system event %s not found [actor=%s] [height=%d]", tt.kind, tt.actor, tt.height)
+			}
+		}
+	})
+
+	t.Run("updates proposals from The Celo", func(t *testing.T) {
+		c, err := theceloclient.NewFixtureClient(fixturesProposalsPath)
+		if err != nil {
+			t.Fatalf("cannot create The Celo fixture client: %v", err)
+		}
+		if err := governance.NewUpdateProposalsUseCase(c, db.GetGovernance().ProposalAgg).Execute(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		proposal, _ := db.GetGovernance().ProposalAgg.FindByProposalId(7)
+		if proposal.DescriptionUrl == "" {
+			t.Errorf("proposal description url not updated: %+v", proposal)
+		}
+	})
+}
+
+func TestPipeline_Follow(t *testing.T) {
+	t.Run("indexes all heights in one report until context is done", func(t *testing.T) {
+		db := memory.New()
+		p := newFixturesPipeline(t, db)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		done := make(chan error, 1)
+		go func() {
+			done <- p.Follow(ctx, FollowConfig{BatchSize: 2, PollInterval: 10 * time.Millisecond})
+		}()
+
+		deadline := time.After(5 * time.Second)
+		for {
+			syncable, err := db.GetCore().Syncables.FindMostRecent()
+			if err == nil && syncable.Height == fixturesLastHeight && syncable.ProcessedAt != nil {
+				break
+			}
+			select {
+			case err := <-done:
+				t.Fatalf("follow mode stopped before indexing all heights: %v", err)
+			case <-deadline:
+				t.Fatalf("follow mode did not index all heights")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+
+		cancel()
+		if err := <-done; err != context.Canceled {
+			t.Errorf("unexpected error, want %v; got %v", context.Canceled, err)
+		}
+
+		report, err := db.GetCore().Reports.Last()
+		if err != nil {
+			t.Fatalf("report not found: %v", err)
+		}
+		if report.StartHeight != fixturesFirstHeight || report.EndHeight != fixturesLastHeight || report.CompletedAt == nil {
+			t.Errorf("unexpected report: %+v", report)
+		}
+		if report.SuccessCount == nil || *report.SuccessCount != fixturesLastHeight-fixturesFirstHeight+1 {
+			t.Errorf("unexpected report success count: %v", report.SuccessCount)
+		}
+	})
+}
+
+func TestPipeline_Run(t *testing.T) {
+	t.Run("returns payload without persisting it in dry mode", func(t *testing.T) {
+		db := memory.New()
+		p := newFixturesPipeline(t, db)
+
+		payload, err := p.Run(context.Background(), RunConfig{Height: 17280, Dry: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if payload.RawBlock == nil || payload.NewBlockSequence == nil || len(payload.ValidatorSequences) != 3 {
+			t.Errorf("unexpected payload: %+v", payload)
+		}
+		if len(payload.AccountActivitySequences) == 0 {
+			t.Errorf("account activity sequences not mapped")
+		}
+
+		if _, err := db.GetCore().Syncables.FindByHeight(17280); err == nil {
+			t.Errorf("syncable persisted in dry mode")
+		}
+		if _, err := db.GetBlocks().BlockSeq.FindByHeight(17280); err == nil {
+			t.Errorf("block sequence persisted in dry mode")
+		}
+	})
+
+	t.Run("fails for unknown target", func(t *testing.T) {
+		p := newFixturesPipeline(t, memory.New())
+
+		_, err := p.Run(context.Background(), RunConfig{Height: 17280, DesiredTargetIDs: []int64{999}, Dry: true})
+		if errors.Cause(err) != ErrTargetNotFound {
+			t.Errorf("unexpected error, want %v; got %v", ErrTargetNotFound, err)
+		}
+	})
+}
+
+const (
+	fixturesGroup            = "0x9C4e1A2B3c5d6E7F8a9B0C1D2E3f4a5b6C7d8e9f"
+	fixturesMissingValidator = "0x4bC4a0f2D6f8C0E3a1e0d1f5A3b8D9C6E2F7A4B1"
+	fixturesJoinedValidator  = "0x7E2a3b5c8d1f4e6a9b0C2d5E8f1a3b6c9d2e5f80"
+	fixturesSender           = "0x5f3a2b1C0D9e8f7A6b5c4d3E2f1A0B9C8D7E6f5a"
+	fixturesVoter            = "0x3C2b1a0f9E8d7C6b5A4f3E2d1C0B9a8F7e6d5C4B"
+)
+
+// runFixturesPipeline indexes all heights of fixtures
+func runFixturesPipeline(t *testing.T, db *memory.Store) {
+	p := newFixturesPipeline(t, db)
+
+	if err := p.Start(context.Background(), IndexConfig{BatchSize: 100}); err != nil {
+		t.Fatalf("pipeline failed: %v", err)
+	}
+
+	// Errors of heights are not returned by pipeline, they are recorded in report
+	report, err := db.GetCore().Reports.Last()
+	if err != nil {
+		t.Fatalf("report not found: %v", err)
+	}
+	if report.ErrorMsg != nil {
+		t.Fatalf("pipeline failed: %s", *report.ErrorMsg)
+	}
+}
+
+func newFixturesPipeline(t *testing.T, db *memory.Store) *indexingPipeline {
+	cfg := &config.Config{
+		IndexerConfigFile:           "../indexer_config.json",
+		FirstBlockHeight:            fixturesFirstHeight,
+		MaxValidatorSequences:       1000,
+		MissedForMaxThreshold:       50,
+		MissedInRowThreshold:        4,
+		MissedBlocksRules:           config.MissedBlocksRules{{Name: "short", Missed: 3, Window: 5}},
+		GroupRewardChangeThresholds: []float64{0.1, 1, 10},
+		WebhookMaxAttempts:          1,
+		WebhookInitialBackoff:       "1s",
+		WebhookMaxBackoff:           "1s",
+		WebhookTimeout:              "1s",
+	}
+
+	client, err := figmentclient.NewFixtureClient(fixturesNodeDir)
+	if err != nil {
+		t.Fatalf("cannot create fixture client: %v", err)
+	}
+
+	p, err := NewPipeline(
+		cfg,
+		client,
+		db.GetCore().Syncables,
+		db.GetCore().Database,
+		db.GetCore().Reports,
+		db.GetBlocks().BlockSeq,
+		db.GetValidators().ValidatorSeq,
+		db.GetAccounts().AccountActivitySeq,
+		db.GetValidatorGroups().ValidatorGroupSeq,
+		db.GetValidators().ValidatorAgg,
+		db.GetValidatorGroups().ValidatorGroupAgg,
+		db.GetGovernance().ProposalAgg,
+		db.GetCore().SystemEvents,
+		db.GetGovernance().GovernanceActivitySeq,
+		db.GetTransactions().TransactionSeq,
+		db.GetEpochs().EpochSummary,
+		db.GetAccounts().VoterRewardSeq,
+		db.GetAccounts().VoterAgg,
+		db.GetEpochs().ElectedValidatorSeq,
+		db.GetWebhooks().WebhookSubscriptions,
+		db.GetWebhooks().WebhookDeliveries,
+	)
+	if err != nil {
+		t.Fatalf("cannot create pipeline: %v", err)
+	}
+	return p
+}
+
+// recordFixtures overwrites fixtures with responses of nodes given in flags.
+// Assertions of end-to-end tests need to be updated after fixtures are recorded again
+func recordFixtures(t *testing.T) {
+	ctx := context.Background()
+
+	if *recordTheCeloUrl != "" {
+		c, err := theceloclient.New(*recordTheCeloUrl)
+		if err != nil {
+			t.Fatalf("cannot create The Celo client: %v", err)
+		}
+		if _, err := theceloclient.NewFixtureRecorder(c, fixturesProposalsPath).GetAllProposals(); err != nil {
+			t.Fatalf("cannot record proposals: %v", err)
+		}
+	}
+
+	if *recordNodeUrl == "" {
+		return
+	}
+
+	nodeClient, err := figmentclient.New(*recordNodeUrl, figmentclient.NodePoolConfig{})
+	if err != nil {
+		t.Fatalf("cannot create node client: %v", err)
+	}
+	c, err := figmentclient.NewFixtureRecorder(nodeClient, fixturesNodeDir)
+	if err != nil {
+		t.Fatalf("cannot create fixture recorder: %v", err)
+	}
+
+	if _, err := c.GetChainParams(ctx); err != nil && err != figmentclient.ErrContractNotDeployed {
+		t.Fatalf("cannot record chain params: %v", err)
+	}
+	for h := fixturesFirstHeight; h <= fixturesLastHeight; h++ {
+		if err := recordHeight(ctx, c, h); err != nil {
+			t.Fatalf("cannot record height %d: %v", h, err)
+		}
+	}
+}
+
+// recordHeight requests all responses needed to index given height
+func recordHeight(ctx context.Context, c figmentclient.Client, h int64) error {
+	var addresses []string
+
+	if _, err := c.GetMetaByHeight(ctx, h); err != nil && err != figmentclient.ErrContractNotDeployed {
+		return err
+	}
+	if _, err := c.GetBlockByHeight(ctx, h); err != nil && err != figmentclient.ErrContractNotDeployed {
+		return err
+	}
+	if _, err := c.GetTransactionsByHeight(ctx, h); err != nil && err != figmentclient.ErrContractNotDeployed {
+		return err
+	}
+
+	validators, err := c.GetValidatorsByHeight(ctx, h)
+	if err != nil && err != figmentclient.ErrContractNotDeployed {
+		return err
+	}
+	for _, validator := range validators {
+		addresses = append(addresses, validator.Address)
+	}
+
+	validatorGroups, err := c.GetValidatorGroupsByHeight(ctx, h)
+	if err != nil && err != figmentclient.ErrContractNotDeployed {
+		return err
+	}
+	for _, validatorGroup := range validatorGroups {
+		addresses = append(addresses, validatorGroup.Address)
+	}
+
+	for _, address := range addresses {
+		if _, err := c.GetIdentityByHeight(ctx, address, h); err != nil && err != figmentclient.ErrContractNotDeployed {
+			return err
+		}
+	}
+	return nil
+}
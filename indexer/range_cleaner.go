@@ -0,0 +1,90 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrReindexTargetNotSupported = errors.New("aggregates cannot be reindexed for height range")
+
+	// aggregatePersistorTaskNames are persistors of data accumulated over all heights which cannot be rebuilt for height range only
+	aggregatePersistorTaskNames = []pipeline.TaskName{
+		ValidatorAggPersistorTaskName,
+		ValidatorGroupAggPersistorTaskName,
+		ProposalAggPersistorTaskName,
+		VoterAggPersistorTaskName,
+	}
+)
+
+// rangeCleaner deletes data of reindexed targets within height range
+type rangeCleaner struct {
+	blockSeqDb              store.BlockSeq
+	validatorSeqDb          store.ValidatorSeq
+	validatorGroupSeqDb     store.ValidatorGroupSeq
+	accountActivitySeqDb    store.AccountActivitySeq
+	governanceActivitySeqDb store.GovernanceActivitySeq
+	transactionSeqDb        store.TransactionSeq
+	epochSummaryDb          store.EpochSummary
+	voterRewardSeqDb        store.VoterRewardSeq
+	electedValidatorSeqDb   store.ElectedValidatorSeq
+	systemEventDb           store.SystemEvents
+}
+
+// clean deletes rows persisted by whitelisted persistor tasks within given height range
+func (c *rangeCleaner) clean(taskWhitelist []pipeline.TaskName, startHeight int64, endHeight int64) error {
+	for _, taskName := range taskWhitelist {
+		for _, aggregateTaskName := range aggregatePersistorTaskNames {
+			if taskName == aggregateTaskName {
+				return errors.Wrap(ErrReindexTargetNotSupported, string(taskName))
+			}
+		}
+	}
+
+	for _, taskName := range taskWhitelist {
+		deleteFunc := c.getDeleteFunc(taskName)
+		if deleteFunc == nil {
+			continue
+		}
+
+		count, err := deleteFunc(startHeight, endHeight)
+		if err != nil {
+			return err
+		}
+
+		logger.Info(fmt.Sprintf("deleted data for reindex [task=%s] [start=%d] [end=%d] [count=%d]", taskName, startHeight, endHeight, *count))
+	}
+	return nil
+}
+
+// getDeleteFunc returns function deleting data persisted by given task. It returns nil when task does not persist any sequences
+func (c *rangeCleaner) getDeleteFunc(taskName pipeline.TaskName) func(int64, int64) (*int64, error) {
+	switch taskName {
+	case BlockSeqPersistorTaskName:
+		return c.blockSeqDb.DeleteForHeightRange
+	case ValidatorSeqPersistorTaskName:
+		return c.validatorSeqDb.DeleteForHeightRange
+	case ValidatorGroupSeqPersistorTaskName:
+		return c.validatorGroupSeqDb.DeleteForHeightRange
+	case AccountActivitySeqPersistorTaskName:
+		return c.accountActivitySeqDb.DeleteForHeightRange
+	case GovernanceActivitySeqPersistorTaskName:
+		return c.governanceActivitySeqDb.DeleteForHeightRange
+	case TransactionSeqPersistorTaskName:
+		return c.transactionSeqDb.DeleteForHeightRange
+	case EpochSummaryPersistorTaskName:
+		return c.epochSummaryDb.DeleteForHeightRange
+	case VoterRewardSeqPersistorTaskName:
+		return c.voterRewardSeqDb.DeleteForHeightRange
+	case ElectedValidatorSeqPersistorTaskName:
+		return c.electedValidatorSeqDb.DeleteForHeightRange
+	case TaskNameSystemEventPersistor:
+		return c.systemEventDb.DeleteForHeightRange
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"testing"
+
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+)
+
+func TestRangeCleaner_clean(t *testing.T) {
+	const startHeight int64 = 10
+	const endHeight int64 = 20
+	t.Parallel()
+
+	tests := []struct {
+		description        string
+		taskWhitelist      []pipeline.TaskName
+		expectBlockSeq     bool
+		expectValidatorSeq bool
+		expectSystemEvent  bool
+		expectErr          error
+	}{
+		{
+			description:    "deletes data of whitelisted persistors only",
+			taskWhitelist:  []pipeline.TaskName{MainSyncerTaskName, SyncerPersistorTaskName, TaskNameBlockFetcher, BlockSeqPersistorTaskName},
+			expectBlockSeq: true,
+		},
+		{
+			description:        "deletes data of many persistors",
+			taskWhitelist:      []pipeline.TaskName{ValidatorSeqPersistorTaskName, TaskNameSystemEventPersistor},
+			expectValidatorSeq: true,
+			expectSystemEvent:  true,
+		},
+		{
+			description:   "does not delete anything when aggregate persistor is whitelisted",
+			taskWhitelist: []pipeline.TaskName{BlockSeqPersistorTaskName, ValidatorAggPersistorTaskName},
+			expectErr:     ErrReindexTargetNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var count int64 = 1
+
+			blockSeqDbMock := mock.NewMockBlockSeq(ctrl)
+			validatorSeqDbMock := mock.NewMockValidatorSeq(ctrl)
+			systemEventDbMock := mock.NewMockSystemEvents(ctrl)
+
+			if tt.expectBlockSeq {
+				blockSeqDbMock.EXPECT().DeleteForHeightRange(startHeight, endHeight).Return(&count, nil).Times(1)
+			}
+			if tt.expectValidatorSeq {
+				validatorSeqDbMock.EXPECT().DeleteForHeightRange(startHeight, endHeight).Return(&count, nil).Times(1)
+			}
+			if tt.expectSystemEvent {
+				systemEventDbMock.EXPECT().DeleteForHeightRange(startHeight, endHeight).Return(&count, nil).Times(1)
+			}
+
+			cleaner := &rangeCleaner{
+				blockSeqDb:          blockSeqDbMock,
+				validatorSeqDb:      validatorSeqDbMock,
+				validatorGroupSeqDb: mock.NewMockValidatorGroupSeq(ctrl),
+				systemEventDb:       systemEventDbMock,
+			}
+
+			err := cleaner.clean(tt.taskWhitelist, startHeight, endHeight)
+			if errors.Cause(err) != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxReorgDepth is the maximum number of heights searched when looking for fork point
+	maxReorgDepth = 100
+)
+
+var (
+	ErrForkPointNotFound    = errors.New("fork point not found")
+	ErrForkHeightNotIndexed = errors.New("height within reorg depth is not indexed")
+)
+
+// reorgHandler finds fork point and rolls back indexed data above it
+type reorgHandler struct {
+	client figmentclient.Client
+
+	syncableDb              store.Syncables
+	blockSeqDb              store.BlockSeq
+	validatorSeqDb          store.ValidatorSeq
+	validatorGroupSeqDb     store.ValidatorGroupSeq
+	accountActivitySeqDb    store.AccountActivitySeq
+	governanceActivitySeqDb store.GovernanceActivitySeq
+	transactionSeqDb        store.TransactionSeq
+	epochSummaryDb          store.EpochSummary
+	voterRewardSeqDb        store.VoterRewardSeq
+	electedValidatorSeqDb   store.ElectedValidatorSeq
+	systemEventDb           store.SystemEvents
+	validatorAggDb          store.ValidatorAgg
+	validatorGroupAggDb     store.ValidatorGroupAgg
+	proposalAggDb           store.ProposalAgg
+	voterAggDb              store.VoterAgg
+}
+
+// findForkHeight returns most recent height below given height which indexed hash matches the one on chain
+func (h *reorgHandler) findForkHeight(ctx context.Context, height int64) (int64, *types.Time, error) {
+	for forkHeight := height - 1; forkHeight > 0 && forkHeight >= height-maxReorgDepth; forkHeight-- {
+		meta, err := h.client.GetMetaByHeight(ctx, forkHeight)
+		if err != nil && err != figmentclient.ErrContractNotDeployed {
+			return 0, nil, err
+		}
+
+		syncable, err := h.syncableDb.FindByHeight(forkHeight)
+		if err != nil {
+			if err == psql.ErrNotFound {
+				// Height left out by repair or reindex cannot be compared, so it cannot be taken as fork point
+				logger.Info(fmt.Sprintf("height within reorg depth is not indexed [height=%d]", forkHeight))
+				return 0, nil, ErrForkHeightNotIndexed
+			}
+			return 0, nil, err
+		}
+
+		// Syncables indexed before hashes were persisted cannot be verified
+		if syncable.Hash == "" || syncable.Hash == meta.Hash {
+			return forkHeight, syncable.Time, nil
+		}
+
+		logger.Info(fmt.Sprintf("indexed hash does not match chain [height=%d] [indexed_hash=%s] [hash=%s]", forkHeight, syncable.Hash, meta.Hash))
+	}
+	return 0, nil, ErrForkPointNotFound
+}
+
+// rollback removes all sequences, system events and aggregate contributions above given height.
+// Every step can be run again, so rollback which was interrupted is completed when it is retried
+func (h *reorgHandler) rollback(forkHeight int64, forkTime types.Time) error {
+	logger.Info(fmt.Sprintf("rolling back indexed data [fork_height=%d]", forkHeight))
+
+	// Aggregates have to be rolled back first since they depend on sequences above fork point.
+	// Each of them is rolled back in single transaction and skipped when its recent height is already reset
+	rollbackFuncs := []func(int64, types.Time) error{
+		h.validatorAggDb.RollbackToHeight,
+		h.validatorGroupAggDb.RollbackToHeight,
+		h.proposalAggDb.RollbackToHeight,
+		h.voterAggDb.RollbackToHeight,
+	}
+
+	for _, rollbackFunc := range rollbackFuncs {
+		if err := rollbackFunc(forkHeight, forkTime); err != nil {
+			return err
+		}
+	}
+
+	deleteFuncs := []struct {
+		table      string
+		deleteFunc func(int64) (*int64, error)
+	}{
+		{"validator_aggregates", h.validatorAggDb.DeleteStartedAboveHeight},
+		{"validator_group_aggregates", h.validatorGroupAggDb.DeleteStartedAboveHeight},
+		{"proposal_aggregates", h.proposalAggDb.DeleteStartedAboveHeight},
+		{"voter_aggregates", h.voterAggDb.DeleteStartedAboveHeight},
+		{"block_sequences", h.blockSeqDb.DeleteAboveHeight},
+		{"validator_sequences", h.validatorSeqDb.DeleteAboveHeight},
+		{"validator_group_sequences", h.validatorGroupSeqDb.DeleteAboveHeight},
+		{"account_activity_sequences", h.accountActivitySeqDb.DeleteAboveHeight},
+		{"governance_activity_sequences", h.governanceActivitySeqDb.DeleteAboveHeight},
+		{"transaction_sequences", h.transactionSeqDb.DeleteAboveHeight},
+		{"epoch_summaries", h.epochSummaryDb.DeleteAboveHeight},
+		{"voter_reward_sequences", h.voterRewardSeqDb.DeleteAboveHeight},
+		{"elected_validator_sequences", h.electedValidatorSeqDb.DeleteAboveHeight},
+		{"system_events", h.systemEventDb.DeleteAboveHeight},
+	}
+
+	for _, f := range deleteFuncs {
+		count, err := f.deleteFunc(forkHeight)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("rolled back records [table=%s] [count=%d]", f.table, *count))
+	}
+
+	// Syncables are removed last so interrupted rollback is detected again on next run
+	count, err := h.syncableDb.DeleteAboveHeight(forkHeight)
+	if err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("rolled back records [table=syncables] [count=%d]", *count))
+
+	return nil
+}
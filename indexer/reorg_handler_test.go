@@ -0,0 +1,265 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	figmentClientMock "github.com/figment-networks/celo-indexer/mock/client"
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestReorgHandler_findForkHeight(t *testing.T) {
+	const reorgHeight int64 = 20
+	t.Parallel()
+
+	syncTime := types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		description   string
+		chainHashes   map[int64]string
+		indexedHashes map[int64]string
+		expectHeight  int64
+		expectErr     error
+	}{
+		{
+			description:   "returns previous height when its hash matches",
+			chainHashes:   map[int64]string{19: "0x19"},
+			indexedHashes: map[int64]string{19: "0x19"},
+			expectHeight:  19,
+		},
+		{
+			description:   "walks back until hashes match",
+			chainHashes:   map[int64]string{19: "0x19b", 18: "0x18b", 17: "0x17"},
+			indexedHashes: map[int64]string{19: "0x19a", 18: "0x18a", 17: "0x17"},
+			expectHeight:  17,
+		},
+		{
+			description:   "stops at height without hash",
+			chainHashes:   map[int64]string{19: "0x19b", 18: "0x18"},
+			indexedHashes: map[int64]string{19: "0x19a", 18: ""},
+			expectHeight:  18,
+		},
+		{
+			description:   "returns error when height is not indexed",
+			chainHashes:   map[int64]string{19: "0x19b", 18: "0x18"},
+			indexedHashes: map[int64]string{19: "0x19a"},
+			expectErr:     ErrForkHeightNotIndexed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			clientMock := figmentClientMock.NewMockClient(ctrl)
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+
+			for height, hash := range tt.chainHashes {
+				clientMock.EXPECT().GetMetaByHeight(ctx, height).Return(&figmentclient.HeightMeta{
+					Height: height,
+					Time:   uint64(syncTime.Time.Unix()),
+					Hash:   hash,
+				}, nil).Times(1)
+
+				indexedHash, ok := tt.indexedHashes[height]
+				if !ok {
+					syncableDbMock.EXPECT().FindByHeight(height).Return(nil, psql.ErrNotFound).Times(1)
+					continue
+				}
+				syncableDbMock.EXPECT().FindByHeight(height).Return(&model.Syncable{
+					Height: height,
+					Time:   syncTime,
+					Hash:   indexedHash,
+				}, nil).Times(1)
+			}
+
+			handler := &reorgHandler{
+				client:     clientMock,
+				syncableDb: syncableDbMock,
+			}
+
+			forkHeight, forkTime, err := handler.findForkHeight(ctx, reorgHeight)
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+				return
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if forkHeight != tt.expectHeight {
+				t.Errorf("unexpected fork height, want %v; got %v", tt.expectHeight, forkHeight)
+			}
+
+			if !forkTime.Equal(*syncTime) {
+				t.Errorf("unexpected fork time, want %v; got %v", syncTime, forkTime)
+			}
+		})
+	}
+}
+
+func TestReorgHandler_rollback(t *testing.T) {
+	const forkHeight int64 = 20
+	t.Parallel()
+
+	forkTime := *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+	errTest := errors.New("test err")
+
+	tests := []struct {
+		description string
+		interruptAt string
+	}{
+		{"rolls back all data above fork height", ""},
+		{"completes rollback interrupted while rolling back aggregates", "voter_aggregates_rollback"},
+		{"completes rollback interrupted while removing sequences", "system_events"},
+		{"completes rollback interrupted while removing syncables", "syncables"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorAggDbMock := mock.NewMockValidatorAgg(ctrl)
+			validatorGroupAggDbMock := mock.NewMockValidatorGroupAgg(ctrl)
+			proposalAggDbMock := mock.NewMockProposalAgg(ctrl)
+			voterAggDbMock := mock.NewMockVoterAgg(ctrl)
+			blockSeqDbMock := mock.NewMockBlockSeq(ctrl)
+			validatorSeqDbMock := mock.NewMockValidatorSeq(ctrl)
+			validatorGroupSeqDbMock := mock.NewMockValidatorGroupSeq(ctrl)
+			accountActivitySeqDbMock := mock.NewMockAccountActivitySeq(ctrl)
+			governanceActivitySeqDbMock := mock.NewMockGovernanceActivitySeq(ctrl)
+			transactionSeqDbMock := mock.NewMockTransactionSeq(ctrl)
+			epochSummaryDbMock := mock.NewMockEpochSummary(ctrl)
+			voterRewardSeqDbMock := mock.NewMockVoterRewardSeq(ctrl)
+			electedValidatorSeqDbMock := mock.NewMockElectedValidatorSeq(ctrl)
+			systemEventDbMock := mock.NewMockSystemEvents(ctrl)
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+
+			handler := &reorgHandler{
+				syncableDb:              syncableDbMock,
+				blockSeqDb:              blockSeqDbMock,
+				validatorSeqDb:          validatorSeqDbMock,
+				validatorGroupSeqDb:     validatorGroupSeqDbMock,
+				accountActivitySeqDb:    accountActivitySeqDbMock,
+				governanceActivitySeqDb: governanceActivitySeqDbMock,
+				transactionSeqDb:        transactionSeqDbMock,
+				epochSummaryDb:          epochSummaryDbMock,
+				voterRewardSeqDb:        voterRewardSeqDbMock,
+				electedValidatorSeqDb:   electedValidatorSeqDbMock,
+				systemEventDb:           systemEventDbMock,
+				validatorAggDb:          validatorAggDbMock,
+				validatorGroupAggDb:     validatorGroupAggDbMock,
+				proposalAggDb:           proposalAggDbMock,
+				voterAggDb:              voterAggDbMock,
+			}
+
+			count := int64(1)
+
+			// Steps in order in which they have to be run, so sequences are never removed before aggregates are rolled back
+			steps := []struct {
+				name   string
+				expect func(err error) *gomock.Call
+			}{
+				{"validator_aggregates_rollback", func(err error) *gomock.Call {
+					return validatorAggDbMock.EXPECT().RollbackToHeight(forkHeight, forkTime).Return(err)
+				}},
+				{"validator_group_aggregates_rollback", func(err error) *gomock.Call {
+					return validatorGroupAggDbMock.EXPECT().RollbackToHeight(forkHeight, forkTime).Return(err)
+				}},
+				{"proposal_aggregates_rollback", func(err error) *gomock.Call {
+					return proposalAggDbMock.EXPECT().RollbackToHeight(forkHeight, forkTime).Return(err)
+				}},
+				{"voter_aggregates_rollback", func(err error) *gomock.Call {
+					return voterAggDbMock.EXPECT().RollbackToHeight(forkHeight, forkTime).Return(err)
+				}},
+				{"validator_aggregates", func(err error) *gomock.Call {
+					return validatorAggDbMock.EXPECT().DeleteStartedAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"validator_group_aggregates", func(err error) *gomock.Call {
+					return validatorGroupAggDbMock.EXPECT().DeleteStartedAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"proposal_aggregates", func(err error) *gomock.Call {
+					return proposalAggDbMock.EXPECT().DeleteStartedAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"voter_aggregates", func(err error) *gomock.Call {
+					return voterAggDbMock.EXPECT().DeleteStartedAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"block_sequences", func(err error) *gomock.Call {
+					return blockSeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"validator_sequences", func(err error) *gomock.Call {
+					return validatorSeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"validator_group_sequences", func(err error) *gomock.Call {
+					return validatorGroupSeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"account_activity_sequences", func(err error) *gomock.Call {
+					return accountActivitySeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"governance_activity_sequences", func(err error) *gomock.Call {
+					return governanceActivitySeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"transaction_sequences", func(err error) *gomock.Call {
+					return transactionSeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"epoch_summaries", func(err error) *gomock.Call {
+					return epochSummaryDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"voter_reward_sequences", func(err error) *gomock.Call {
+					return voterRewardSeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"elected_validator_sequences", func(err error) *gomock.Call {
+					return electedValidatorSeqDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"system_events", func(err error) *gomock.Call {
+					return systemEventDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+				{"syncables", func(err error) *gomock.Call {
+					return syncableDbMock.EXPECT().DeleteAboveHeight(forkHeight).Return(&count, err)
+				}},
+			}
+
+			var calls []*gomock.Call
+			if tt.interruptAt != "" {
+				// Interrupted run stops at failing step, remaining steps are not run
+				for _, step := range steps {
+					if step.name == tt.interruptAt {
+						calls = append(calls, step.expect(errTest).Times(1))
+						break
+					}
+					calls = append(calls, step.expect(nil).Times(1))
+				}
+			}
+			// Retried run goes through all steps again
+			for _, step := range steps {
+				calls = append(calls, step.expect(nil).Times(1))
+			}
+			gomock.InOrder(calls...)
+
+			if tt.interruptAt != "" {
+				if err := handler.rollback(forkHeight, forkTime); err != errTest {
+					t.Errorf("unexpected error of interrupted rollback, want %v; got %v", errTest, err)
+				}
+			}
+
+			if err := handler.rollback(forkHeight, forkTime); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/figment-networks/celo-indexer/store/psql"
 
 	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/utils/logger"
 	"github.com/pkg/errors"
 )
 
@@ -60,5 +61,7 @@ func (o *reportCreator) create() error {
 func (o *reportCreator) complete(totalCount int64, successCount int64, err error) error {
 	o.report.Complete(successCount, totalCount-successCount, err)
 
+	logger.Info(fmt.Sprintf("report completed [kind=%s] [success=%d] [total=%d] [duration=%s] [throughput=%.2f heights/s]", o.kind, successCount, totalCount, o.report.Duration, o.report.Throughput))
+
 	return o.reportDb.Save(o.report)
 }
@@ -168,6 +168,31 @@ func TestReportCreator_complete(t *testing.T) {
 			t.Errorf("complete() should return error %v", testErr)
 		}
 	})
+	t.Run("reports throughput of successfully processed heights", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reportStoreMock := mock.NewMockReports(ctrl)
+
+		reportStoreMock.EXPECT().Save(gomock.Any()).Return(nil).Times(1)
+
+		report := getTestReport(model.ReportKindIndex)
+		report.CreatedAt = *types.NewTimeFromTime(time.Now().Add(-10 * time.Second))
+
+		creator := reportCreator{
+			report:   report,
+			reportDb: reportStoreMock,
+		}
+
+		if err := creator.complete(20, 10, nil); err != nil {
+			t.Errorf("complete() should not return error, got: %v", err)
+			return
+		}
+
+		if report.Throughput <= 0 || report.Throughput > 1 {
+			t.Errorf("unexpected throughput, want about 1 height/s; got %v", report.Throughput)
+		}
+	})
 }
 
 func getTestReport(kind model.ReportKind) *model.Report {
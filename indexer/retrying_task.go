@@ -0,0 +1,137 @@
+package indexer
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"time"
+
+	base "github.com/figment-networks/celo-indexer/client"
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/metrics"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ErrorKindChainReorganization is a kind of errors returned when indexed chain was reorganized.
+	// Reorganization is handled by rewinding indexed heights, so it is never retried
+	ErrorKindChainReorganization base.ErrorKind = "chain_reorganization"
+)
+
+// retryPolicy describes how failed tasks of one stage are retried
+type retryPolicy struct {
+	maxAttempts    int64
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	jitter         float64
+}
+
+// newRetryPolicy creates retry policy of given stage from config.
+// Durations are validated together with config, so parsing errors are ignored
+func newRetryPolicy(cfg *config.Config, stage pipeline.StageName) retryPolicy {
+	policy := cfg.GetRetryPolicy(string(stage))
+
+	initialBackoff, _ := time.ParseDuration(policy.InitialBackoff)
+	maxBackoff, _ := time.ParseDuration(policy.MaxBackoff)
+
+	return retryPolicy{
+		maxAttempts:    policy.MaxAttempts,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		jitter:         *policy.Jitter,
+	}
+}
+
+// backoff returns delay before retrying given failed attempt. It doubles with every attempt up to max backoff
+// and is randomized by jitter, so tasks which failed together are not retried at the same time
+func (p retryPolicy) backoff(attempt int64, rnd func() float64) time.Duration {
+	delay := p.initialBackoff
+	for i := int64(1); i < attempt && delay < p.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.maxBackoff {
+		delay = p.maxBackoff
+	}
+	return delay + time.Duration(float64(delay)*p.jitter*(2*rnd()-1))
+}
+
+// newRetryingTask wraps task so it is retried with backoff when it fails with retryable error
+func newRetryingTask(task pipeline.Task, policy retryPolicy) pipeline.Task {
+	return &retryingTask{
+		task:   task,
+		policy: policy,
+		rnd:    rand.Float64,
+	}
+}
+
+type retryingTask struct {
+	task   pipeline.Task
+	policy retryPolicy
+	rnd    func() float64
+}
+
+func (t *retryingTask) GetName() string {
+	return t.task.GetName()
+}
+
+func (t *retryingTask) Run(ctx context.Context, p pipeline.Payload) error {
+	for attempt := int64(1); ; attempt++ {
+		err := t.task.Run(ctx, p)
+		if err == nil {
+			return nil
+		}
+
+		kind := errorKindOf(err)
+		if !kind.Retryable() || attempt >= t.policy.maxAttempts || ctx.Err() != nil {
+			metrics.PipelineTaskFailures.WithLabels(t.GetName(), string(kind)).Inc()
+			return err
+		}
+		metrics.PipelineTaskRetries.WithLabels(t.GetName(), string(kind)).Inc()
+
+		delay := t.policy.backoff(attempt, t.rnd)
+		logger.Info(fmt.Sprintf("retrying indexer task [task=%s] [attempt=%d] [kind=%s] [delay=%s] [err=%v]", t.GetName(), attempt, kind, delay, err))
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// errorKindOf classifies errors returned by indexer tasks
+func errorKindOf(err error) base.ErrorKind {
+	var reorgErr *ChainReorganizationError
+	if errors.As(err, &reorgErr) {
+		return ErrorKindChainReorganization
+	}
+
+	switch errors.Cause(err) {
+	case ErrBlockSequenceNotValid, ErrValidatorSequenceNotValid, ErrValidatorGroupSequenceNotValid, ErrGroupRewardOutsideOfRange:
+		return base.ErrorKindDataInvalid
+	case driver.ErrBadConn:
+		return base.ErrorKindDatabase
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return base.ErrorKindDatabase
+	}
+
+	return figmentclient.ErrorKindOf(err)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	base "github.com/figment-networks/celo-indexer/client"
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/lib/pq"
+)
+
+type flakyTask struct {
+	errs []error
+	runs int
+}
+
+func (t *flakyTask) GetName() string {
+	return "FlakyTask"
+}
+
+func (t *flakyTask) Run(context.Context, pipeline.Payload) error {
+	t.runs++
+	if t.runs > len(t.errs) {
+		return nil
+	}
+	return t.errs[t.runs-1]
+}
+
+func TestRetryingTask_Run(t *testing.T) {
+	t.Parallel()
+
+	transientErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+
+	tests := []struct {
+		description string
+		errs        []error
+		maxAttempts int64
+		expectRuns  int
+		expectErr   bool
+	}{
+		{"succeeds without retries", nil, 3, 1, false},
+		{"retries transient errors", []error{transientErr, transientErr}, 3, 3, false},
+		{"fails after max attempts", []error{transientErr, transientErr, transientErr}, 3, 3, true},
+		{"does not retry invalid data", []error{ErrValidatorSequenceNotValid}, 3, 1, true},
+		{"does not retry missing contract", []error{figmentclient.ErrContractNotDeployed}, 3, 1, true},
+		{"does not retry chain reorganization", []error{&ChainReorganizationError{Height: 10}}, 3, 1, true},
+		{"does not retry when attempts are not configured", []error{transientErr}, 0, 1, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			task := &flakyTask{errs: tt.errs}
+			retrying := newRetryingTask(task, retryPolicy{maxAttempts: tt.maxAttempts, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond})
+
+			err := retrying.Run(context.Background(), nil)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if task.runs != tt.expectRuns {
+				t.Errorf("unexpected runs count, want %d; got %d", tt.expectRuns, task.runs)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	t.Parallel()
+
+	policy := retryPolicy{initialBackoff: time.Second, maxBackoff: 5 * time.Second, jitter: 0.5}
+
+	tests := []struct {
+		attempt int64
+		rnd     float64
+		expect  time.Duration
+	}{
+		{1, 0.5, time.Second},
+		{2, 0.5, 2 * time.Second},
+		{3, 0.5, 4 * time.Second},
+		{4, 0.5, 5 * time.Second},
+		{10, 0.5, 5 * time.Second},
+		{1, 0, 500 * time.Millisecond},
+		{1, 1, 1500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		rnd := func() float64 { return tt.rnd }
+		if got := policy.backoff(tt.attempt, rnd); got != tt.expect {
+			t.Errorf("unexpected backoff for attempt %d, want %v; got %v", tt.attempt, tt.expect, got)
+		}
+	}
+}
+
+func TestErrorKindOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		err         error
+		expect      base.ErrorKind
+	}{
+		{"network error", &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}, base.ErrorKindTransient},
+		{"timeout", context.DeadlineExceeded, base.ErrorKindTransient},
+		{"rate limit", errors.New("429 Too Many Requests"), base.ErrorKindTransient},
+		{"pruned state", errors.New("missing trie node 1a2b (path )"), base.ErrorKindStatePruned},
+		{"missing contract", figmentclient.ErrContractNotDeployed, base.ErrorKindContractNotDeployed},
+		{"abi decoding", errors.New("abi: cannot marshal in to go type"), base.ErrorKindDataInvalid},
+		{"invalid sequence", ErrBlockSequenceNotValid, base.ErrorKindDataInvalid},
+		{"database", &pq.Error{Message: "deadlock detected"}, base.ErrorKindDatabase},
+		{"chain reorganization", &ChainReorganizationError{Height: 10}, ErrorKindChainReorganization},
+		{"typed error", base.NewError(base.ErrorKindStatePruned, errors.New("test")), base.ErrorKindStatePruned},
+		{"unknown", errors.New("test"), base.ErrorKindUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := errorKindOf(tt.err); got != tt.expect {
+			t.Errorf("unexpected kind of %s, want %s; got %s", tt.description, tt.expect, got)
+		}
+	}
+}
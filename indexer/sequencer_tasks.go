@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/config"
 	"github.com/figment-networks/celo-indexer/store"
 	"github.com/figment-networks/celo-indexer/store/psql"
@@ -17,6 +18,10 @@ const (
 	ValidatorGroupSeqCreatorTaskName     = "ValidatorGroupSeqCreator"
 	AccountActivitySeqCreatorTaskName    = "AccountActivitySeqCreator"
 	GovernanceActivitySeqCreatorTaskName = "GovernanceActivitySeqCreator"
+	TransactionSeqCreatorTaskName        = "TransactionSeqCreator"
+	EpochSummaryCreatorTaskName          = "EpochSummaryCreator"
+	VoterRewardSeqCreatorTaskName        = "VoterRewardSeqCreator"
+	ElectedValidatorSeqCreatorTaskName   = "ElectedValidatorSeqCreator"
 )
 
 var (
@@ -25,6 +30,10 @@ var (
 	_ pipeline.Task = (*validatorGroupSeqCreatorTask)(nil)
 	_ pipeline.Task = (*accountActivitySeqCreatorTask)(nil)
 	_ pipeline.Task = (*governanceActivitySeqCreatorTask)(nil)
+	_ pipeline.Task = (*transactionSeqCreatorTask)(nil)
+	_ pipeline.Task = (*epochSummaryCreatorTask)(nil)
+	_ pipeline.Task = (*voterRewardSeqCreatorTask)(nil)
+	_ pipeline.Task = (*electedValidatorSeqCreatorTask)(nil)
 )
 
 // NewBlockSeqCreatorTask creates block sequences
@@ -89,7 +98,7 @@ func (t *validatorSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
 
-	mappedValidatorSeqs, err := ToValidatorSequence(payload.Syncable, payload.RawValidators)
+	mappedValidatorSeqs, err := ToValidatorSequence(payload.Syncable, payload.RawValidators, payload.RawBlock)
 	if err != nil {
 		return err
 	}
@@ -188,3 +197,131 @@ func (t *governanceActivitySeqCreatorTask) Run(ctx context.Context, p pipeline.P
 
 	return nil
 }
+
+// NewTransactionSeqCreatorTask creates transaction sequences
+func NewTransactionSeqCreatorTask() *transactionSeqCreatorTask {
+	return &transactionSeqCreatorTask{}
+}
+
+type transactionSeqCreatorTask struct{}
+
+func (t *transactionSeqCreatorTask) GetName() string {
+	return TransactionSeqCreatorTaskName
+}
+
+func (t *transactionSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	mappedTransactionSeqs, err := ToTransactionSequence(payload.Syncable, payload.RawTransactions)
+	if err != nil {
+		return err
+	}
+
+	payload.TransactionSequences = mappedTransactionSeqs
+
+	return nil
+}
+
+// NewEpochSummaryCreatorTask creates summary of epoch at its last height
+func NewEpochSummaryCreatorTask() *epochSummaryCreatorTask {
+	return &epochSummaryCreatorTask{}
+}
+
+type epochSummaryCreatorTask struct{}
+
+func (t *epochSummaryCreatorTask) GetName() string {
+	return EpochSummaryCreatorTaskName
+}
+
+func (t *epochSummaryCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	if payload.RawEpochSummary == nil || payload.Syncable.Epoch == nil {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	payload.EpochSummary = ToEpochSummary(payload.Syncable, payload.RawEpochSummary)
+
+	return nil
+}
+
+// NewVoterRewardSeqCreatorTask creates sequences of rewards given to voters at last height of epoch
+func NewVoterRewardSeqCreatorTask(c figmentclient.Client) *voterRewardSeqCreatorTask {
+	return &voterRewardSeqCreatorTask{
+		client: c,
+	}
+}
+
+type voterRewardSeqCreatorTask struct {
+	client figmentclient.Client
+}
+
+func (t *voterRewardSeqCreatorTask) GetName() string {
+	return VoterRewardSeqCreatorTaskName
+}
+
+func (t *voterRewardSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	// Voter rewards are distributed at last height of epoch only
+	if payload.HeightMeta.LastInEpoch == nil || !*payload.HeightMeta.LastInEpoch || payload.Syncable.Epoch == nil {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	// Voters are read from Election contract at current height, so voters which activated votes before first indexed height are included
+	accounts, err := t.client.GetVotersByHeight(ctx, payload.CurrentHeight)
+	if err != nil {
+		if err == figmentclient.ErrContractNotDeployed {
+			logger.Info(err.Error())
+			return nil
+		}
+		return err
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	rawVoterRewards, err := t.client.GetVoterRewardsByHeight(ctx, payload.CurrentHeight, accounts)
+	if err != nil {
+		if err == figmentclient.ErrContractNotDeployed {
+			logger.Info(err.Error())
+		} else {
+			return err
+		}
+	}
+
+	payload.VoterRewardSequences = ToVoterRewardSequence(payload.Syncable, rawVoterRewards)
+
+	return nil
+}
+
+// NewElectedValidatorSeqCreatorTask creates sequences of validators elected for epoch at its first height
+func NewElectedValidatorSeqCreatorTask() *electedValidatorSeqCreatorTask {
+	return &electedValidatorSeqCreatorTask{}
+}
+
+type electedValidatorSeqCreatorTask struct{}
+
+func (t *electedValidatorSeqCreatorTask) GetName() string {
+	return ElectedValidatorSeqCreatorTaskName
+}
+
+func (t *electedValidatorSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	if len(payload.RawElectedValidators) == 0 || payload.Syncable.Epoch == nil {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	payload.ElectedValidatorSequences = ToElectedValidatorSequence(payload.Syncable, payload.RawElectedValidators)
+
+	return nil
+}
@@ -2,10 +2,13 @@ package indexer
 
 import (
 	"context"
+	"errors"
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/config"
+	clientMock "github.com/figment-networks/celo-indexer/mock/client"
 	"github.com/figment-networks/celo-indexer/model"
 	"github.com/figment-networks/celo-indexer/types"
+	"github.com/golang/mock/gomock"
 	"math/big"
 	"reflect"
 	"testing"
@@ -28,6 +31,7 @@ func TestValidatorSeqCreator_Run(t *testing.T) {
 	tests := []struct {
 		description string
 		raw         []*figmentclient.Validator
+		rawBlock    *figmentclient.Block
 		expect      []model.ValidatorSeq
 		expectErr   bool
 	}{
@@ -45,6 +49,30 @@ func TestValidatorSeqCreator_Run(t *testing.T) {
 				},
 			},
 
+			expectErr: false,
+		},
+		{
+			description: "marks validator which signer is coinbase of block as proposer",
+			raw: []*figmentclient.Validator{
+				{Address: "validator1", Signer: "0xABC", Score: big.NewInt(100)},
+				{Address: "validator2", Signer: "0xDEF", Score: big.NewInt(100)},
+			},
+			rawBlock: &figmentclient.Block{Coinbase: "0xabc"},
+			expect: []model.ValidatorSeq{
+				{
+					Sequence: seq,
+					Address:  "validator1",
+					Score:    types.NewQuantityFromInt64(100),
+					Proposed: true,
+				},
+				{
+					Sequence: seq,
+					Address:  "validator2",
+					Score:    types.NewQuantityFromInt64(100),
+					Proposed: false,
+				},
+			},
+
 			expectErr: false,
 		},
 	}
@@ -62,6 +90,7 @@ func TestValidatorSeqCreator_Run(t *testing.T) {
 					Time:   &syncTime,
 				},
 				RawValidators: tt.raw,
+				RawBlock:      tt.rawBlock,
 			}
 
 			if err := task.Run(ctx, pl); err != nil && !tt.expectErr {
@@ -191,3 +220,185 @@ func TestValidatorGroupSeqCreator_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionSeqCreator_Run(t *testing.T) {
+	const syncHeight int64 = 20
+
+	syncTime := *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		description string
+		raw         []*figmentclient.Transaction
+		expect      []model.TransactionSeq
+	}{
+		{
+			description: "updates payload.TransactionSequences",
+			raw: []*figmentclient.Transaction{
+				{Hash: "tx1", Index: 1, Address: "from1", To: "to1", GasPrice: big.NewInt(500), Gas: 200, GasUsed: 100, FeeCurrency: "token1", GatewayFee: big.NewInt(10), Success: true},
+			},
+			expect: []model.TransactionSeq{
+				{
+					Sequence:    &model.Sequence{Height: syncHeight, Time: syncTime},
+					Hash:        "tx1",
+					Index:       1,
+					FromAddress: "from1",
+					ToAddress:   "to1",
+					Gas:         200,
+					GasPrice:    types.NewQuantityFromInt64(500),
+					GasUsed:     100,
+					FeeCurrency: "token1",
+					GatewayFee:  types.NewQuantityFromInt64(10),
+					Success:     true,
+					Operations:  types.Jsonb{RawMessage: []byte("null")},
+				},
+			},
+		},
+		{
+			description: "maps missing gateway fee to zero",
+			raw: []*figmentclient.Transaction{
+				{Hash: "tx1", GasPrice: big.NewInt(500)},
+			},
+			expect: []model.TransactionSeq{
+				{
+					Sequence:   &model.Sequence{Height: syncHeight, Time: syncTime},
+					Hash:       "tx1",
+					GasPrice:   types.NewQuantityFromInt64(500),
+					GatewayFee: types.NewQuantityFromInt64(0),
+					Operations: types.Jsonb{RawMessage: []byte("null")},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			task := NewTransactionSeqCreatorTask()
+
+			pl := &payload{
+				CurrentHeight: syncHeight,
+				Syncable: &model.Syncable{
+					Height: syncHeight,
+					Time:   &syncTime,
+				},
+				RawTransactions: tt.raw,
+			}
+
+			if err := task.Run(context.Background(), pl); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !reflect.DeepEqual(pl.TransactionSequences, tt.expect) {
+				t.Errorf("unexpected payload.TransactionSequences, got: %+v; want: %+v", pl.TransactionSequences, tt.expect)
+			}
+		})
+	}
+}
+
+func TestVoterRewardSeqCreator_Run(t *testing.T) {
+	const syncHeight int64 = 20
+	const epoch int64 = 1
+
+	syncTime := *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+	lastInEpoch := true
+	notLastInEpoch := false
+
+	tests := []struct {
+		description   string
+		lastInEpoch   *bool
+		voters        []string
+		votersErr     error
+		returnRewards []*figmentclient.VoterReward
+		returnErr     error
+		expect        []model.VoterRewardSeq
+		result        error
+	}{
+		{
+			description: "skips height which is not last in epoch",
+			lastInEpoch: &notLastInEpoch,
+		},
+		{
+			description: "skips height without voters",
+			lastInEpoch: &lastInEpoch,
+		},
+		{
+			description: "skips height when election contract is not deployed",
+			lastInEpoch: &lastInEpoch,
+			votersErr:   figmentclient.ErrContractNotDeployed,
+		},
+		{
+			description: "returns error if client errors getting voters",
+			lastInEpoch: &lastInEpoch,
+			votersErr:   errors.New("test error"),
+			result:      errors.New("test error"),
+		},
+		{
+			description: "returns error if client errors",
+			lastInEpoch: &lastInEpoch,
+			voters:      []string{"voter1"},
+			returnErr:   errors.New("test error"),
+			result:      errors.New("test error"),
+		},
+		{
+			description: "updates payload.VoterRewardSequences of voters",
+			lastInEpoch: &lastInEpoch,
+			voters:      []string{"voter1", "voter2"},
+			returnRewards: []*figmentclient.VoterReward{
+				{Account: "voter1", Group: "group1", Reward: big.NewInt(10), ActiveVotes: big.NewInt(110)},
+			},
+			expect: []model.VoterRewardSeq{
+				{
+					Sequence:    &model.Sequence{Height: syncHeight, Time: syncTime},
+					Epoch:       epoch,
+					Address:     "voter1",
+					Group:       "group1",
+					Amount:      types.NewQuantityFromInt64(10),
+					ActiveVotes: types.NewQuantityFromInt64(110),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			mockClient := clientMock.NewMockClient(ctrl)
+			task := NewVoterRewardSeqCreatorTask(mockClient)
+
+			e := epoch
+			pl := &payload{
+				CurrentHeight: syncHeight,
+				HeightMeta:    HeightMeta{LastInEpoch: tt.lastInEpoch},
+				Syncable: &model.Syncable{
+					Height: syncHeight,
+					Time:   &syncTime,
+					Epoch:  &e,
+				},
+			}
+
+			if *tt.lastInEpoch {
+				mockClient.EXPECT().GetVotersByHeight(ctx, syncHeight).Return(tt.voters, tt.votersErr).Times(1)
+			}
+			if tt.voters != nil {
+				mockClient.EXPECT().GetVoterRewardsByHeight(ctx, syncHeight, tt.voters).Return(tt.returnRewards, tt.returnErr).Times(1)
+			}
+
+			result := task.Run(ctx, pl)
+			if (result == nil) != (tt.result == nil) {
+				t.Errorf("want %v; got %v", tt.result, result)
+				return
+			}
+
+			if !reflect.DeepEqual(pl.VoterRewardSequences, tt.expect) {
+				t.Errorf("unexpected payload.VoterRewardSequences, got: %+v; want: %+v", pl.VoterRewardSequences, tt.expect)
+			}
+		})
+	}
+}
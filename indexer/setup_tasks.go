@@ -28,12 +28,15 @@ type heightMetaRetrieverTask struct {
 }
 
 type HeightMeta struct {
-	ChainId     uint64
-	Height      int64
-	Time        *types.Time
-	Epoch       *int64
-	EpochSize   *int64
-	LastInEpoch *bool
+	ChainId      uint64
+	Height       int64
+	Time         *types.Time
+	Hash         string
+	ParentHash   string
+	Epoch        *int64
+	EpochSize    *int64
+	LastInEpoch  *bool
+	FirstInEpoch *bool
 }
 
 func (t *heightMetaRetrieverTask) GetName() string {
@@ -77,11 +80,14 @@ func (t *heightMetaRetrieverTask) Run(ctx context.Context, p pipeline.Payload) e
 		// Contract dependent data
 		heightMeta.Epoch = meta.Epoch
 		heightMeta.LastInEpoch = meta.LastInEpoch
+		heightMeta.FirstInEpoch = meta.FirstInEpoch
 	}
 
 	// Get meta partial data
 	heightMeta.Height = meta.Height
 	heightMeta.Time = types.NewTimeFromSeconds(meta.Time)
+	heightMeta.Hash = meta.Hash
+	heightMeta.ParentHash = meta.ParentHash
 
 	payload.HeightMeta = heightMeta
 	return nil
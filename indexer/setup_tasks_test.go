@@ -34,6 +34,8 @@ func TestHeightMetaRetriever_Run(t *testing.T) {
 			returnMeta: &figmentclient.HeightMeta{
 				Height:      syncHeight,
 				Time:        uint64(syncTime.Time.UTC().Unix()),
+				Hash:        "0xhash",
+				ParentHash:  "0xparenthash",
 				Epoch:       nil,
 				LastInEpoch: nil,
 			},
@@ -41,6 +43,8 @@ func TestHeightMetaRetriever_Run(t *testing.T) {
 				ChainId:     chainId,
 				Height:      syncHeight,
 				Time:        syncTime,
+				Hash:        "0xhash",
+				ParentHash:  "0xparenthash",
 				Epoch:       nil,
 				EpochSize:   nil,
 				LastInEpoch: nil,
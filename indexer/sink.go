@@ -10,6 +10,7 @@ import (
 
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/metrics"
+	"github.com/figment-networks/celo-indexer/publisher"
 	"github.com/figment-networks/celo-indexer/store"
 	"github.com/figment-networks/celo-indexer/utils/logger"
 )
@@ -18,11 +19,12 @@ var (
 	_ pipeline.Sink = (*sink)(nil)
 )
 
-func NewSink(syncableDb store.Syncables, databaseDb store.Database, c figmentclient.Client, versionNumber int64) *sink {
+func NewSink(syncableDb store.Syncables, databaseDb store.Database, c figmentclient.Client, pub publisher.Publisher, versionNumber int64) *sink {
 	return &sink{
 		syncableDb:    syncableDb,
 		databaseDb:    databaseDb,
 		client:        c,
+		publisher:     pub,
 		versionNumber: versionNumber,
 
 		databaseSizeMetric: metrics.PipelineDatabaseSizeAfterHeight.WithLabels(),
@@ -34,6 +36,7 @@ type sink struct {
 	syncableDb    store.Syncables
 	databaseDb    store.Database
 	client        figmentclient.Client
+	publisher     publisher.Publisher
 	versionNumber int64
 
 	databaseSizeMetric *m.GroupGauge
@@ -51,7 +54,7 @@ func (s *sink) Consume(ctx context.Context, p pipeline.Payload) error {
 		logger.Field("height", payload.CurrentHeight),
 	)
 
-	if err := s.setProcessed(payload); err != nil {
+	if err := s.setProcessed(ctx, payload); err != nil {
 		return err
 
 	}
@@ -67,14 +70,35 @@ func (s *sink) Consume(ctx context.Context, p pipeline.Payload) error {
 	return nil
 }
 
-func (s *sink) setProcessed(payload *payload) error {
-	payload.Syncable.MarkProcessed(s.versionNumber, s.client.GetRequestCounter().GetCounter())
+func (s *sink) setProcessed(ctx context.Context, payload *payload) error {
+	// Height is marked as processed only after it is published, so it is published again when publishing fails
+	if err := s.publish(ctx, payload); err != nil {
+		return errors.Wrap(err, "failed publishing height in sink")
+	}
+
+	payload.Syncable.MarkProcessed(s.versionNumber, s.requestsCount(payload))
 	if err := s.syncableDb.Save(payload.Syncable); err != nil {
 		return errors.Wrap(err, "failed saving syncable in sink")
 	}
 	return nil
 }
 
+func (s *sink) publish(ctx context.Context, payload *payload) error {
+	if s.publisher == nil {
+		return nil
+	}
+
+	messages, err := toHeightMessages(payload)
+	if err != nil {
+		return err
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return s.publisher.Publish(ctx, messages...)
+}
+
 func (s *sink) addMetrics(payload *payload) error {
 	res, err := s.databaseDb.GetTotalSize()
 	if err != nil {
@@ -82,7 +106,16 @@ func (s *sink) addMetrics(payload *payload) error {
 	}
 
 	s.databaseSizeMetric.Set(res.Size)
-	s.requestCountMetric.Set(float64(s.client.GetRequestCounter().GetCounter()))
+	s.requestCountMetric.Set(float64(s.requestsCount(payload)))
 
 	return nil
 }
+
+// requestsCount returns number of node requests made for height.
+// Client counter is shared by heights prefetched in parallel, so requests of prefetched heights are not counted
+func (s *sink) requestsCount(payload *payload) uint64 {
+	if payload.Prefetched {
+		return 0
+	}
+	return s.client.GetRequestCounter().GetCounter()
+}
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	baseClientMock "github.com/figment-networks/celo-indexer/mock/baseclient"
+	figmentClientMock "github.com/figment-networks/celo-indexer/mock/client"
+	publisherMock "github.com/figment-networks/celo-indexer/mock/publisher"
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/publisher"
+	"github.com/golang/mock/gomock"
+)
+
+func TestSink_setProcessed(t *testing.T) {
+	const height int64 = 20
+	t.Parallel()
+
+	tests := []struct {
+		description         string
+		payload             *payload
+		expectMessages      int
+		expectRequestsCount uint64
+		publishErr          error
+	}{
+		{
+			description:         "publishes height before it is marked as processed",
+			payload:             &payload{CurrentHeight: height, NewBlockSequence: &model.BlockSeq{}, SystemEvents: []model.SystemEvent{{}}},
+			expectMessages:      2,
+			expectRequestsCount: 1,
+		},
+		{
+			description:    "does not mark height as processed when publishing fails",
+			payload:        &payload{CurrentHeight: height, NewBlockSequence: &model.BlockSeq{}},
+			expectMessages: 1,
+			publishErr:     errors.New("test error"),
+		},
+		{
+			description:         "does not publish when there is nothing to publish",
+			payload:             &payload{CurrentHeight: height},
+			expectRequestsCount: 1,
+		},
+		{
+			description: "does not record requests count of prefetched height",
+			payload:     &payload{CurrentHeight: height, Prefetched: true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			tt.payload.Syncable = &model.Syncable{Height: height}
+
+			requestCounterMock := baseClientMock.NewMockRequestCounter(ctrl)
+			requestCounterMock.EXPECT().GetCounter().Return(uint64(1)).AnyTimes()
+
+			clientMock := figmentClientMock.NewMockClient(ctrl)
+			clientMock.EXPECT().GetRequestCounter().Return(requestCounterMock).AnyTimes()
+
+			publisherMock := publisherMock.NewMockPublisher(ctrl)
+			if tt.expectMessages > 0 {
+				publisherMock.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, messages ...publisher.Message) error {
+					if len(messages) != tt.expectMessages {
+						t.Errorf("unexpected messages count, want %d; got %d", tt.expectMessages, len(messages))
+					}
+					return tt.publishErr
+				}).Times(1)
+			}
+
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+			if tt.publishErr == nil {
+				syncableDbMock.EXPECT().Save(tt.payload.Syncable).Return(nil).Times(1)
+			}
+
+			s := NewSink(syncableDbMock, nil, clientMock, publisherMock, 1)
+
+			err := s.setProcessed(ctx, tt.payload)
+			if (err != nil) != (tt.publishErr != nil) {
+				t.Errorf("unexpected error, want %v; got %v", tt.publishErr, err)
+			}
+			if tt.publishErr != nil && tt.payload.Syncable.ProcessedAt != nil {
+				t.Errorf("height should not be marked as processed")
+			}
+			if tt.publishErr == nil && tt.payload.Syncable.RequestsCount != tt.expectRequestsCount {
+				t.Errorf("unexpected requests count, want %d; got %d", tt.expectRequestsCount, tt.payload.Syncable.RequestsCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+var (
+	_ pipeline.Source = (*parallelIndexSource)(nil)
+)
+
+func NewParallelIndexSource(ctx context.Context, src *indexSource, prefetcher *heightPrefetcher, payloadFactory *payloadFactory) *parallelIndexSource {
+	s := &parallelIndexSource{
+		indexSource:    src,
+		prefetcher:     prefetcher,
+		payloadFactory: payloadFactory,
+	}
+	s.load(ctx)
+	return s
+}
+
+// parallelIndexSource is an index source which gets heights prefetched in parallel
+// Remaining stages are run for one height at a time, in height order
+type parallelIndexSource struct {
+	*indexSource
+
+	prefetcher     *heightPrefetcher
+	payloadFactory *payloadFactory
+
+	prefetched bool
+}
+
+func (s *parallelIndexSource) Next(ctx context.Context, p pipeline.Payload) bool {
+	if !s.indexSource.Next(ctx, p) {
+		return false
+	}
+	s.load(ctx)
+	return true
+}
+
+func (s *parallelIndexSource) Skip(stageName pipeline.StageName) bool {
+	if !s.prefetched {
+		return false
+	}
+	for _, prefetchedStage := range prefetchedStages {
+		if prefetchedStage == stageName {
+			return true
+		}
+	}
+	return false
+}
+
+// load waits for current height to be prefetched and hands its payload to payload factory
+func (s *parallelIndexSource) load(ctx context.Context) {
+	height := s.Current()
+
+	s.prefetcher.schedule(ctx, height)
+
+	p := s.prefetcher.get(height)
+	s.prefetched = p != nil
+	if s.prefetched {
+		s.payloadFactory.preload(p)
+	}
+}
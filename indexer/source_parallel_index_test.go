@@ -0,0 +1,101 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	baseClientMock "github.com/figment-networks/celo-indexer/mock/baseclient"
+	figmentClientMock "github.com/figment-networks/celo-indexer/mock/client"
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/golang/mock/gomock"
+)
+
+func TestParallelIndexSource(t *testing.T) {
+	const startHeight int64 = 10
+	const endHeight int64 = 15
+	t.Parallel()
+
+	tests := []struct {
+		description     string
+		parallelHeights int64
+		failingHeight   int64
+	}{
+		{"prefetches heights one by one", 1, 0},
+		{"prefetches heights in parallel", 3, 0},
+		{"prefetches more heights in parallel than there is in batch", 10, 0},
+		{"falls back to sequential fetching when prefetching fails", 3, 12},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			requestCounterMock := baseClientMock.NewMockRequestCounter(ctrl)
+			requestCounterMock.EXPECT().InitCounter().AnyTimes()
+
+			clientMock := figmentClientMock.NewMockClient(ctrl)
+			clientMock.EXPECT().WithAssignedNode(gomock.Any()).Return(clientMock).AnyTimes()
+			clientMock.EXPECT().GetRequestCounter().Return(requestCounterMock).AnyTimes()
+			clientMock.EXPECT().GetChainParams(gomock.Any()).Return(&figmentclient.ChainParams{}, nil).AnyTimes()
+			clientMock.EXPECT().GetMetaByHeight(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, height int64) (*figmentclient.HeightMeta, error) {
+				if height == tt.failingHeight {
+					return nil, errors.New("test error")
+				}
+				return &figmentclient.HeightMeta{Height: height}, nil
+			}).AnyTimes()
+
+			options := &pipeline.Options{
+				TaskWhitelist: []pipeline.TaskName{TaskNameHeightMetaRetriever},
+			}
+
+			factory := NewPayloadFactory()
+			prefetcher := newHeightPrefetcher(&config.Config{}, clientMock, options, tt.parallelHeights, startHeight, endHeight)
+			src := &indexSource{
+				currentHeight: startHeight,
+				startHeight:   startHeight,
+				endHeight:     endHeight,
+			}
+
+			source := NewParallelIndexSource(ctx, src, prefetcher, factory)
+
+			var recentPayload pipeline.Payload
+			for ok := true; ok; ok = source.Next(ctx, recentPayload) {
+				height := source.Current()
+				pl := factory.GetPayload(height).(*payload)
+
+				if height == tt.failingHeight {
+					if source.Skip(pipeline.StageFetcher) {
+						t.Errorf("fetcher stage should not be skipped for height %d", height)
+					}
+				} else {
+					if !source.Skip(pipeline.StageSetup) || !source.Skip(pipeline.StageFetcher) || !source.Skip(pipeline.StageParser) {
+						t.Errorf("prefetched stages should be skipped for height %d", height)
+					}
+					if source.Skip(pipeline.StageSyncer) || source.Skip(StageAnalyzer) {
+						t.Errorf("stages depending on previous height should not be skipped for height %d", height)
+					}
+					if pl.HeightMeta.Height != height {
+						t.Errorf("unexpected prefetched payload, want height %d; got %d", height, pl.HeightMeta.Height)
+					}
+				}
+
+				if int64(len(prefetcher.results)) >= tt.parallelHeights {
+					t.Errorf("too many heights prefetched ahead, want less than %d; got %d", tt.parallelHeights, len(prefetcher.results))
+				}
+
+				recentPayload = pl
+			}
+
+			if source.Current() != endHeight {
+				t.Errorf("unexpected last height, want %d; got %d", endHeight, source.Current())
+			}
+		})
+	}
+}
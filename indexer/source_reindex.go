@@ -0,0 +1,113 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ pipeline.Source = (*reindexSource)(nil)
+
+	ErrHeightRangeInvalid = errors.New("end height must not be lower than start height")
+)
+
+type ReindexSourceConfig struct {
+	StartHeight int64
+	EndHeight   int64
+}
+
+func NewReindexSource(syncableDb store.Syncables, sourceCfg *ReindexSourceConfig) (*reindexSource, error) {
+	src := &reindexSource{
+		syncableDb: syncableDb,
+
+		sourceCfg: sourceCfg,
+	}
+	if err := src.init(); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+// reindexSource is a source of already indexed heights within fixed range
+type reindexSource struct {
+	syncableDb store.Syncables
+
+	sourceCfg *ReindexSourceConfig
+
+	currentHeight int64
+	startHeight   int64
+	endHeight     int64
+	err           error
+}
+
+func (s *reindexSource) Skip(pipeline.StageName) bool {
+	return false
+}
+
+func (s *reindexSource) Next(context.Context, pipeline.Payload) bool {
+	if s.err == nil && s.currentHeight < s.endHeight {
+		s.currentHeight = s.currentHeight + 1
+		return true
+	}
+	return false
+}
+
+func (s *reindexSource) Current() int64 {
+	return s.currentHeight
+}
+
+func (s *reindexSource) Err() error {
+	return s.err
+}
+
+func (s *reindexSource) Len() int64 {
+	return s.endHeight - s.startHeight + 1
+}
+
+func (s *reindexSource) init() error {
+	if s.sourceCfg.EndHeight < s.sourceCfg.StartHeight {
+		return ErrHeightRangeInvalid
+	}
+	if err := s.setStartHeight(); err != nil {
+		return err
+	}
+	if err := s.setEndHeight(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *reindexSource) setStartHeight() error {
+	if err := s.checkIndexed(s.sourceCfg.StartHeight); err != nil {
+		return err
+	}
+
+	s.currentHeight = s.sourceCfg.StartHeight
+	s.startHeight = s.sourceCfg.StartHeight
+	return nil
+}
+
+func (s *reindexSource) setEndHeight() error {
+	if err := s.checkIndexed(s.sourceCfg.EndHeight); err != nil {
+		return err
+	}
+
+	s.endHeight = s.sourceCfg.EndHeight
+	return nil
+}
+
+// checkIndexed makes sure that given height was already indexed, so reindex does not go beyond indexed range
+func (s *reindexSource) checkIndexed(height int64) error {
+	if _, err := s.syncableDb.FindByHeight(height); err != nil {
+		if err == psql.ErrNotFound {
+			return errors.New(fmt.Sprintf("cannot reindex height which was never indexed [height=%d]", height))
+		}
+		return err
+	}
+	return nil
+}
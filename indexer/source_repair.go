@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+var (
+	_ pipeline.Source = (*repairSource)(nil)
+)
+
+func NewRepairSource(syncableDb store.Syncables) (*repairSource, error) {
+	src := &repairSource{
+		syncableDb: syncableDb,
+	}
+	if err := src.init(); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+// repairSource is a source of heights which are missing or were not processed
+type repairSource struct {
+	syncableDb store.Syncables
+
+	gaps       []store.SyncableGap
+	currentGap int
+
+	currentHeight int64
+	startHeight   int64
+	endHeight     int64
+	err           error
+}
+
+func (s *repairSource) Skip(pipeline.StageName) bool {
+	return false
+}
+
+func (s *repairSource) Next(context.Context, pipeline.Payload) bool {
+	if s.err != nil {
+		return false
+	}
+	if s.currentHeight < s.gaps[s.currentGap].EndHeight {
+		s.currentHeight = s.currentHeight + 1
+		return true
+	}
+	if s.currentGap < len(s.gaps)-1 {
+		s.currentGap = s.currentGap + 1
+		s.currentHeight = s.gaps[s.currentGap].StartHeight
+		return true
+	}
+	return false
+}
+
+func (s *repairSource) Current() int64 {
+	return s.currentHeight
+}
+
+func (s *repairSource) Err() error {
+	return s.err
+}
+
+func (s *repairSource) Len() int64 {
+	var count int64
+	for _, gap := range s.gaps {
+		count += gap.EndHeight - gap.StartHeight + 1
+	}
+	return count
+}
+
+func (s *repairSource) init() error {
+	gaps, err := s.syncableDb.FindGaps(0)
+	if err != nil {
+		return err
+	}
+	if len(gaps) == 0 {
+		return ErrNothingToProcess
+	}
+
+	s.gaps = gaps
+	s.currentHeight = gaps[0].StartHeight
+	s.startHeight = gaps[0].StartHeight
+	s.endHeight = gaps[len(gaps)-1].EndHeight
+	return nil
+}
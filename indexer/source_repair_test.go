@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRepairSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		gaps          []store.SyncableGap
+		expectHeights []int64
+		expectErr     error
+	}{
+		{
+			description:   "iterates over heights of single gap",
+			gaps:          []store.SyncableGap{{StartHeight: 10, EndHeight: 12}},
+			expectHeights: []int64{10, 11, 12},
+		},
+		{
+			description:   "iterates over heights of many gaps",
+			gaps:          []store.SyncableGap{{StartHeight: 10, EndHeight: 11}, {StartHeight: 15, EndHeight: 15}, {StartHeight: 20, EndHeight: 21}},
+			expectHeights: []int64{10, 11, 15, 20, 21},
+		},
+		{
+			description: "returns error when there are no gaps",
+			gaps:        nil,
+			expectErr:   ErrNothingToProcess,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			syncableDbMock := mock.NewMockSyncables(ctrl)
+			syncableDbMock.EXPECT().FindGaps(int64(0)).Return(tt.gaps, nil).Times(1)
+
+			source, err := NewRepairSource(syncableDbMock)
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			var heights []int64
+			for ok := true; ok; ok = source.Next(context.Background(), nil) {
+				heights = append(heights, source.Current())
+			}
+
+			if !reflect.DeepEqual(heights, tt.expectHeights) {
+				t.Errorf("unexpected heights, want %v; got %v", tt.expectHeights, heights)
+			}
+			if source.Len() != int64(len(tt.expectHeights)) {
+				t.Errorf("unexpected length, want %v; got %v", len(tt.expectHeights), source.Len())
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/store"
 	"github.com/figment-networks/celo-indexer/store/psql"
 	"github.com/figment-networks/celo-indexer/types"
@@ -15,9 +17,21 @@ import (
 )
 
 const (
-	MainSyncerTaskName = "MainSyncer"
+	MainSyncerTaskName             = "MainSyncer"
+	ValidatorSignersSyncerTaskName = "ValidatorSignersSyncer"
 )
 
+// ChainReorganizationError is returned when parent hash of given height does not match hash of previously indexed height
+type ChainReorganizationError struct {
+	Height      int64
+	ParentHash  string
+	IndexedHash string
+}
+
+func (e *ChainReorganizationError) Error() string {
+	return fmt.Sprintf("chain reorganization detected [height=%d] [parent_hash=%s] [indexed_hash=%s]", e.Height, e.ParentHash, e.IndexedHash)
+}
+
 func NewMainSyncerTask(syncableDb store.Syncables) pipeline.Task {
 	return &mainSyncerTask{
 		syncableDb: syncableDb,
@@ -37,6 +51,10 @@ func (t *mainSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSyncer, t.GetName(), payload.CurrentHeight))
 
+	if err := t.checkParentHash(payload); err != nil {
+		return err
+	}
+
 	syncable, err := t.syncableDb.FindByHeight(payload.CurrentHeight)
 	if err != nil {
 		if err == psql.ErrNotFound {
@@ -54,6 +72,8 @@ func (t *mainSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
 		}
 	}
 
+	syncable.Hash = payload.HeightMeta.Hash
+	syncable.ParentHash = payload.HeightMeta.ParentHash
 	syncable.StartedAt = *types.NewTimeFromTime(time.Now())
 
 	report, ok := ctx.Value(CtxReport).(*model.Report)
@@ -64,3 +84,117 @@ func (t *mainSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
 	payload.Syncable = syncable
 	return nil
 }
+
+// checkParentHash makes sure that current height is built on top of previously indexed height
+func (t *mainSyncerTask) checkParentHash(payload *payload) error {
+	prevSyncable, err := t.syncableDb.FindByHeight(payload.CurrentHeight - 1)
+	if err != nil {
+		if err == psql.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	// Syncables indexed before hashes were persisted cannot be verified
+	if prevSyncable.Hash == "" || payload.HeightMeta.ParentHash == "" {
+		return nil
+	}
+
+	if prevSyncable.Hash != payload.HeightMeta.ParentHash {
+		return &ChainReorganizationError{
+			Height:      payload.CurrentHeight,
+			ParentHash:  payload.HeightMeta.ParentHash,
+			IndexedHash: prevSyncable.Hash,
+		}
+	}
+	return nil
+}
+
+func NewValidatorSignersSyncerTask(c figmentclient.Client, electedValidatorSeqDb store.ElectedValidatorSeq) pipeline.Task {
+	return &validatorSignersSyncerTask{
+		client:                c,
+		electedValidatorSeqDb: electedValidatorSeqDb,
+	}
+}
+
+// validatorSignersSyncerTask sets whether validators signed the block from its aggregated seal bitmap.
+// It runs in syncer stage, because validators elected at first height of epoch are persisted by the time next heights are synced
+type validatorSignersSyncerTask struct {
+	client                figmentclient.Client
+	electedValidatorSeqDb store.ElectedValidatorSeq
+}
+
+func (t *validatorSignersSyncerTask) GetName() string {
+	return ValidatorSignersSyncerTaskName
+}
+
+func (t *validatorSignersSyncerTask) Run(ctx context.Context, p pipeline.Payload) error {
+	payload := p.(*payload)
+
+	if payload.RawBlock == nil || len(payload.RawValidators) == 0 {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSyncer, t.GetName(), payload.CurrentHeight))
+
+	electedIndexes, err := t.getElectedIndexes(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	// Block extra is read from header of next block, so parent aggregated seal is the seal of current block.
+	// Validators which were not elected did not take part in consensus, so they are left without signed flag
+	bitmap := payload.RawBlock.Extra.ParentAggregatedSeal.Bitmap
+	for _, rawValidator := range payload.RawValidators {
+		index, ok := electedIndexes[strings.ToLower(rawValidator.Signer)]
+		if !ok {
+			rawValidator.Signed = nil
+			continue
+		}
+
+		signed := bitmap != nil && bitmap.Bit(int(index)) == 1
+		rawValidator.Signed = &signed
+	}
+	return nil
+}
+
+// getElectedIndexes returns indexes of validators elected for epoch of current height by their signers.
+// Elected set is read from the payload or the store, and from the node when epoch has not been indexed yet
+func (t *validatorSignersSyncerTask) getElectedIndexes(ctx context.Context, payload *payload) (map[string]int64, error) {
+	electedIndexes := make(map[string]int64)
+
+	if len(payload.RawElectedValidators) > 0 {
+		for _, rawElectedValidator := range payload.RawElectedValidators {
+			electedIndexes[strings.ToLower(rawElectedValidator.Signer)] = rawElectedValidator.Index
+		}
+		return electedIndexes, nil
+	}
+
+	if payload.HeightMeta.Epoch != nil {
+		electedValidatorSequences, err := t.electedValidatorSeqDb.FindByEpoch(*payload.HeightMeta.Epoch)
+		if err != nil && err != psql.ErrNotFound {
+			return nil, err
+		}
+
+		if len(electedValidatorSequences) > 0 {
+			for _, electedValidatorSequence := range electedValidatorSequences {
+				electedIndexes[strings.ToLower(electedValidatorSequence.Signer)] = electedValidatorSequence.Index
+			}
+			return electedIndexes, nil
+		}
+	}
+
+	signers, err := t.client.GetValidatorSignersByHeight(ctx, payload.CurrentHeight)
+	if err != nil {
+		// No validators are elected before Election contract is deployed
+		if err == figmentclient.ErrContractNotDeployed {
+			return electedIndexes, nil
+		}
+		return nil, err
+	}
+
+	for i, signer := range signers {
+		electedIndexes[strings.ToLower(signer)] = int64(i)
+	}
+	return electedIndexes, nil
+}
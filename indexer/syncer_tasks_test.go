@@ -0,0 +1,251 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	clientMock "github.com/figment-networks/celo-indexer/mock/client"
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMainSyncer_Run(t *testing.T) {
+	const syncHeight int64 = 20
+	t.Parallel()
+
+	syncTime := types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		description     string
+		prevSyncable    *model.Syncable
+		prevSyncableErr error
+		expectReorg     bool
+	}{
+		{
+			description:     "creates syncable when previous height is not indexed",
+			prevSyncable:    nil,
+			prevSyncableErr: psql.ErrNotFound,
+			expectReorg:     false,
+		},
+		{
+			description:  "creates syncable when parent hash matches previous height",
+			prevSyncable: &model.Syncable{Height: syncHeight - 1, Hash: "0xparenthash"},
+			expectReorg:  false,
+		},
+		{
+			description:  "creates syncable when previous height has no hash",
+			prevSyncable: &model.Syncable{Height: syncHeight - 1},
+			expectReorg:  false,
+		},
+		{
+			description:  "returns error when parent hash does not match previous height",
+			prevSyncable: &model.Syncable{Height: syncHeight - 1, Hash: "0xorphanedhash"},
+			expectReorg:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockSyncables(ctrl)
+
+			task := NewMainSyncerTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight: syncHeight,
+				HeightMeta: HeightMeta{
+					Height:     syncHeight,
+					Time:       syncTime,
+					Hash:       "0xhash",
+					ParentHash: "0xparenthash",
+				},
+			}
+
+			dbMock.EXPECT().FindByHeight(syncHeight-1).Return(tt.prevSyncable, tt.prevSyncableErr).Times(1)
+			if !tt.expectReorg {
+				dbMock.EXPECT().FindByHeight(syncHeight).Return(nil, psql.ErrNotFound).Times(1)
+			}
+
+			err := task.Run(ctx, pl)
+
+			var reorgErr *ChainReorganizationError
+			if tt.expectReorg {
+				if !errors.As(err, &reorgErr) {
+					t.Errorf("want ChainReorganizationError; got %v", err)
+					return
+				}
+				if reorgErr.Height != syncHeight {
+					t.Errorf("unexpected reorg height, want %v; got %v", syncHeight, reorgErr.Height)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if pl.Syncable.Hash != pl.HeightMeta.Hash || pl.Syncable.ParentHash != pl.HeightMeta.ParentHash {
+				t.Errorf("want syncable hashes %v/%v; got %v/%v", pl.HeightMeta.Hash, pl.HeightMeta.ParentHash, pl.Syncable.Hash, pl.Syncable.ParentHash)
+			}
+		})
+	}
+}
+
+func TestValidatorSignersSyncer_Run(t *testing.T) {
+	const syncHeight int64 = 20
+	t.Parallel()
+
+	epoch := int64(2)
+	signed, notSigned := true, false
+	dbErr := errors.New("unexpected err")
+
+	electedValidatorSeqs := []model.ElectedValidatorSeq{
+		{Signer: "0xSIGNER0", Index: 0},
+		{Signer: "0xsigner1", Index: 1},
+		{Signer: "0xsigner2", Index: 2},
+	}
+
+	tests := []struct {
+		description          string
+		rawElectedValidators []*figmentclient.ElectedValidator
+		electedValidatorSeqs []model.ElectedValidatorSeq
+		dbErr                error
+		signers              []string
+		clientErr            error
+		bitmap               *big.Int
+		expectDbCall         bool
+		expectClientCall     bool
+		expectSigned         []*bool
+		expectErr            error
+	}{
+		{
+			description:          "sets signed from bitmap using validators elected for epoch",
+			electedValidatorSeqs: electedValidatorSeqs,
+			bitmap:               big.NewInt(5),
+			expectDbCall:         true,
+			expectSigned:         []*bool{&signed, &notSigned, &signed, nil},
+		},
+		{
+			description: "sets signed from bitmap using validators elected at current height",
+			rawElectedValidators: []*figmentclient.ElectedValidator{
+				{Signer: "0xsigner0", Index: 0},
+				{Signer: "0xsigner1", Index: 1},
+				{Signer: "0xsigner2", Index: 2},
+			},
+			bitmap:       big.NewInt(3),
+			expectSigned: []*bool{&signed, &signed, &notSigned, nil},
+		},
+		{
+			description:          "sets signed to false when block has no bitmap",
+			electedValidatorSeqs: electedValidatorSeqs,
+			expectDbCall:         true,
+			expectSigned:         []*bool{&notSigned, &notSigned, &notSigned, nil},
+		},
+		{
+			description:      "sets signed from bitmap using node when validators elected for epoch are not indexed",
+			dbErr:            psql.ErrNotFound,
+			signers:          []string{"0xsigner0", "0xSIGNER1", "0xsigner2"},
+			bitmap:           big.NewInt(5),
+			expectDbCall:     true,
+			expectClientCall: true,
+			expectSigned:     []*bool{&signed, &notSigned, &signed, nil},
+		},
+		{
+			description:      "sets signed to nil when Election contract is not deployed",
+			dbErr:            psql.ErrNotFound,
+			clientErr:        figmentclient.ErrContractNotDeployed,
+			bitmap:           big.NewInt(5),
+			expectDbCall:     true,
+			expectClientCall: true,
+			expectSigned:     []*bool{nil, nil, nil, nil},
+		},
+		{
+			description:      "returns error when node cannot get validators elected for epoch",
+			dbErr:            psql.ErrNotFound,
+			clientErr:        dbErr,
+			bitmap:           big.NewInt(5),
+			expectDbCall:     true,
+			expectClientCall: true,
+			expectErr:        dbErr,
+		},
+		{
+			description:  "returns error when elected validators cannot be found",
+			dbErr:        dbErr,
+			bitmap:       big.NewInt(5),
+			expectDbCall: true,
+			expectErr:    dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockElectedValidatorSeq(ctrl)
+			mockClient := clientMock.NewMockClient(ctrl)
+
+			task := NewValidatorSignersSyncerTask(mockClient, dbMock)
+
+			pl := &payload{
+				CurrentHeight: syncHeight,
+				HeightMeta: HeightMeta{
+					Height: syncHeight,
+					Epoch:  &epoch,
+				},
+				RawBlock: &figmentclient.Block{
+					Extra: figmentclient.BlockExtra{
+						AggregatedSeal:       figmentclient.IstanbulAggregatedSeal{Bitmap: big.NewInt(7)},
+						ParentAggregatedSeal: figmentclient.IstanbulAggregatedSeal{Bitmap: tt.bitmap},
+					},
+				},
+				RawValidators: []*figmentclient.Validator{
+					{Signer: "0xsigner0", Signed: &signed},
+					{Signer: "0xsigner1", Signed: &signed},
+					{Signer: "0xsigner2", Signed: &signed},
+					{Signer: "0xsigner3", Signed: &signed},
+				},
+				RawElectedValidators: tt.rawElectedValidators,
+			}
+
+			if tt.expectDbCall {
+				dbMock.EXPECT().FindByEpoch(epoch).Return(tt.electedValidatorSeqs, tt.dbErr).Times(1)
+			}
+			if tt.expectClientCall {
+				mockClient.EXPECT().GetValidatorSignersByHeight(ctx, syncHeight).Return(tt.signers, tt.clientErr).Times(1)
+			}
+
+			err := task.Run(ctx, pl)
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+				return
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			for i, rawValidator := range pl.RawValidators {
+				want, got := tt.expectSigned[i], rawValidator.Signed
+				if (want == nil) != (got == nil) || (want != nil && *want != *got) {
+					t.Errorf("unexpected signed of validator %d, want %v; got %v", i, want, got)
+				}
+			}
+		})
+	}
+}
@@ -35,6 +35,86 @@ var (
 		Tags:      []string{"query"},
 	})
 
+	PipelineTaskRetries = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "pipeline",
+		Name:      "task_retries",
+		Desc:      "The total number of retries of failed pipeline tasks",
+		Tags:      []string{"task", "kind"},
+	})
+
+	PipelineTaskFailures = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "pipeline",
+		Name:      "task_failures",
+		Desc:      "The total number of pipeline tasks which failed after all attempts",
+		Tags:      []string{"task", "kind"},
+	})
+
+	VerifyMismatchCount = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "verify",
+		Name:      "mismatch_count",
+		Desc:      "The total number of stored records which do not match data from the node",
+		Tags:      []string{"table", "kind"},
+	})
+
+	ContractsRegistryCacheHit = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "contracts_registry_cache_hit",
+		Desc:      "The total number of contract addresses served from contracts registry cache",
+		Tags:      []string{"contract"},
+	})
+
+	ContractsRegistryCacheMiss = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "contracts_registry_cache_miss",
+		Desc:      "The total number of contract addresses resolved through Registry contract",
+		Tags:      []string{"contract"},
+	})
+
+	ClientNodeHealthy = metrics.MustNewGaugeWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "node_healthy",
+		Desc:      "Whether node passed the most recent health check (1) or not (0)",
+		Tags:      []string{"node"},
+	})
+
+	ClientNodeHeight = metrics.MustNewGaugeWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "node_height",
+		Desc:      "The most recent height of node",
+		Tags:      []string{"node"},
+	})
+
+	ClientNodeLatency = metrics.MustNewGaugeWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "node_latency",
+		Desc:      "The time spent by node responding to health check",
+		Tags:      []string{"node"},
+	})
+
+	ClientNodeCalls = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "node_calls",
+		Desc:      "The total number of client calls made to node by result",
+		Tags:      []string{"node", "result"},
+	})
+
+	ClientNodeFailovers = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexer",
+		Subsystem: "client",
+		Name:      "node_failovers",
+		Desc:      "The total number of calls retried on node after another node failed",
+		Tags:      []string{"node"},
+	})
+
 	ServerRequestDuration = metrics.MustNewHistogramWithTags(metrics.HistogramOptions{
 		Namespace: "indexer",
 		Subsystem: "server",
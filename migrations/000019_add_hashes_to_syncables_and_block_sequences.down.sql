@@ -0,0 +1,4 @@
+ALTER TABLE syncables DROP COLUMN hash;
+ALTER TABLE syncables DROP COLUMN parent_hash;
+ALTER TABLE block_sequences DROP COLUMN hash;
+ALTER TABLE block_sequences DROP COLUMN parent_hash;
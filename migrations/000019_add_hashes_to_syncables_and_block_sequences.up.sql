@@ -0,0 +1,4 @@
+ALTER TABLE syncables ADD COLUMN hash TEXT;
+ALTER TABLE syncables ADD COLUMN parent_hash TEXT;
+ALTER TABLE block_sequences ADD COLUMN hash TEXT;
+ALTER TABLE block_sequences ADD COLUMN parent_hash TEXT;
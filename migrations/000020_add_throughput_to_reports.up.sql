@@ -0,0 +1 @@
+ALTER TABLE reports ADD COLUMN throughput DOUBLE PRECISION;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id         BIGSERIAL                NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    url        TEXT                     NOT NULL,
+    actors     TEXT[]                   NOT NULL DEFAULT '{}',
+    kinds      TEXT[]                   NOT NULL DEFAULT '{}',
+    secret     TEXT                     NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              BIGSERIAL                NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    subscription_id BIGINT                   NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    height          DECIMAL(65, 0)           NOT NULL,
+    actor           TEXT,
+    kind            TEXT                     NOT NULL,
+    attempts        INT                      NOT NULL,
+    status_code     INT,
+    error_msg       TEXT,
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_webhook_deliveries_subscription_id on webhook_deliveries (subscription_id);
+CREATE index idx_webhook_deliveries_event on webhook_deliveries (subscription_id, height, actor, kind);
@@ -0,0 +1,4 @@
+ALTER TABLE validator_aggregates DROP COLUMN recent_missed_blocks;
+ALTER TABLE validator_aggregates DROP COLUMN missed_in_row_count;
+ALTER TABLE validator_group_aggregates DROP COLUMN recent_missed_blocks;
+ALTER TABLE validator_group_aggregates DROP COLUMN missed_in_row_count;
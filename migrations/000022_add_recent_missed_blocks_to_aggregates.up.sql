@@ -0,0 +1,4 @@
+ALTER TABLE validator_aggregates ADD COLUMN recent_missed_blocks BIT VARYING NOT NULL DEFAULT B'';
+ALTER TABLE validator_aggregates ADD COLUMN missed_in_row_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE validator_group_aggregates ADD COLUMN recent_missed_blocks BIT VARYING NOT NULL DEFAULT B'';
+ALTER TABLE validator_group_aggregates ADD COLUMN missed_in_row_count BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS transaction_sequences
+(
+    id                    BIGSERIAL                NOT NULL,
+
+    height                DECIMAL(65, 0)           NOT NULL,
+    time                  TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    hash                  TEXT                     NOT NULL,
+    index                 INTEGER                  NOT NULL,
+    from_address          TEXT                     NOT NULL,
+    to_address            TEXT                     NOT NULL,
+    nonce                 DECIMAL(65, 0)           NOT NULL,
+    gas                   DECIMAL(65, 0)           NOT NULL,
+    gas_price             DECIMAL(65, 0)           NOT NULL,
+    gas_used              DECIMAL(65, 0)           NOT NULL,
+    fee_currency          TEXT                     NOT NULL,
+    gateway_fee           DECIMAL(65, 0)           NOT NULL,
+    gateway_fee_recipient TEXT                     NOT NULL,
+    success               BOOLEAN                  NOT NULL,
+    operations            JSONB                    NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_transaction_sequences_height on transaction_sequences (height);
+CREATE index idx_transaction_sequences_hash on transaction_sequences (hash);
+CREATE index idx_transaction_sequences_from_address on transaction_sequences (from_address);
+CREATE index idx_transaction_sequences_to_address on transaction_sequences (to_address);
@@ -0,0 +1,6 @@
+CREATE index idx_transaction_sequences_from_address on transaction_sequences (from_address);
+CREATE index idx_transaction_sequences_to_address on transaction_sequences (to_address);
+
+DROP INDEX IF EXISTS idx_account_activity_sequences_address_height_id;
+DROP INDEX IF EXISTS idx_transaction_sequences_from_address_height_id;
+DROP INDEX IF EXISTS idx_transaction_sequences_to_address_height_id;
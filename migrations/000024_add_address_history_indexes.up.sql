@@ -0,0 +1,7 @@
+-- Address history is paginated by height and id, the newest records first
+CREATE index idx_account_activity_sequences_address_height_id on account_activity_sequences (address, height, id);
+CREATE index idx_transaction_sequences_from_address_height_id on transaction_sequences (from_address, height, id);
+CREATE index idx_transaction_sequences_to_address_height_id on transaction_sequences (to_address, height, id);
+
+DROP INDEX IF EXISTS idx_transaction_sequences_from_address;
+DROP INDEX IF EXISTS idx_transaction_sequences_to_address;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_account_activity_sequences_token_id;
+DROP INDEX IF EXISTS idx_account_activity_sequences_counterparty_id;
+
+ALTER TABLE account_activity_sequences
+    DROP COLUMN counterparty,
+    DROP COLUMN token;
@@ -0,0 +1,7 @@
+ALTER TABLE account_activity_sequences
+    ADD COLUMN counterparty TEXT NOT NULL DEFAULT '',
+    ADD COLUMN token        TEXT NOT NULL DEFAULT '';
+
+-- Transfers are paginated by id, the newest records first
+CREATE index idx_account_activity_sequences_token_id on account_activity_sequences (token, id) WHERE token <> '';
+CREATE index idx_account_activity_sequences_counterparty_id on account_activity_sequences (counterparty, id) WHERE token <> '';
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS epoch_summaries
+(
+    id                       BIGSERIAL                NOT NULL,
+
+    height                   DECIMAL(65, 0)           NOT NULL,
+    time                     TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    epoch                    DECIMAL(65, 0)           NOT NULL,
+    target_voting_yield      DECIMAL(65, 0)           NOT NULL,
+    rewards_multiplier       DECIMAL(65, 0)           NOT NULL,
+    elected_validators_count INTEGER                  NOT NULL,
+    validator_rewards        DECIMAL(65, 0)           NOT NULL,
+    voter_rewards            DECIMAL(65, 0)           NOT NULL,
+    community_fund_rewards   DECIMAL(65, 0)           NOT NULL,
+    carbon_fund_rewards      DECIMAL(65, 0)           NOT NULL,
+    total_votes              DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE index idx_epoch_summaries_epoch on epoch_summaries (epoch);
+CREATE index idx_epoch_summaries_height on epoch_summaries (height);
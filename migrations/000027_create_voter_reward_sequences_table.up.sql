@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS voter_reward_sequences
+(
+    id           BIGSERIAL                NOT NULL,
+
+    height       DECIMAL(65, 0)           NOT NULL,
+    time         TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    epoch        DECIMAL(65, 0)           NOT NULL,
+    address      TEXT                     NOT NULL,
+    "group"      TEXT                     NOT NULL,
+    amount       DECIMAL(65, 0)           NOT NULL,
+    active_votes DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_voter_reward_sequences_height on voter_reward_sequences (height);
+CREATE index idx_voter_reward_sequences_address_height_id on voter_reward_sequences (address, height, id);
+CREATE index idx_voter_reward_sequences_group on voter_reward_sequences ("group");
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS voter_aggregates
+(
+    id                BIGSERIAL                NOT NULL,
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    started_at_height DECIMAL(65, 0)           NOT NULL,
+    started_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    recent_at_height  DECIMAL(65, 0)           NOT NULL,
+    recent_at         TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    address           TEXT                     NOT NULL,
+    "group"           TEXT                     NOT NULL,
+    pending_votes     DECIMAL(65, 0)           NOT NULL,
+    active_votes      DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE index idx_voter_aggregates_address_group on voter_aggregates (address, "group");
+CREATE index idx_voter_aggregates_group_votes on voter_aggregates ("group", (active_votes + pending_votes) DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS elected_validator_sequences;
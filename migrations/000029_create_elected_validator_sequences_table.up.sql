@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS elected_validator_sequences
+(
+    id      BIGSERIAL                NOT NULL,
+
+    height  DECIMAL(65, 0)           NOT NULL,
+    time    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    epoch   DECIMAL(65, 0)           NOT NULL,
+    address TEXT                     NOT NULL,
+    signer  TEXT                     NOT NULL,
+    "group" TEXT                     NOT NULL,
+    index   DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_elected_validator_sequences_height on elected_validator_sequences (height);
+CREATE UNIQUE index idx_elected_validator_sequences_epoch_address on elected_validator_sequences (epoch, address);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_block_sequences_proposer;
+
+ALTER TABLE validator_aggregates DROP COLUMN IF EXISTS proposed_blocks_count;
+ALTER TABLE validator_sequences DROP COLUMN IF EXISTS proposed;
+ALTER TABLE block_sequences DROP COLUMN IF EXISTS signers_bitmap;
+ALTER TABLE block_sequences DROP COLUMN IF EXISTS round;
+ALTER TABLE block_sequences DROP COLUMN IF EXISTS proposer;
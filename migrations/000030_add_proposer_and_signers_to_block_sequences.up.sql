@@ -0,0 +1,7 @@
+ALTER TABLE block_sequences ADD COLUMN proposer TEXT NOT NULL DEFAULT '';
+ALTER TABLE block_sequences ADD COLUMN round BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE block_sequences ADD COLUMN signers_bitmap BIT VARYING NOT NULL DEFAULT B'';
+ALTER TABLE validator_sequences ADD COLUMN proposed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE validator_aggregates ADD COLUMN proposed_blocks_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE index idx_block_sequences_proposer on block_sequences (proposer);
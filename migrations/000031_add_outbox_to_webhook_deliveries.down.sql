@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_id_pending;
+DROP INDEX IF EXISTS idx_webhook_deliveries_next_attempt_at;
+DROP INDEX IF EXISTS idx_webhook_deliveries_event;
+CREATE index idx_webhook_deliveries_event on webhook_deliveries (subscription_id, height, actor, kind);
+
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS next_attempt_at;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS payload;
@@ -0,0 +1,16 @@
+ALTER TABLE webhook_deliveries ADD COLUMN payload JSONB;
+ALTER TABLE webhook_deliveries ADD COLUMN next_attempt_at TIMESTAMP WITH TIME ZONE;
+
+-- Keep only latest delivery of every event so deliveries can be enqueued once per event
+DELETE FROM webhook_deliveries a
+    USING webhook_deliveries b
+WHERE a.id < b.id
+  AND a.subscription_id = b.subscription_id
+  AND a.height = b.height
+  AND a.actor IS NOT DISTINCT FROM b.actor
+  AND a.kind = b.kind;
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_event;
+CREATE UNIQUE index idx_webhook_deliveries_event on webhook_deliveries (subscription_id, height, actor, kind);
+CREATE index idx_webhook_deliveries_next_attempt_at on webhook_deliveries (next_attempt_at) WHERE delivered_at IS NULL;
+CREATE index idx_webhook_deliveries_subscription_id_pending on webhook_deliveries (subscription_id, id) WHERE delivered_at IS NULL AND next_attempt_at IS NOT NULL;
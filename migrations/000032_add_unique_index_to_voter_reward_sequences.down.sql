@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_voter_reward_sequences_height_address_group;
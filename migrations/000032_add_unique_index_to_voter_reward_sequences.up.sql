@@ -0,0 +1,9 @@
+-- Keep only latest reward of voter for group at height so rewards can be upserted
+DELETE FROM voter_reward_sequences a
+    USING voter_reward_sequences b
+WHERE a.id < b.id
+  AND a.height = b.height
+  AND a.address = b.address
+  AND a."group" = b."group";
+
+CREATE UNIQUE index idx_voter_reward_sequences_height_address_group on voter_reward_sequences (height, address, "group");
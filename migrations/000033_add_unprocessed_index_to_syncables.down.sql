@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_syncables_unprocessed_height;
@@ -0,0 +1,2 @@
+-- Unprocessed heights are looked up when finding gaps of syncables
+CREATE index idx_syncables_unprocessed_height on syncables (height) WHERE processed_at IS NULL;
@@ -6,6 +6,8 @@ package mock_figmentclient
 
 import (
 	context "context"
+	ethereum "github.com/ethereum/go-ethereum"
+	types "github.com/ethereum/go-ethereum/core/types"
 	client "github.com/figment-networks/celo-indexer/client"
 	figmentclient "github.com/figment-networks/celo-indexer/client/figmentclient"
 	gomock "github.com/golang/mock/gomock"
@@ -107,6 +109,36 @@ func (mr *MockClientMockRecorder) GetChainStatus(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainStatus", reflect.TypeOf((*MockClient)(nil).GetChainStatus), arg0)
 }
 
+// GetElectedValidatorsByHeight mocks base method
+func (m *MockClient) GetElectedValidatorsByHeight(arg0 context.Context, arg1 int64) ([]*figmentclient.ElectedValidator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetElectedValidatorsByHeight", arg0, arg1)
+	ret0, _ := ret[0].([]*figmentclient.ElectedValidator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetElectedValidatorsByHeight indicates an expected call of GetElectedValidatorsByHeight
+func (mr *MockClientMockRecorder) GetElectedValidatorsByHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElectedValidatorsByHeight", reflect.TypeOf((*MockClient)(nil).GetElectedValidatorsByHeight), arg0, arg1)
+}
+
+// GetEpochSummaryByHeight mocks base method
+func (m *MockClient) GetEpochSummaryByHeight(arg0 context.Context, arg1 int64) (*figmentclient.EpochSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochSummaryByHeight", arg0, arg1)
+	ret0, _ := ret[0].(*figmentclient.EpochSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochSummaryByHeight indicates an expected call of GetEpochSummaryByHeight
+func (mr *MockClientMockRecorder) GetEpochSummaryByHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochSummaryByHeight", reflect.TypeOf((*MockClient)(nil).GetEpochSummaryByHeight), arg0, arg1)
+}
+
 // GetIdentityByHeight mocks base method
 func (m *MockClient) GetIdentityByHeight(arg0 context.Context, arg1 string, arg2 int64) (*figmentclient.Identity, error) {
 	m.ctrl.T.Helper()
@@ -195,6 +227,21 @@ func (mr *MockClientMockRecorder) GetValidatorGroupsByHeight(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorGroupsByHeight", reflect.TypeOf((*MockClient)(nil).GetValidatorGroupsByHeight), arg0, arg1)
 }
 
+// GetValidatorSignersByHeight mocks base method
+func (m *MockClient) GetValidatorSignersByHeight(arg0 context.Context, arg1 int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSignersByHeight", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorSignersByHeight indicates an expected call of GetValidatorSignersByHeight
+func (mr *MockClientMockRecorder) GetValidatorSignersByHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSignersByHeight", reflect.TypeOf((*MockClient)(nil).GetValidatorSignersByHeight), arg0, arg1)
+}
+
 // GetValidatorsByHeight mocks base method
 func (m *MockClient) GetValidatorsByHeight(arg0 context.Context, arg1 int64) ([]*figmentclient.Validator, error) {
 	m.ctrl.T.Helper()
@@ -210,6 +257,51 @@ func (mr *MockClientMockRecorder) GetValidatorsByHeight(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorsByHeight", reflect.TypeOf((*MockClient)(nil).GetValidatorsByHeight), arg0, arg1)
 }
 
+// GetVoterRewardsByHeight mocks base method
+func (m *MockClient) GetVoterRewardsByHeight(arg0 context.Context, arg1 int64, arg2 []string) ([]*figmentclient.VoterReward, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoterRewardsByHeight", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*figmentclient.VoterReward)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoterRewardsByHeight indicates an expected call of GetVoterRewardsByHeight
+func (mr *MockClientMockRecorder) GetVoterRewardsByHeight(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoterRewardsByHeight", reflect.TypeOf((*MockClient)(nil).GetVoterRewardsByHeight), arg0, arg1, arg2)
+}
+
+// GetVotersByHeight mocks base method
+func (m *MockClient) GetVotersByHeight(arg0 context.Context, arg1 int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVotersByHeight", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVotersByHeight indicates an expected call of GetVotersByHeight
+func (mr *MockClientMockRecorder) GetVotersByHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVotersByHeight", reflect.TypeOf((*MockClient)(nil).GetVotersByHeight), arg0, arg1)
+}
+
+// SubscribeNewHead mocks base method
+func (m *MockClient) SubscribeNewHead(arg0 context.Context, arg1 chan<- *types.Header) (ethereum.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewHead", arg0, arg1)
+	ret0, _ := ret[0].(ethereum.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewHead indicates an expected call of SubscribeNewHead
+func (mr *MockClientMockRecorder) SubscribeNewHead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewHead", reflect.TypeOf((*MockClient)(nil).SubscribeNewHead), arg0, arg1)
+}
+
 // WithAssignedNode mocks base method
 func (m *MockClient) WithAssignedNode(arg0 byte) figmentclient.Client {
 	m.ctrl.T.Helper()
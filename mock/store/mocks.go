@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/celo-indexer/store (interfaces: AccountActivitySeq,BlockSeq,BlockSummary,Database,Reports,Syncables,SystemEvents,ValidatorAgg,ValidatorSeq,ValidatorSummary,ValidatorGroupAgg,ValidatorGroupSeq,ValidatorGroupSummary)
+// Source: github.com/figment-networks/celo-indexer/store (interfaces: AccountActivitySeq,BlockSeq,BlockSummary,Database,Reports,Syncables,SystemEvents,ValidatorAgg,ValidatorSeq,ValidatorSummary,ValidatorGroupAgg,ValidatorGroupSeq,ValidatorGroupSummary,TransactionSeq,EpochSummary,ElectedValidatorSeq,VoterRewardSeq,VoterAgg,WebhookSubscriptions,WebhookDeliveries,ProposalAgg,GovernanceActivitySeq)
 
 // Package mock_store is a generated GoMock package.
 package mock_store
@@ -50,6 +50,21 @@ func (mr *MockAccountActivitySeqMockRecorder) BulkUpsert(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockAccountActivitySeq)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAboveHeight mocks base method
+func (m *MockAccountActivitySeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockAccountActivitySeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockAccountActivitySeq)(nil).DeleteAboveHeight), arg0)
+}
+
 // DeleteForHeight mocks base method
 func (m *MockAccountActivitySeq) DeleteForHeight(arg0 int64) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,21 @@ func (mr *MockAccountActivitySeqMockRecorder) DeleteForHeight(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeight", reflect.TypeOf((*MockAccountActivitySeq)(nil).DeleteForHeight), arg0)
 }
 
+// DeleteForHeightRange mocks base method
+func (m *MockAccountActivitySeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockAccountActivitySeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockAccountActivitySeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
 // DeleteOlderThan mocks base method
 func (m *MockAccountActivitySeq) DeleteOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +110,22 @@ func (mr *MockAccountActivitySeqMockRecorder) DeleteOlderThan(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockAccountActivitySeq)(nil).DeleteOlderThan), arg0)
 }
 
+// FindByAddress mocks base method
+func (m *MockAccountActivitySeq) FindByAddress(arg0 string, arg1 store.AddressHistoryQuery) ([]model.AccountActivitySeq, *store.AddressHistoryCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddress", arg0, arg1)
+	ret0, _ := ret[0].([]model.AccountActivitySeq)
+	ret1, _ := ret[1].(*store.AddressHistoryCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByAddress indicates an expected call of FindByAddress
+func (mr *MockAccountActivitySeqMockRecorder) FindByAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddress", reflect.TypeOf((*MockAccountActivitySeq)(nil).FindByAddress), arg0, arg1)
+}
+
 // FindByHeight mocks base method
 func (m *MockAccountActivitySeq) FindByHeight(arg0 int64) ([]model.AccountActivitySeq, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +201,22 @@ func (mr *MockAccountActivitySeqMockRecorder) FindMostRecent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecent", reflect.TypeOf((*MockAccountActivitySeq)(nil).FindMostRecent))
 }
 
+// FindTransfers mocks base method
+func (m *MockAccountActivitySeq) FindTransfers(arg0, arg1 string, arg2 store.AddressHistoryQuery) ([]model.AccountActivitySeq, *store.AddressHistoryCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransfers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.AccountActivitySeq)
+	ret1, _ := ret[1].(*store.AddressHistoryCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTransfers indicates an expected call of FindTransfers
+func (mr *MockAccountActivitySeqMockRecorder) FindTransfers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransfers", reflect.TypeOf((*MockAccountActivitySeq)(nil).FindTransfers), arg0, arg1, arg2)
+}
+
 // MockBlockSeq is a mock of BlockSeq interface
 type MockBlockSeq struct {
 	ctrl     *gomock.Controller
@@ -206,6 +268,36 @@ func (mr *MockBlockSeqMockRecorder) CreateIfNotExists(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockBlockSeq)(nil).CreateIfNotExists), arg0)
 }
 
+// DeleteAboveHeight mocks base method
+func (m *MockBlockSeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockBlockSeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockBlockSeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockBlockSeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockBlockSeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockBlockSeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
 // DeleteOlderThan mocks base method
 func (m *MockBlockSeq) DeleteOlderThan(arg0 time.Time, arg1 []store.ActivityPeriodRow) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -631,6 +723,21 @@ func (mr *MockSyncablesMockRecorder) CreateOrUpdate(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockSyncables)(nil).CreateOrUpdate), arg0)
 }
 
+// DeleteAboveHeight mocks base method
+func (m *MockSyncables) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockSyncablesMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockSyncables)(nil).DeleteAboveHeight), arg0)
+}
+
 // FindByHeight mocks base method
 func (m *MockSyncables) FindByHeight(arg0 int64) (*model.Syncable, error) {
 	m.ctrl.T.Helper()
@@ -661,6 +768,21 @@ func (mr *MockSyncablesMockRecorder) FindFirstByDifferentIndexVersion(arg0 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFirstByDifferentIndexVersion", reflect.TypeOf((*MockSyncables)(nil).FindFirstByDifferentIndexVersion), arg0)
 }
 
+// FindGaps mocks base method
+func (m *MockSyncables) FindGaps(arg0 int64) ([]store.SyncableGap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGaps", arg0)
+	ret0, _ := ret[0].([]store.SyncableGap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGaps indicates an expected call of FindGaps
+func (mr *MockSyncablesMockRecorder) FindGaps(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGaps", reflect.TypeOf((*MockSyncables)(nil).FindGaps), arg0)
+}
+
 // FindLastInEpoch mocks base method
 func (m *MockSyncables) FindLastInEpoch(arg0 int64) (*model.Syncable, error) {
 	m.ctrl.T.Helper()
@@ -801,6 +923,36 @@ func (mr *MockSystemEventsMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockSystemEvents)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAboveHeight mocks base method
+func (m *MockSystemEvents) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockSystemEventsMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockSystemEvents)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockSystemEvents) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockSystemEventsMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockSystemEvents)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
 // DeleteOlderThan mocks base method
 func (m *MockSystemEvents) DeleteOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -816,34 +968,34 @@ func (mr *MockSystemEventsMockRecorder) DeleteOlderThan(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockSystemEvents)(nil).DeleteOlderThan), arg0)
 }
 
-// FindByActor mocks base method
-func (m *MockSystemEvents) FindByActor(arg0 string, arg1 store.FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
+// FindAll mocks base method
+func (m *MockSystemEvents) FindAll(arg0 store.FindAll) ([]model.SystemEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1)
+	ret := m.ctrl.Call(m, "FindAll", arg0)
 	ret0, _ := ret[0].([]model.SystemEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByActor indicates an expected call of FindByActor
-func (mr *MockSystemEventsMockRecorder) FindByActor(arg0, arg1 interface{}) *gomock.Call {
+// FindAll indicates an expected call of FindAll
+func (mr *MockSystemEventsMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActor", reflect.TypeOf((*MockSystemEvents)(nil).FindByActor), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSystemEvents)(nil).FindAll), arg0)
 }
 
-// FindAll mocks base method
-func (m *MockSystemEvents) FindAll(arg0 string, arg1 store.FindAll) ([]model.SystemEvent, error) {
+// FindByActor mocks base method
+func (m *MockSystemEvents) FindByActor(arg0 string, arg1 store.FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1)
 	ret0, _ := ret[0].([]model.SystemEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAll indicates an expected call of FindAll
-func (mr *MockSystemEventsMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+// FindByActor indicates an expected call of FindByActor
+func (mr *MockSystemEventsMockRecorder) FindByActor(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSystemEvents)(nil).FindByActor), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActor", reflect.TypeOf((*MockSystemEvents)(nil).FindByActor), arg0, arg1)
 }
 
 // FindByHeight mocks base method
@@ -943,6 +1095,21 @@ func (mr *MockValidatorAggMockRecorder) Create(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockValidatorAgg)(nil).Create), arg0)
 }
 
+// DeleteStartedAboveHeight mocks base method
+func (m *MockValidatorAgg) DeleteStartedAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStartedAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStartedAboveHeight indicates an expected call of DeleteStartedAboveHeight
+func (mr *MockValidatorAggMockRecorder) DeleteStartedAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStartedAboveHeight", reflect.TypeOf((*MockValidatorAgg)(nil).DeleteStartedAboveHeight), arg0)
+}
+
 // FindBy mocks base method
 func (m *MockValidatorAgg) FindBy(arg0 string, arg1 interface{}) (*model.ValidatorAgg, error) {
 	m.ctrl.T.Helper()
@@ -1003,6 +1170,20 @@ func (mr *MockValidatorAggMockRecorder) GetAllForHeightGreaterThan(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllForHeightGreaterThan", reflect.TypeOf((*MockValidatorAgg)(nil).GetAllForHeightGreaterThan), arg0)
 }
 
+// RollbackToHeight mocks base method
+func (m *MockValidatorAgg) RollbackToHeight(arg0 int64, arg1 types.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToHeight", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToHeight indicates an expected call of RollbackToHeight
+func (mr *MockValidatorAggMockRecorder) RollbackToHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToHeight", reflect.TypeOf((*MockValidatorAgg)(nil).RollbackToHeight), arg0, arg1)
+}
+
 // Save mocks base method
 func (m *MockValidatorAgg) Save(arg0 *model.ValidatorAgg) error {
 	m.ctrl.T.Helper()
@@ -1054,6 +1235,36 @@ func (mr *MockValidatorSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockValidatorSeq)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAboveHeight mocks base method
+func (m *MockValidatorSeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockValidatorSeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockValidatorSeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockValidatorSeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockValidatorSeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockValidatorSeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
 // DeleteOlderThan mocks base method
 func (m *MockValidatorSeq) DeleteOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -1352,6 +1563,21 @@ func (mr *MockValidatorGroupAggMockRecorder) CreateOrUpdate(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockValidatorGroupAgg)(nil).CreateOrUpdate), arg0)
 }
 
+// DeleteStartedAboveHeight mocks base method
+func (m *MockValidatorGroupAgg) DeleteStartedAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStartedAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStartedAboveHeight indicates an expected call of DeleteStartedAboveHeight
+func (mr *MockValidatorGroupAggMockRecorder) DeleteStartedAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStartedAboveHeight", reflect.TypeOf((*MockValidatorGroupAgg)(nil).DeleteStartedAboveHeight), arg0)
+}
+
 // FindBy mocks base method
 func (m *MockValidatorGroupAgg) FindBy(arg0 string, arg1 interface{}) (*model.ValidatorGroupAgg, error) {
 	m.ctrl.T.Helper()
@@ -1397,6 +1623,20 @@ func (mr *MockValidatorGroupAggMockRecorder) FindByID(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockValidatorGroupAgg)(nil).FindByID), arg0)
 }
 
+// RollbackToHeight mocks base method
+func (m *MockValidatorGroupAgg) RollbackToHeight(arg0 int64, arg1 types.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToHeight", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToHeight indicates an expected call of RollbackToHeight
+func (mr *MockValidatorGroupAggMockRecorder) RollbackToHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToHeight", reflect.TypeOf((*MockValidatorGroupAgg)(nil).RollbackToHeight), arg0, arg1)
+}
+
 // Save mocks base method
 func (m *MockValidatorGroupAgg) Save(arg0 *model.ValidatorGroupAgg) error {
 	m.ctrl.T.Helper()
@@ -1448,6 +1688,36 @@ func (mr *MockValidatorGroupSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockValidatorGroupSeq)(nil).BulkUpsert), arg0)
 }
 
+// DeleteAboveHeight mocks base method
+func (m *MockValidatorGroupSeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockValidatorGroupSeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockValidatorGroupSeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockValidatorGroupSeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockValidatorGroupSeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockValidatorGroupSeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
 // DeleteOlderThan mocks base method
 func (m *MockValidatorGroupSeq) DeleteOlderThan(arg0 time.Time) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -1679,3 +1949,1113 @@ func (mr *MockValidatorGroupSummaryMockRecorder) FindSummaryByAddress(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummaryByAddress", reflect.TypeOf((*MockValidatorGroupSummary)(nil).FindSummaryByAddress), arg0, arg1, arg2)
 }
+
+// MockTransactionSeq is a mock of TransactionSeq interface
+type MockTransactionSeq struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionSeqMockRecorder
+}
+
+// MockTransactionSeqMockRecorder is the mock recorder for MockTransactionSeq
+type MockTransactionSeqMockRecorder struct {
+	mock *MockTransactionSeq
+}
+
+// NewMockTransactionSeq creates a new mock instance
+func NewMockTransactionSeq(ctrl *gomock.Controller) *MockTransactionSeq {
+	mock := &MockTransactionSeq{ctrl: ctrl}
+	mock.recorder = &MockTransactionSeqMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactionSeq) EXPECT() *MockTransactionSeqMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockTransactionSeq) BulkUpsert(arg0 []model.TransactionSeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockTransactionSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockTransactionSeq)(nil).BulkUpsert), arg0)
+}
+
+// DeleteAboveHeight mocks base method
+func (m *MockTransactionSeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockTransactionSeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockTransactionSeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeight mocks base method
+func (m *MockTransactionSeq) DeleteForHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeight indicates an expected call of DeleteForHeight
+func (mr *MockTransactionSeqMockRecorder) DeleteForHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeight", reflect.TypeOf((*MockTransactionSeq)(nil).DeleteForHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockTransactionSeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockTransactionSeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockTransactionSeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
+// DeleteOlderThan mocks base method
+func (m *MockTransactionSeq) DeleteOlderThan(arg0 time.Time) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOlderThan indicates an expected call of DeleteOlderThan
+func (mr *MockTransactionSeqMockRecorder) DeleteOlderThan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockTransactionSeq)(nil).DeleteOlderThan), arg0)
+}
+
+// FindByAddress mocks base method
+func (m *MockTransactionSeq) FindByAddress(arg0 string, arg1 store.AddressHistoryQuery) ([]model.TransactionSeq, *store.AddressHistoryCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddress", arg0, arg1)
+	ret0, _ := ret[0].([]model.TransactionSeq)
+	ret1, _ := ret[1].(*store.AddressHistoryCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByAddress indicates an expected call of FindByAddress
+func (mr *MockTransactionSeqMockRecorder) FindByAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddress", reflect.TypeOf((*MockTransactionSeq)(nil).FindByAddress), arg0, arg1)
+}
+
+// FindByHash mocks base method
+func (m *MockTransactionSeq) FindByHash(arg0 string) (*model.TransactionSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", arg0)
+	ret0, _ := ret[0].(*model.TransactionSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash
+func (mr *MockTransactionSeqMockRecorder) FindByHash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockTransactionSeq)(nil).FindByHash), arg0)
+}
+
+// FindByHeight mocks base method
+func (m *MockTransactionSeq) FindByHeight(arg0 int64) ([]model.TransactionSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].([]model.TransactionSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockTransactionSeqMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockTransactionSeq)(nil).FindByHeight), arg0)
+}
+
+// MockEpochSummary is a mock of EpochSummary interface
+type MockEpochSummary struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochSummaryMockRecorder
+}
+
+// MockEpochSummaryMockRecorder is the mock recorder for MockEpochSummary
+type MockEpochSummaryMockRecorder struct {
+	mock *MockEpochSummary
+}
+
+// NewMockEpochSummary creates a new mock instance
+func NewMockEpochSummary(ctrl *gomock.Controller) *MockEpochSummary {
+	mock := &MockEpochSummary{ctrl: ctrl}
+	mock.recorder = &MockEpochSummaryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEpochSummary) EXPECT() *MockEpochSummaryMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method
+func (m *MockEpochSummary) All(arg0 int64, arg1 *int64) ([]model.EpochSummary, *int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", arg0, arg1)
+	ret0, _ := ret[0].([]model.EpochSummary)
+	ret1, _ := ret[1].(*int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// All indicates an expected call of All
+func (mr *MockEpochSummaryMockRecorder) All(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockEpochSummary)(nil).All), arg0, arg1)
+}
+
+// BulkUpsert mocks base method
+func (m *MockEpochSummary) BulkUpsert(arg0 []model.EpochSummary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockEpochSummaryMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockEpochSummary)(nil).BulkUpsert), arg0)
+}
+
+// DeleteAboveHeight mocks base method
+func (m *MockEpochSummary) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockEpochSummaryMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockEpochSummary)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockEpochSummary) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockEpochSummaryMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockEpochSummary)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
+// FindByEpoch mocks base method
+func (m *MockEpochSummary) FindByEpoch(arg0 int64) (*model.EpochSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEpoch", arg0)
+	ret0, _ := ret[0].(*model.EpochSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEpoch indicates an expected call of FindByEpoch
+func (mr *MockEpochSummaryMockRecorder) FindByEpoch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEpoch", reflect.TypeOf((*MockEpochSummary)(nil).FindByEpoch), arg0)
+}
+
+// MockElectedValidatorSeq is a mock of ElectedValidatorSeq interface
+type MockElectedValidatorSeq struct {
+	ctrl     *gomock.Controller
+	recorder *MockElectedValidatorSeqMockRecorder
+}
+
+// MockElectedValidatorSeqMockRecorder is the mock recorder for MockElectedValidatorSeq
+type MockElectedValidatorSeqMockRecorder struct {
+	mock *MockElectedValidatorSeq
+}
+
+// NewMockElectedValidatorSeq creates a new mock instance
+func NewMockElectedValidatorSeq(ctrl *gomock.Controller) *MockElectedValidatorSeq {
+	mock := &MockElectedValidatorSeq{ctrl: ctrl}
+	mock.recorder = &MockElectedValidatorSeqMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockElectedValidatorSeq) EXPECT() *MockElectedValidatorSeqMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockElectedValidatorSeq) BulkUpsert(arg0 []model.ElectedValidatorSeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockElectedValidatorSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockElectedValidatorSeq)(nil).BulkUpsert), arg0)
+}
+
+// DeleteAboveHeight mocks base method
+func (m *MockElectedValidatorSeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockElectedValidatorSeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockElectedValidatorSeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeight mocks base method
+func (m *MockElectedValidatorSeq) DeleteForHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeight indicates an expected call of DeleteForHeight
+func (mr *MockElectedValidatorSeqMockRecorder) DeleteForHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeight", reflect.TypeOf((*MockElectedValidatorSeq)(nil).DeleteForHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockElectedValidatorSeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockElectedValidatorSeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockElectedValidatorSeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
+// FindByEpoch mocks base method
+func (m *MockElectedValidatorSeq) FindByEpoch(arg0 int64) ([]model.ElectedValidatorSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEpoch", arg0)
+	ret0, _ := ret[0].([]model.ElectedValidatorSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEpoch indicates an expected call of FindByEpoch
+func (mr *MockElectedValidatorSeqMockRecorder) FindByEpoch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEpoch", reflect.TypeOf((*MockElectedValidatorSeq)(nil).FindByEpoch), arg0)
+}
+
+// MockVoterRewardSeq is a mock of VoterRewardSeq interface
+type MockVoterRewardSeq struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoterRewardSeqMockRecorder
+}
+
+// MockVoterRewardSeqMockRecorder is the mock recorder for MockVoterRewardSeq
+type MockVoterRewardSeqMockRecorder struct {
+	mock *MockVoterRewardSeq
+}
+
+// NewMockVoterRewardSeq creates a new mock instance
+func NewMockVoterRewardSeq(ctrl *gomock.Controller) *MockVoterRewardSeq {
+	mock := &MockVoterRewardSeq{ctrl: ctrl}
+	mock.recorder = &MockVoterRewardSeqMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVoterRewardSeq) EXPECT() *MockVoterRewardSeqMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockVoterRewardSeq) BulkUpsert(arg0 []model.VoterRewardSeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockVoterRewardSeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockVoterRewardSeq)(nil).BulkUpsert), arg0)
+}
+
+// DeleteAboveHeight mocks base method
+func (m *MockVoterRewardSeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockVoterRewardSeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockVoterRewardSeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeight mocks base method
+func (m *MockVoterRewardSeq) DeleteForHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeight indicates an expected call of DeleteForHeight
+func (mr *MockVoterRewardSeqMockRecorder) DeleteForHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeight", reflect.TypeOf((*MockVoterRewardSeq)(nil).DeleteForHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockVoterRewardSeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockVoterRewardSeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockVoterRewardSeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
+// FindByAddress mocks base method
+func (m *MockVoterRewardSeq) FindByAddress(arg0 string, arg1 store.AddressHistoryQuery) ([]model.VoterRewardSeq, *store.AddressHistoryCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddress", arg0, arg1)
+	ret0, _ := ret[0].([]model.VoterRewardSeq)
+	ret1, _ := ret[1].(*store.AddressHistoryCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByAddress indicates an expected call of FindByAddress
+func (mr *MockVoterRewardSeqMockRecorder) FindByAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddress", reflect.TypeOf((*MockVoterRewardSeq)(nil).FindByAddress), arg0, arg1)
+}
+
+// FindByHeight mocks base method
+func (m *MockVoterRewardSeq) FindByHeight(arg0 int64) ([]model.VoterRewardSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].([]model.VoterRewardSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockVoterRewardSeqMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockVoterRewardSeq)(nil).FindByHeight), arg0)
+}
+
+// MockVoterAgg is a mock of VoterAgg interface
+type MockVoterAgg struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoterAggMockRecorder
+}
+
+// MockVoterAggMockRecorder is the mock recorder for MockVoterAgg
+type MockVoterAggMockRecorder struct {
+	mock *MockVoterAgg
+}
+
+// NewMockVoterAgg creates a new mock instance
+func NewMockVoterAgg(ctrl *gomock.Controller) *MockVoterAgg {
+	mock := &MockVoterAgg{ctrl: ctrl}
+	mock.recorder = &MockVoterAggMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVoterAgg) EXPECT() *MockVoterAggMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockVoterAgg) Create(arg0 *model.VoterAgg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockVoterAggMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVoterAgg)(nil).Create), arg0)
+}
+
+// DeleteStartedAboveHeight mocks base method
+func (m *MockVoterAgg) DeleteStartedAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStartedAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStartedAboveHeight indicates an expected call of DeleteStartedAboveHeight
+func (mr *MockVoterAggMockRecorder) DeleteStartedAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStartedAboveHeight", reflect.TypeOf((*MockVoterAgg)(nil).DeleteStartedAboveHeight), arg0)
+}
+
+// FindByAddress mocks base method
+func (m *MockVoterAgg) FindByAddress(arg0 string) ([]model.VoterAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddress", arg0)
+	ret0, _ := ret[0].([]model.VoterAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAddress indicates an expected call of FindByAddress
+func (mr *MockVoterAggMockRecorder) FindByAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddress", reflect.TypeOf((*MockVoterAgg)(nil).FindByAddress), arg0)
+}
+
+// FindByAddressAndGroup mocks base method
+func (m *MockVoterAgg) FindByAddressAndGroup(arg0, arg1 string) (*model.VoterAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddressAndGroup", arg0, arg1)
+	ret0, _ := ret[0].(*model.VoterAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAddressAndGroup indicates an expected call of FindByAddressAndGroup
+func (mr *MockVoterAggMockRecorder) FindByAddressAndGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddressAndGroup", reflect.TypeOf((*MockVoterAgg)(nil).FindByAddressAndGroup), arg0, arg1)
+}
+
+// FindTopByGroup mocks base method
+func (m *MockVoterAgg) FindTopByGroup(arg0 string, arg1 int64) ([]model.VoterAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTopByGroup", arg0, arg1)
+	ret0, _ := ret[0].([]model.VoterAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTopByGroup indicates an expected call of FindTopByGroup
+func (mr *MockVoterAggMockRecorder) FindTopByGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopByGroup", reflect.TypeOf((*MockVoterAgg)(nil).FindTopByGroup), arg0, arg1)
+}
+
+// RollbackToHeight mocks base method
+func (m *MockVoterAgg) RollbackToHeight(arg0 int64, arg1 types.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToHeight", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToHeight indicates an expected call of RollbackToHeight
+func (mr *MockVoterAggMockRecorder) RollbackToHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToHeight", reflect.TypeOf((*MockVoterAgg)(nil).RollbackToHeight), arg0, arg1)
+}
+
+// Save mocks base method
+func (m *MockVoterAgg) Save(arg0 *model.VoterAgg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockVoterAggMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockVoterAgg)(nil).Save), arg0)
+}
+
+// MockWebhookSubscriptions is a mock of WebhookSubscriptions interface
+type MockWebhookSubscriptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionsMockRecorder
+}
+
+// MockWebhookSubscriptionsMockRecorder is the mock recorder for MockWebhookSubscriptions
+type MockWebhookSubscriptionsMockRecorder struct {
+	mock *MockWebhookSubscriptions
+}
+
+// NewMockWebhookSubscriptions creates a new mock instance
+func NewMockWebhookSubscriptions(ctrl *gomock.Controller) *MockWebhookSubscriptions {
+	mock := &MockWebhookSubscriptions{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookSubscriptions) EXPECT() *MockWebhookSubscriptionsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhookSubscriptions) Create(arg0 *model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookSubscriptionsMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptions)(nil).Create), arg0)
+}
+
+// DeleteByID mocks base method
+func (m *MockWebhookSubscriptions) DeleteByID(arg0 types.ID) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByID indicates an expected call of DeleteByID
+func (mr *MockWebhookSubscriptionsMockRecorder) DeleteByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockWebhookSubscriptions)(nil).DeleteByID), arg0)
+}
+
+// FindAll mocks base method
+func (m *MockWebhookSubscriptions) FindAll() ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockWebhookSubscriptionsMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookSubscriptions)(nil).FindAll))
+}
+
+// FindByID mocks base method
+func (m *MockWebhookSubscriptions) FindByID(arg0 types.ID) (*model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret0, _ := ret[0].(*model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockWebhookSubscriptionsMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookSubscriptions)(nil).FindByID), arg0)
+}
+
+// MockWebhookDeliveries is a mock of WebhookDeliveries interface
+type MockWebhookDeliveries struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveriesMockRecorder
+}
+
+// MockWebhookDeliveriesMockRecorder is the mock recorder for MockWebhookDeliveries
+type MockWebhookDeliveriesMockRecorder struct {
+	mock *MockWebhookDeliveries
+}
+
+// NewMockWebhookDeliveries creates a new mock instance
+func NewMockWebhookDeliveries(ctrl *gomock.Controller) *MockWebhookDeliveries {
+	mock := &MockWebhookDeliveries{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveries) EXPECT() *MockWebhookDeliveriesMockRecorder {
+	return m.recorder
+}
+
+// BulkCreateIfNotExists mocks base method
+func (m *MockWebhookDeliveries) BulkCreateIfNotExists(arg0 []model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateIfNotExists", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkCreateIfNotExists indicates an expected call of BulkCreateIfNotExists
+func (mr *MockWebhookDeliveriesMockRecorder) BulkCreateIfNotExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateIfNotExists", reflect.TypeOf((*MockWebhookDeliveries)(nil).BulkCreateIfNotExists), arg0)
+}
+
+// Create mocks base method
+func (m *MockWebhookDeliveries) Create(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookDeliveriesMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveries)(nil).Create), arg0)
+}
+
+// FindBySubscriptionID mocks base method
+func (m *MockWebhookDeliveries) FindBySubscriptionID(arg0 types.ID, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySubscriptionID", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySubscriptionID indicates an expected call of FindBySubscriptionID
+func (mr *MockWebhookDeliveriesMockRecorder) FindBySubscriptionID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySubscriptionID", reflect.TypeOf((*MockWebhookDeliveries)(nil).FindBySubscriptionID), arg0, arg1)
+}
+
+// FindPending mocks base method
+func (m *MockWebhookDeliveries) FindPending(arg0 time.Time, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending
+func (mr *MockWebhookDeliveriesMockRecorder) FindPending(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockWebhookDeliveries)(nil).FindPending), arg0, arg1)
+}
+
+// Save mocks base method
+func (m *MockWebhookDeliveries) Save(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockWebhookDeliveriesMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookDeliveries)(nil).Save), arg0)
+}
+
+// MockProposalAgg is a mock of ProposalAgg interface
+type MockProposalAgg struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalAggMockRecorder
+}
+
+// MockProposalAggMockRecorder is the mock recorder for MockProposalAgg
+type MockProposalAggMockRecorder struct {
+	mock *MockProposalAgg
+}
+
+// NewMockProposalAgg creates a new mock instance
+func NewMockProposalAgg(ctrl *gomock.Controller) *MockProposalAgg {
+	mock := &MockProposalAgg{ctrl: ctrl}
+	mock.recorder = &MockProposalAggMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProposalAgg) EXPECT() *MockProposalAggMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method
+func (m *MockProposalAgg) All(arg0 int64, arg1 *int64) ([]model.ProposalAgg, *int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", arg0, arg1)
+	ret0, _ := ret[0].([]model.ProposalAgg)
+	ret1, _ := ret[1].(*int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// All indicates an expected call of All
+func (mr *MockProposalAggMockRecorder) All(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockProposalAgg)(nil).All), arg0, arg1)
+}
+
+// Create mocks base method
+func (m *MockProposalAgg) Create(arg0 *model.ProposalAgg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockProposalAggMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProposalAgg)(nil).Create), arg0)
+}
+
+// CreateOrUpdate mocks base method
+func (m *MockProposalAgg) CreateOrUpdate(arg0 *model.ProposalAgg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate
+func (mr *MockProposalAggMockRecorder) CreateOrUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockProposalAgg)(nil).CreateOrUpdate), arg0)
+}
+
+// DeleteStartedAboveHeight mocks base method
+func (m *MockProposalAgg) DeleteStartedAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStartedAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStartedAboveHeight indicates an expected call of DeleteStartedAboveHeight
+func (mr *MockProposalAggMockRecorder) DeleteStartedAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStartedAboveHeight", reflect.TypeOf((*MockProposalAgg)(nil).DeleteStartedAboveHeight), arg0)
+}
+
+// FindBy mocks base method
+func (m *MockProposalAgg) FindBy(arg0 string, arg1 interface{}) (*model.ProposalAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBy", arg0, arg1)
+	ret0, _ := ret[0].(*model.ProposalAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBy indicates an expected call of FindBy
+func (mr *MockProposalAggMockRecorder) FindBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBy", reflect.TypeOf((*MockProposalAgg)(nil).FindBy), arg0, arg1)
+}
+
+// FindByID mocks base method
+func (m *MockProposalAgg) FindByID(arg0 int64) (*model.ProposalAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret0, _ := ret[0].(*model.ProposalAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockProposalAggMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockProposalAgg)(nil).FindByID), arg0)
+}
+
+// FindByProposalId mocks base method
+func (m *MockProposalAgg) FindByProposalId(arg0 uint64) (*model.ProposalAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProposalId", arg0)
+	ret0, _ := ret[0].(*model.ProposalAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProposalId indicates an expected call of FindByProposalId
+func (mr *MockProposalAggMockRecorder) FindByProposalId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProposalId", reflect.TypeOf((*MockProposalAgg)(nil).FindByProposalId), arg0)
+}
+
+// RollbackToHeight mocks base method
+func (m *MockProposalAgg) RollbackToHeight(arg0 int64, arg1 types.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToHeight", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToHeight indicates an expected call of RollbackToHeight
+func (mr *MockProposalAggMockRecorder) RollbackToHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToHeight", reflect.TypeOf((*MockProposalAgg)(nil).RollbackToHeight), arg0, arg1)
+}
+
+// Save mocks base method
+func (m *MockProposalAgg) Save(arg0 *model.ProposalAgg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockProposalAggMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockProposalAgg)(nil).Save), arg0)
+}
+
+// MockGovernanceActivitySeq is a mock of GovernanceActivitySeq interface
+type MockGovernanceActivitySeq struct {
+	ctrl     *gomock.Controller
+	recorder *MockGovernanceActivitySeqMockRecorder
+}
+
+// MockGovernanceActivitySeqMockRecorder is the mock recorder for MockGovernanceActivitySeq
+type MockGovernanceActivitySeqMockRecorder struct {
+	mock *MockGovernanceActivitySeq
+}
+
+// NewMockGovernanceActivitySeq creates a new mock instance
+func NewMockGovernanceActivitySeq(ctrl *gomock.Controller) *MockGovernanceActivitySeq {
+	mock := &MockGovernanceActivitySeq{ctrl: ctrl}
+	mock.recorder = &MockGovernanceActivitySeqMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGovernanceActivitySeq) EXPECT() *MockGovernanceActivitySeqMockRecorder {
+	return m.recorder
+}
+
+// BulkUpsert mocks base method
+func (m *MockGovernanceActivitySeq) BulkUpsert(arg0 []model.GovernanceActivitySeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpsert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkUpsert indicates an expected call of BulkUpsert
+func (mr *MockGovernanceActivitySeqMockRecorder) BulkUpsert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsert", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).BulkUpsert), arg0)
+}
+
+// CreateIfNotExists mocks base method
+func (m *MockGovernanceActivitySeq) CreateIfNotExists(arg0 *model.GovernanceActivitySeq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists
+func (mr *MockGovernanceActivitySeqMockRecorder) CreateIfNotExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).CreateIfNotExists), arg0)
+}
+
+// DeleteAboveHeight mocks base method
+func (m *MockGovernanceActivitySeq) DeleteAboveHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAboveHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAboveHeight indicates an expected call of DeleteAboveHeight
+func (mr *MockGovernanceActivitySeqMockRecorder) DeleteAboveHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAboveHeight", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).DeleteAboveHeight), arg0)
+}
+
+// DeleteForHeight mocks base method
+func (m *MockGovernanceActivitySeq) DeleteForHeight(arg0 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeight", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeight indicates an expected call of DeleteForHeight
+func (mr *MockGovernanceActivitySeqMockRecorder) DeleteForHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeight", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).DeleteForHeight), arg0)
+}
+
+// DeleteForHeightRange mocks base method
+func (m *MockGovernanceActivitySeq) DeleteForHeightRange(arg0, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForHeightRange", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteForHeightRange indicates an expected call of DeleteForHeightRange
+func (mr *MockGovernanceActivitySeqMockRecorder) DeleteForHeightRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForHeightRange", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).DeleteForHeightRange), arg0, arg1)
+}
+
+// DeleteOlderThan mocks base method
+func (m *MockGovernanceActivitySeq) DeleteOlderThan(arg0 time.Time) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOlderThan indicates an expected call of DeleteOlderThan
+func (mr *MockGovernanceActivitySeqMockRecorder) DeleteOlderThan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).DeleteOlderThan), arg0)
+}
+
+// FindByHeight mocks base method
+func (m *MockGovernanceActivitySeq) FindByHeight(arg0 int64) ([]model.GovernanceActivitySeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].([]model.GovernanceActivitySeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockGovernanceActivitySeqMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).FindByHeight), arg0)
+}
+
+// FindByHeightAndProposalId mocks base method
+func (m *MockGovernanceActivitySeq) FindByHeightAndProposalId(arg0 int64, arg1 uint64) ([]model.GovernanceActivitySeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeightAndProposalId", arg0, arg1)
+	ret0, _ := ret[0].([]model.GovernanceActivitySeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeightAndProposalId indicates an expected call of FindByHeightAndProposalId
+func (mr *MockGovernanceActivitySeqMockRecorder) FindByHeightAndProposalId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeightAndProposalId", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).FindByHeightAndProposalId), arg0, arg1)
+}
+
+// FindByProposalId mocks base method
+func (m *MockGovernanceActivitySeq) FindByProposalId(arg0 uint64, arg1 int64, arg2 *int64) ([]model.GovernanceActivitySeq, *int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProposalId", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.GovernanceActivitySeq)
+	ret1, _ := ret[1].(*int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByProposalId indicates an expected call of FindByProposalId
+func (mr *MockGovernanceActivitySeqMockRecorder) FindByProposalId(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProposalId", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).FindByProposalId), arg0, arg1, arg2)
+}
+
+// FindLastByProposalId mocks base method
+func (m *MockGovernanceActivitySeq) FindLastByProposalId(arg0 uint64, arg1 int64) ([]model.GovernanceActivitySeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastByProposalId", arg0, arg1)
+	ret0, _ := ret[0].([]model.GovernanceActivitySeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastByProposalId indicates an expected call of FindLastByProposalId
+func (mr *MockGovernanceActivitySeqMockRecorder) FindLastByProposalId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByProposalId", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).FindLastByProposalId), arg0, arg1)
+}
+
+// FindLastByProposalIdAndKind mocks base method
+func (m *MockGovernanceActivitySeq) FindLastByProposalIdAndKind(arg0 uint64, arg1 string, arg2 int64) ([]model.GovernanceActivitySeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastByProposalIdAndKind", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.GovernanceActivitySeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastByProposalIdAndKind indicates an expected call of FindLastByProposalIdAndKind
+func (mr *MockGovernanceActivitySeqMockRecorder) FindLastByProposalIdAndKind(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByProposalIdAndKind", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).FindLastByProposalIdAndKind), arg0, arg1, arg2)
+}
+
+// FindMostRecent mocks base method
+func (m *MockGovernanceActivitySeq) FindMostRecent() (*model.GovernanceActivitySeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMostRecent")
+	ret0, _ := ret[0].(*model.GovernanceActivitySeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMostRecent indicates an expected call of FindMostRecent
+func (mr *MockGovernanceActivitySeqMockRecorder) FindMostRecent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecent", reflect.TypeOf((*MockGovernanceActivitySeq)(nil).FindMostRecent))
+}
@@ -8,6 +8,8 @@ type AccountActivitySeq struct {
 
 	TransactionHash string         `json:"transaction_hash"`
 	Address         string         `json:"address"`
+	Counterparty    string         `json:"counterparty"`
+	Token           string         `json:"token"`
 	Amount          types.Quantity `json:"amount"`
 	Kind            string         `json:"kind"`
 	Data            types.Jsonb    `json:"data"`
@@ -17,9 +19,18 @@ func (AccountActivitySeq) TableName() string {
 	return "account_activity_sequences"
 }
 
+func (b *AccountActivitySeq) Equal(m AccountActivitySeq) bool {
+	return b.Sequence.Equal(*m.Sequence) &&
+		b.TransactionHash == m.TransactionHash &&
+		b.Address == m.Address &&
+		b.Kind == m.Kind
+}
+
 func (b *AccountActivitySeq) Update(m AccountActivitySeq) {
 	b.TransactionHash = m.TransactionHash
 	b.Address = m.Address
+	b.Counterparty = m.Counterparty
+	b.Token = m.Token
 	b.Amount = m.Amount
 	b.Kind = m.Kind
 	b.Data = m.Data
@@ -5,10 +5,15 @@ type BlockSeq struct {
 	*Sequence
 
 	// Indexed data
+	Hash            string  `json:"hash"`
+	ParentHash      string  `json:"parent_hash"`
 	TxCount         int     `json:"tx_count"`
 	Size            float64 `json:"size"`
 	GasUsed         uint64  `json:"gas_used"`
 	TotalDifficulty uint64  `json:"total_difficulty"`
+	Proposer        string  `json:"proposer"`
+	Round           uint64  `json:"round"`
+	SignersBitmap   string  `json:"signers_bitmap"`
 }
 
 func (BlockSeq) TableName() string {
@@ -24,8 +29,18 @@ func (b *BlockSeq) Equal(m BlockSeq) bool {
 }
 
 func (b *BlockSeq) Update(m BlockSeq) {
+	b.Hash = m.Hash
+	b.ParentHash = m.ParentHash
 	b.TxCount = m.TxCount
 	b.Size = m.Size
 	b.GasUsed = m.GasUsed
 	b.TotalDifficulty = m.TotalDifficulty
+	b.Proposer = m.Proposer
+	b.Round = m.Round
+	b.SignersBitmap = m.SignersBitmap
+}
+
+// IsSigner returns whether validator at given index of elected validators signed the block
+func (b *BlockSeq) IsSigner(index int64) bool {
+	return index >= 0 && index < int64(len(b.SignersBitmap)) && b.SignersBitmap[index] == '1'
 }
@@ -72,3 +72,11 @@ func (a *Summary) Equal(m Summary) bool {
 	return a.TimeInterval.Equal(m.TimeInterval) &&
 		a.TimeBucket.Equal(m.TimeBucket)
 }
+
+// nextMissedInRowCount returns number of consecutive missed blocks after next block
+func nextMissedInRowCount(count int64, missed bool) int64 {
+	if missed {
+		return count + 1
+	}
+	return 0
+}
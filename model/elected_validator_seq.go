@@ -0,0 +1,17 @@
+package model
+
+// ElectedValidatorSeq is validator elected for epoch captured at first height of epoch
+type ElectedValidatorSeq struct {
+	*Model
+	*Sequence
+
+	Epoch   int64  `json:"epoch"`
+	Address string `json:"address"`
+	Signer  string `json:"signer"`
+	Group   string `json:"group"`
+	Index   int64  `json:"index"`
+}
+
+func (ElectedValidatorSeq) TableName() string {
+	return "elected_validator_sequences"
+}
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/figment-networks/celo-indexer/types"
+
+// EpochSummary holds reward parameters and target rewards of epoch recorded at its last height
+type EpochSummary struct {
+	*Model
+	*Sequence
+
+	Epoch                  int64          `json:"epoch"`
+	TargetVotingYield      types.Quantity `json:"target_voting_yield"`
+	RewardsMultiplier      types.Quantity `json:"rewards_multiplier"`
+	ElectedValidatorsCount int64          `json:"elected_validators_count"`
+	ValidatorRewards       types.Quantity `json:"validator_rewards"`
+	VoterRewards           types.Quantity `json:"voter_rewards"`
+	CommunityFundRewards   types.Quantity `json:"community_fund_rewards"`
+	CarbonFundRewards      types.Quantity `json:"carbon_fund_rewards"`
+	TotalVotes             types.Quantity `json:"total_votes"`
+}
+
+func (EpochSummary) TableName() string {
+	return "epoch_summaries"
+}
@@ -9,6 +9,8 @@ const (
 	ReportKindIndex ReportKind = iota + 1
 	ReportKindParallelReindex
 	ReportKindSequentialReindex
+	ReportKindRangeReindex
+	ReportKindRepair
 )
 
 type Report struct {
@@ -22,6 +24,7 @@ type Report struct {
 	ErrorCount   *int64
 	ErrorMsg     *string
 	Duration     time.Duration
+	Throughput   float64
 	CompletedAt  *types.Time
 }
 
@@ -35,6 +38,10 @@ func (k ReportKind) String() string {
 		return "parallel_reindex"
 	case ReportKindSequentialReindex:
 		return "sequential_reindex"
+	case ReportKindRangeReindex:
+		return "range_reindex"
+	case ReportKindRepair:
+		return "repair"
 	default:
 		return "unknown"
 	}
@@ -62,6 +69,11 @@ func (r *Report) Complete(successCount int64, errorCount int64, err error) {
 	r.Duration = time.Since(r.CreatedAt.Time)
 	r.CompletedAt = completedAt
 
+	// Number of successfully processed heights per second
+	if r.Duration > 0 {
+		r.Throughput = float64(successCount) / r.Duration.Seconds()
+	}
+
 	if err != nil {
 		errMsg := err.Error()
 		r.ErrorMsg = &errMsg
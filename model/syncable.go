@@ -17,6 +17,8 @@ type Syncable struct {
 
 	Height      int64       `json:"height"`
 	Time        *types.Time `json:"time"`
+	Hash        string      `json:"hash"`
+	ParentHash  string      `json:"parent_hash"`
 	ChainId     uint64      `json:"chain_id"`
 	Epoch       *int64      `json:"epoch"`
 	LastInEpoch *bool       `json:"last_in_epoch"`
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/figment-networks/celo-indexer/types"
+import (
+	"strings"
+
+	"github.com/figment-networks/celo-indexer/types"
+)
 
 const (
 	SystemEventGroupRewardChange1 SystemEventKind = "group_reward_change_1"
@@ -10,14 +14,41 @@ const (
 	SystemEventLeftActiveSet      SystemEventKind = "left_active_set"
 	SystemEventMissedNConsecutive SystemEventKind = "missed_n_consecutive"
 	SystemEventMissedNofM         SystemEventKind = "missed_n_of_m"
+	SystemEventElected            SystemEventKind = "elected"
+	SystemEventUnelected          SystemEventKind = "unelected"
 )
 
 type SystemEventKind string
 
+// NewMissedNofMKind returns kind of system event created by named missed blocks rule
+func NewMissedNofMKind(ruleName string) SystemEventKind {
+	if ruleName == "" {
+		return SystemEventMissedNofM
+	}
+	return SystemEventKind(SystemEventMissedNofM.String() + "_" + ruleName)
+}
+
 func (o SystemEventKind) String() string {
 	return string(o)
 }
 
+func (o SystemEventKind) Valid() bool {
+	switch o {
+	case SystemEventGroupRewardChange1,
+		SystemEventGroupRewardChange2,
+		SystemEventGroupRewardChange3,
+		SystemEventJoinedActiveSet,
+		SystemEventLeftActiveSet,
+		SystemEventMissedNConsecutive,
+		SystemEventMissedNofM,
+		SystemEventElected,
+		SystemEventUnelected:
+		return true
+	default:
+		return strings.HasPrefix(o.String(), SystemEventMissedNofM.String()+"_")
+	}
+}
+
 type SystemEvent struct {
 	*Model
 
@@ -0,0 +1,26 @@
+package model
+
+import "github.com/figment-networks/celo-indexer/types"
+
+type TransactionSeq struct {
+	*Model
+	*Sequence
+
+	Hash                string         `json:"hash"`
+	Index               int64          `json:"index"`
+	FromAddress         string         `json:"from_address"`
+	ToAddress           string         `json:"to_address"`
+	Nonce               uint64         `json:"nonce"`
+	Gas                 uint64         `json:"gas"`
+	GasPrice            types.Quantity `json:"gas_price"`
+	GasUsed             uint64         `json:"gas_used"`
+	FeeCurrency         string         `json:"fee_currency"`
+	GatewayFee          types.Quantity `json:"gateway_fee"`
+	GatewayFeeRecipient string         `json:"gateway_fee_recipient"`
+	Success             bool           `json:"success"`
+	Operations          types.Jsonb    `json:"operations"`
+}
+
+func (TransactionSeq) TableName() string {
+	return "transaction_sequences"
+}
@@ -1,15 +1,20 @@
 package model
 
+import "github.com/figment-networks/celo-indexer/types"
+
 type ValidatorAgg struct {
 	*ModelWithTimestamps
 	*Aggregate
 
-	Address                 string `json:"address"`
-	RecentName              string `json:"recent_name"`
-	RecentMetadataUrl       string `json:"recent_metadata_url"`
-	RecentAsValidatorHeight int64  `json:"recent_as_validator_height"`
-	AccumulatedUptime       int64  `json:"accumulated_uptime"`
-	AccumulatedUptimeCount  int64  `json:"accumulated_uptime_count"`
+	Address                 string          `json:"address"`
+	RecentName              string          `json:"recent_name"`
+	RecentMetadataUrl       string          `json:"recent_metadata_url"`
+	RecentAsValidatorHeight int64           `json:"recent_as_validator_height"`
+	AccumulatedUptime       int64           `json:"accumulated_uptime"`
+	AccumulatedUptimeCount  int64           `json:"accumulated_uptime_count"`
+	RecentMissedBlocks      types.BitWindow `json:"recent_missed_blocks"`
+	MissedInRowCount        int64           `json:"missed_in_row_count"`
+	ProposedBlocksCount     int64           `json:"proposed_blocks_count"`
 }
 
 // - Methods
@@ -34,4 +39,13 @@ func (s *ValidatorAgg) Update(u *ValidatorAgg) {
 	s.RecentAsValidatorHeight = u.RecentAsValidatorHeight
 	s.AccumulatedUptime = u.AccumulatedUptime
 	s.AccumulatedUptimeCount = u.AccumulatedUptimeCount
+	s.RecentMissedBlocks = u.RecentMissedBlocks
+	s.MissedInRowCount = u.MissedInRowCount
+	s.ProposedBlocksCount = u.ProposedBlocksCount
+}
+
+// RecordRecentBlock records whether validator missed most recent block in window of given size
+func (s *ValidatorAgg) RecordRecentBlock(missed bool, windowSize int64) {
+	s.RecentMissedBlocks = s.RecentMissedBlocks.Push(missed, windowSize)
+	s.MissedInRowCount = nextMissedInRowCount(s.MissedInRowCount, missed)
 }
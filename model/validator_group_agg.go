@@ -1,14 +1,18 @@
 package model
 
+import "github.com/figment-networks/celo-indexer/types"
+
 type ValidatorGroupAgg struct {
 	*ModelWithTimestamps
 	*Aggregate
 
-	Address                string `json:"address"`
-	RecentName             string `json:"recent_name"`
-	RecentMetadataUrl      string `json:"recent_metadata_url"`
-	AccumulatedUptime      int64  `json:"accumulated_uptime"`
-	AccumulatedUptimeCount int64  `json:"accumulated_uptime_count"`
+	Address                string          `json:"address"`
+	RecentName             string          `json:"recent_name"`
+	RecentMetadataUrl      string          `json:"recent_metadata_url"`
+	AccumulatedUptime      int64           `json:"accumulated_uptime"`
+	AccumulatedUptimeCount int64           `json:"accumulated_uptime_count"`
+	RecentMissedBlocks     types.BitWindow `json:"recent_missed_blocks"`
+	MissedInRowCount       int64           `json:"missed_in_row_count"`
 }
 
 func (ValidatorGroupAgg) TableName() string {
@@ -31,4 +35,12 @@ func (s *ValidatorGroupAgg) Update(u *ValidatorGroupAgg) {
 	s.RecentMetadataUrl = u.RecentMetadataUrl
 	s.AccumulatedUptimeCount = s.AccumulatedUptimeCount + u.AccumulatedUptimeCount
 	s.AccumulatedUptime = s.AccumulatedUptime + u.AccumulatedUptime
+	s.RecentMissedBlocks = u.RecentMissedBlocks
+	s.MissedInRowCount = u.MissedInRowCount
+}
+
+// RecordRecentBlock records whether validator group missed most recent block in window of given size
+func (s *ValidatorGroupAgg) RecordRecentBlock(missed bool, windowSize int64) {
+	s.RecentMissedBlocks = s.RecentMissedBlocks.Push(missed, windowSize)
+	s.MissedInRowCount = nextMissedInRowCount(s.MissedInRowCount, missed)
 }
@@ -12,6 +12,7 @@ type ValidatorSeq struct {
 	Address     string         `json:"address"`
 	Affiliation string         `json:"affiliation"`
 	Signed      *bool          `json:"signed"`
+	Proposed    bool           `json:"proposed"`
 	Score       types.Quantity `json:"score"`
 
 	// Join fields
@@ -36,6 +37,7 @@ func (s *ValidatorSeq) Equal(m ValidatorSeq) bool {
 func (s *ValidatorSeq) Update(m ValidatorSeq) {
 	s.Affiliation = m.Affiliation
 	s.Signed = m.Signed
+	s.Proposed = m.Proposed
 	s.Score = m.Score
 }
 
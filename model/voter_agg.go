@@ -0,0 +1,55 @@
+package model
+
+import (
+	"math/big"
+
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+// VoterAgg is position of voter in validator group. Started and recent heights are heights of first vote and of most recent change
+type VoterAgg struct {
+	*ModelWithTimestamps
+	*Aggregate
+
+	Address      string         `json:"address"`
+	Group        string         `json:"group"`
+	PendingVotes types.Quantity `json:"pending_votes"`
+	ActiveVotes  types.Quantity `json:"active_votes"`
+}
+
+func (VoterAgg) TableName() string {
+	return "voter_aggregates"
+}
+
+func (s *VoterAgg) Valid() bool {
+	return s.Aggregate.Valid() &&
+		s.Address != "" &&
+		s.Group != ""
+}
+
+func (s *VoterAgg) Equal(m VoterAgg) bool {
+	return s.Address == m.Address &&
+		s.Group == m.Group
+}
+
+func (s *VoterAgg) Update(u *VoterAgg) {
+	s.Aggregate.RecentAtHeight = u.Aggregate.RecentAtHeight
+	s.Aggregate.RecentAt = u.Aggregate.RecentAt
+	s.PendingVotes = u.PendingVotes
+	s.ActiveVotes = u.ActiveVotes
+}
+
+// AddVotes changes pending and active votes by given amounts.
+// Votes cast below first indexed height are not known, so votes are kept from dropping below zero
+func (s *VoterAgg) AddVotes(pending *big.Int, active *big.Int) {
+	s.PendingVotes = addNonNegative(s.PendingVotes, pending)
+	s.ActiveVotes = addNonNegative(s.ActiveVotes, active)
+}
+
+func addNonNegative(q types.Quantity, delta *big.Int) types.Quantity {
+	sum := new(big.Int).Add(&q.Int, delta)
+	if sum.Sign() < 0 {
+		return types.NewQuantityFromInt64(0)
+	}
+	return types.NewQuantity(sum)
+}
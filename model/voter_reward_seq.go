@@ -0,0 +1,19 @@
+package model
+
+import "github.com/figment-networks/celo-indexer/types"
+
+// VoterRewardSeq is reward of voter for active votes for group distributed at last height of epoch
+type VoterRewardSeq struct {
+	*Model
+	*Sequence
+
+	Epoch       int64          `json:"epoch"`
+	Address     string         `json:"address"`
+	Group       string         `json:"group"`
+	Amount      types.Quantity `json:"amount"`
+	ActiveVotes types.Quantity `json:"active_votes"`
+}
+
+func (VoterRewardSeq) TableName() string {
+	return "voter_reward_sequences"
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/lib/pq"
+)
+
+type WebhookSubscription struct {
+	*ModelWithTimestamps
+
+	Url    string         `json:"url"`
+	Actors pq.StringArray `json:"actors"`
+	Kinds  pq.StringArray `json:"kinds"`
+	Secret string         `json:"-"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (s *WebhookSubscription) Valid() bool {
+	return s.Url != "" &&
+		s.Secret != ""
+}
+
+// Matches returns true when system event passes actor and kind filters of subscription.
+// Empty filter matches all values
+func (s *WebhookSubscription) Matches(e SystemEvent) bool {
+	return matchesFilter(s.Actors, e.Actor) &&
+		matchesFilter(s.Kinds, e.Kind.String())
+}
+
+func matchesFilter(filter []string, value string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, v := range filter {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	*ModelWithTimestamps
+
+	SubscriptionID types.ID        `json:"subscription_id"`
+	Height         int64           `json:"height"`
+	Actor          string          `json:"actor"`
+	Kind           SystemEventKind `json:"kind"`
+	Payload        types.Jsonb     `json:"-"`
+	Attempts       int64           `json:"attempts"`
+	StatusCode     *int64          `json:"status_code"`
+	ErrorMsg       *string         `json:"error_msg"`
+	NextAttemptAt  *types.Time     `json:"next_attempt_at"`
+	DeliveredAt    *types.Time     `json:"delivered_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *WebhookDelivery) Delivered() bool {
+	return d.DeliveredAt != nil
+}
+
+// Pending returns true when delivery is waiting for next attempt
+func (d *WebhookDelivery) Pending() bool {
+	return d.DeliveredAt == nil && d.NextAttemptAt != nil
+}
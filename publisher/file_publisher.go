@@ -0,0 +1,91 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+var (
+	_ Publisher = (*filePublisher)(nil)
+)
+
+// NewWriterPublisher creates publisher writing messages as JSON lines to given writer
+func NewWriterPublisher(w io.Writer) *filePublisher {
+	return &filePublisher{
+		writer: w,
+	}
+}
+
+// NewFilePublisher creates publisher appending messages as JSON lines to file at given path
+func NewFilePublisher(path string) *filePublisher {
+	return &filePublisher{
+		path: path,
+	}
+}
+
+type filePublisher struct {
+	path string
+
+	mu     sync.Mutex
+	writer io.Writer
+	file   *os.File
+}
+
+type fileMessage struct {
+	Subject string          `json:"subject"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (p *filePublisher) Publish(ctx context.Context, messages ...Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.open(); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(p.writer)
+	for _, m := range messages {
+		if err := encoder.Encode(fileMessage{Subject: m.Subject, Data: m.Data}); err != nil {
+			return err
+		}
+	}
+
+	// Make sure messages are on disk before height is marked as processed
+	if p.file != nil {
+		return p.file.Sync()
+	}
+	return nil
+}
+
+func (p *filePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.file == nil {
+		return nil
+	}
+
+	err := p.file.Close()
+	p.file = nil
+	p.writer = nil
+	return err
+}
+
+// open lazily opens file so publisher can be reused after it is closed
+func (p *filePublisher) open() error {
+	if p.writer != nil {
+		return nil
+	}
+
+	file, err := os.OpenFile(p.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	p.file = file
+	p.writer = file
+	return nil
+}
@@ -0,0 +1,201 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	natsDefaultPort = "4222"
+	natsTimeout     = 10 * time.Second
+)
+
+var (
+	_ Publisher = (*natsPublisher)(nil)
+
+	ErrNatsUrlInvalid = errors.New("nats url is invalid")
+)
+
+// NewNatsPublisher creates publisher speaking NATS client protocol.
+// Every publish is confirmed with PING/PONG round trip, so it returns only after server processed all messages
+func NewNatsPublisher(rawUrl string, subjectPrefix string) (*natsPublisher, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return nil, ErrNatsUrlInvalid
+	}
+
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), natsDefaultPort)
+	}
+
+	return &natsPublisher{
+		addr:          addr,
+		user:          u.User,
+		subjectPrefix: subjectPrefix,
+	}, nil
+}
+
+type natsPublisher struct {
+	addr          string
+	user          *url.Userinfo
+	subjectPrefix string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+type natsConnectOptions struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	User     string `json:"user,omitempty"`
+	Pass     string `json:"pass,omitempty"`
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, messages ...Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.publish(ctx, messages); err != nil {
+		// Drop connection so next publish starts with a clean one
+		p.close()
+		return errors.Wrap(err, "nats publish failed")
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.close()
+}
+
+func (p *natsPublisher) publish(ctx context.Context, messages []Message) error {
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := p.conn.SetDeadline(deadline(ctx)); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(p.conn)
+	for _, m := range messages {
+		fmt.Fprintf(w, "PUB %s %d\r\n", p.subject(m.Subject), len(m.Data))
+		w.Write(m.Data)
+		w.WriteString("\r\n")
+	}
+	w.WriteString("PING\r\n")
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return p.waitForPong()
+}
+
+func (p *natsPublisher) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: natsTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return err
+	}
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+
+	if err := p.conn.SetDeadline(deadline(ctx)); err != nil {
+		return err
+	}
+
+	line, err := p.readLine()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO") {
+		return errors.New(fmt.Sprintf("unexpected nats server greeting: %s", line))
+	}
+
+	opts := natsConnectOptions{Name: "celo-indexer", Lang: "go"}
+	if p.user != nil {
+		opts.User = p.user.Username()
+		opts.Pass, _ = p.user.Password()
+	}
+	rawOpts, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(p.conn, "CONNECT %s\r\nPING\r\n", rawOpts); err != nil {
+		return err
+	}
+
+	return p.waitForPong()
+}
+
+// waitForPong reads server responses until PONG is received
+func (p *natsPublisher) waitForPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New(fmt.Sprintf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR"))))
+		}
+	}
+}
+
+func (p *natsPublisher) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (p *natsPublisher) subject(subject string) string {
+	if p.subjectPrefix == "" {
+		return subject
+	}
+	return fmt.Sprintf("%s.%s", p.subjectPrefix, subject)
+}
+
+func (p *natsPublisher) close() error {
+	if p.conn == nil {
+		return nil
+	}
+
+	err := p.conn.Close()
+	p.conn = nil
+	p.reader = nil
+	return err
+}
+
+func deadline(ctx context.Context) time.Time {
+	if d, ok := ctx.Deadline(); ok {
+		return d
+	}
+	return time.Now().Add(natsTimeout)
+}
@@ -0,0 +1,168 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// natsStandIn is in-process stand-in of NATS server which records published messages
+type natsStandIn struct {
+	listener  net.Listener
+	published chan Message
+	errOnPub  bool
+}
+
+func newNatsStandIn(t *testing.T, errOnPub bool) *natsStandIn {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot start nats stand-in: %v", err)
+	}
+
+	s := &natsStandIn{
+		listener:  listener,
+		published: make(chan Message, 100),
+		errOnPub:  errOnPub,
+	}
+	go s.serve()
+	return s
+}
+
+func (s *natsStandIn) url() string {
+	return fmt.Sprintf("nats://%s", s.listener.Addr().String())
+}
+
+func (s *natsStandIn) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *natsStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	conn.Write([]byte("INFO {\"server_id\":\"stand-in\"}\r\n"))
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "PING":
+			conn.Write([]byte("PONG\r\n"))
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			data := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, data); err != nil {
+				return
+			}
+			if s.errOnPub {
+				conn.Write([]byte("-ERR 'Permissions Violation'\r\n"))
+				continue
+			}
+			s.published <- Message{Subject: fields[1], Data: data[:size]}
+		}
+	}
+}
+
+func TestNatsPublisher_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		prefix      string
+		errOnPub    bool
+		expectErr   bool
+	}{
+		{"publishes messages with prefixed subjects", "celo", false, false},
+		{"publishes messages without prefix", "", false, false},
+		{"returns error when server rejects message", "celo", true, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			server := newNatsStandIn(t, tt.errOnPub)
+			defer server.listener.Close()
+
+			p, err := NewNatsPublisher(server.url(), tt.prefix)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer p.Close()
+
+			messages := []Message{
+				{Subject: "block_sequences", Data: []byte(`{"height":10}`)},
+				{Subject: "system_events", Data: []byte("{\"height\":10,\r\n\"data\":[]}")},
+			}
+
+			err = p.Publish(context.Background(), messages...)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.expectErr {
+				return
+			}
+
+			for _, expected := range messages {
+				received := <-server.published
+
+				expectedSubject := expected.Subject
+				if tt.prefix != "" {
+					expectedSubject = tt.prefix + "." + expected.Subject
+				}
+				if received.Subject != expectedSubject {
+					t.Errorf("unexpected subject, want %s; got %s", expectedSubject, received.Subject)
+				}
+				if string(received.Data) != string(expected.Data) {
+					t.Errorf("unexpected data, want %s; got %s", expected.Data, received.Data)
+				}
+			}
+		})
+	}
+}
+
+func TestNatsPublisher_reconnects(t *testing.T) {
+	t.Parallel()
+
+	server := newNatsStandIn(t, false)
+	defer server.listener.Close()
+
+	p, err := NewNatsPublisher(server.url(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := p.Publish(context.Background(), Message{Subject: "a", Data: []byte("1")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.Publish(context.Background(), Message{Subject: "a", Data: []byte("2")}); err != nil {
+		t.Fatalf("unexpected error after reconnect: %v", err)
+	}
+	p.Close()
+
+	if len(server.published) != 2 {
+		t.Errorf("unexpected published count, want 2; got %d", len(server.published))
+	}
+}
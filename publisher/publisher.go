@@ -0,0 +1,45 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	KindStdout = "stdout"
+	KindFile   = "file"
+	KindNats   = "nats"
+)
+
+// Message is a single message published to given subject
+type Message struct {
+	Subject string
+	Data    []byte
+}
+
+// Publisher publishes messages to external consumers
+type Publisher interface {
+	// Publish returns only after all messages were delivered
+	Publish(ctx context.Context, messages ...Message) error
+	Close() error
+}
+
+// New creates publisher of kind set in config. It returns nil when publishing is disabled
+func New(cfg *config.Config) (Publisher, error) {
+	switch cfg.Publisher {
+	case "":
+		return nil, nil
+	case KindStdout:
+		return NewWriterPublisher(os.Stdout), nil
+	case KindFile:
+		return NewFilePublisher(cfg.PublisherFile), nil
+	case KindNats:
+		return NewNatsPublisher(cfg.PublisherNatsUrl, cfg.PublisherSubjectPrefix)
+	default:
+		return nil, errors.New(fmt.Sprintf("publisher %s not supported", cfg.Publisher))
+	}
+}
@@ -0,0 +1,18 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+func TestMain(m *testing.M) {
+	setup()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+func setup() {
+	logger.InitTest()
+}
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -35,3 +38,27 @@ func ErrorReportingMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// AdminAuthMiddleware allows only requests with bearer token matching admin token.
+// Admin endpoints are disabled when admin token is not configured
+func AdminAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"status": http.StatusForbidden,
+				"error":  "admin endpoints are disabled",
+			})
+			return
+		}
+
+		given := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"status": http.StatusUnauthorized,
+				"error":  "unauthorized",
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -5,20 +5,38 @@ func (s *Server) setupRoutes() {
 	s.engine.GET("/health", s.handlers.Health.Handle)
 	s.engine.GET("/status", s.handlers.GetStatus.Handle)
 	s.engine.GET("/block", s.handlers.GetBlockByHeight.Handle)
+	s.engine.GET("/block/:height/signers", s.handlers.GetBlockSigners.Handle)
 	s.engine.GET("/block_times/:limit", s.handlers.GetBlockTimes.Handle)
 	s.engine.GET("/blocks_summary", s.handlers.GetBlockSummary.Handle)
 	s.engine.GET("/transactions", s.handlers.GetTransactionsByHeight.Handle)
+	s.engine.GET("/transaction/:hash", s.handlers.GetTransactionByHash.Handle)
 	s.engine.GET("/account_details/:address", s.handlers.GetAccountDetails.Handle)
 	s.engine.GET("/account/:address", s.handlers.GetAccountByHeight.Handle)
+	s.engine.GET("/account/:address/transactions", s.handlers.GetAccountTransactions.Handle)
+	s.engine.GET("/account/:address/activity", s.handlers.GetAccountActivity.Handle)
+	s.engine.GET("/account/:address/rewards", s.handlers.GetAccountRewards.Handle)
+	s.engine.GET("/account/:address/votes", s.handlers.GetAccountVotes.Handle)
+	s.engine.GET("/transfers", s.handlers.GetTransfers.Handle)
+	s.engine.GET("/epochs", s.handlers.GetEpochs.Handle)
+	s.engine.GET("/epoch/:number", s.handlers.GetEpochByNumber.Handle)
+	s.engine.GET("/epoch/:number/elected", s.handlers.GetEpochElected.Handle)
 	s.engine.GET("/validator/:address", s.handlers.GetValidatorByAddress.Handle)
 	s.engine.GET("/validators/for_min_height/:height", s.handlers.GetValidatorsForMinHeight.Handle)
 	s.engine.GET("/validators", s.handlers.GetValidatorsByHeight.Handle)
 	s.engine.GET("/validators_summary", s.handlers.GetValidatorSummary.Handle)
 	s.engine.GET("/validator_group/:address", s.handlers.GetValidatorGroupByAddress.Handle)
+	s.engine.GET("/validator_group/:address/voters", s.handlers.GetValidatorGroupVoters.Handle)
 	s.engine.GET("/validator_groups", s.handlers.GetValidatorGroupsByHeight.Handle)
 	s.engine.GET("/validator_groups_summary", s.handlers.GetValidatorGroupSummary.Handle)
 	s.engine.GET("/system_events/:address", s.handlers.GetSystemEventsForAddress.Handle)
 	s.engine.GET("/system_events", s.handlers.GetSystemEvents.Handle)
 	s.engine.GET("/proposals", s.handlers.GetProposals.Handle)
 	s.engine.GET("/proposals/:proposal_id/activity", s.handlers.GetProposalActivity.Handle)
+
+	admin := s.engine.Group("/admin", AdminAuthMiddleware(s.cfg.AdminToken))
+	admin.GET("/pipeline/run", s.handlers.RunPipeline.Handle)
+	admin.GET("/webhooks", s.handlers.GetWebhookSubscriptions.Handle)
+	admin.POST("/webhooks", s.handlers.CreateWebhookSubscription.Handle)
+	admin.DELETE("/webhooks/:id", s.handlers.DeleteWebhookSubscription.Handle)
+	admin.GET("/webhooks/:id/deliveries", s.handlers.GetWebhookDeliveries.Handle)
 }
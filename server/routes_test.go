@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/usecase"
+)
+
+func TestServer_WebhookRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	s := New(&config.Config{AdminToken: "secret"}, usecase.NewHttpHandlers(&config.Config{}, nil, nil))
+
+	tests := []struct {
+		description   string
+		method        string
+		path          string
+		authorization string
+		expectedCode  int
+	}{
+		{"list without credentials", http.MethodGet, "/admin/webhooks", "", http.StatusUnauthorized},
+		{"create without credentials", http.MethodPost, "/admin/webhooks", "", http.StatusUnauthorized},
+		{"delete without credentials", http.MethodDelete, "/admin/webhooks/1", "", http.StatusUnauthorized},
+		{"deliveries without credentials", http.MethodGet, "/admin/webhooks/1/deliveries", "", http.StatusUnauthorized},
+		{"list with wrong token", http.MethodGet, "/admin/webhooks", "Bearer wrong", http.StatusUnauthorized},
+		{"unprotected path is not registered", http.MethodGet, "/webhooks", "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			s.engine.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedCode {
+				t.Errorf("unexpected status code, want %v; got %v", tt.expectedCode, w.Code)
+			}
+		})
+	}
+}
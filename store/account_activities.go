@@ -12,7 +12,11 @@ type AccountActivitySeq interface {
 	FindMostRecent() (*model.AccountActivitySeq, error)
 	FindLastByAddress(address string, limit int64) ([]model.AccountActivitySeq, error)
 	FindLastByAddressAndKind(address string, kind string, limit int64) ([]model.AccountActivitySeq, error)
+	FindByAddress(address string, query AddressHistoryQuery) ([]model.AccountActivitySeq, *AddressHistoryCursor, error)
+	FindTransfers(token string, address string, query AddressHistoryQuery) ([]model.AccountActivitySeq, *AddressHistoryCursor, error)
 	DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
 	DeleteForHeight(h int64) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
 }
 
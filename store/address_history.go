@@ -0,0 +1,63 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AddressHistoryDirection string
+
+const (
+	AddressHistoryDirectionSent     AddressHistoryDirection = "sent"
+	AddressHistoryDirectionReceived AddressHistoryDirection = "received"
+)
+
+var ErrInvalidAddressHistoryCursor = errors.New("invalid address history cursor")
+
+// AddressHistoryQuery filters and paginates sequences of address. Sequences are returned from the newest one below Cursor
+type AddressHistoryQuery struct {
+	Kinds       []string
+	Direction   AddressHistoryDirection
+	StartHeight *int64
+	EndHeight   *int64
+	StartTime   *time.Time
+	EndTime     *time.Time
+	Cursor      *AddressHistoryCursor
+	Limit       int64
+}
+
+// AddressHistoryCursor points at the last sequence of previous page. Sequences are ordered by height and id,
+// so rows reinserted by reindexing keep their place in history
+type AddressHistoryCursor struct {
+	Height int64
+	ID     int64
+}
+
+// ParseAddressHistoryCursor parses cursor in "<height>_<id>" format
+func ParseAddressHistoryCursor(s string) (*AddressHistoryCursor, error) {
+	parts := strings.Split(s, "_")
+	if len(parts) != 2 {
+		return nil, ErrInvalidAddressHistoryCursor
+	}
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidAddressHistoryCursor
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidAddressHistoryCursor
+	}
+	return &AddressHistoryCursor{Height: height, ID: id}, nil
+}
+
+func (c AddressHistoryCursor) String() string {
+	return fmt.Sprintf("%d_%d", c.Height, c.ID)
+}
+
+// MarshalText encodes cursor in format accepted by ParseAddressHistoryCursor
+func (c AddressHistoryCursor) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
@@ -16,6 +16,8 @@ type BlockSeq interface {
 	GetAvgRecentTimes(limit int64) GetAvgRecentTimesResult
 	FindMostRecent() (*model.BlockSeq, error)
 	DeleteOlderThan(purgeThreshold time.Time, activityPeriods []ActivityPeriodRow) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
 	Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]BlockSeqSummary, error)
 }
 
@@ -35,6 +35,14 @@ type Syncables interface {
 	FindMostRecentByDifferentIndexVersion(indexVersion int64) (*model.Syncable, error)
 	CreateOrUpdate(val *model.Syncable) error
 	SetProcessedAtForRange(reportID types.ID, startHeight int64, endHeight int64) error
+	DeleteAboveHeight(h int64) (*int64, error)
+	FindGaps(limit int64) ([]SyncableGap, error)
+}
+
+// SyncableGap contains range of heights which are missing or were not processed
+type SyncableGap struct {
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
 }
 
 type SystemEvents interface {
@@ -45,6 +53,8 @@ type SystemEvents interface {
 	FindUnique(height int64, address string, kind model.SystemEventKind) (*model.SystemEvent, error)
 	FindMostRecent() (*model.SystemEvent, error)
 	DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
 }
 
 type FindSystemEventByActorQuery struct {
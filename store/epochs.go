@@ -0,0 +1,21 @@
+package store
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+)
+
+type EpochSummary interface {
+	BulkUpsert(records []model.EpochSummary) error
+	FindByEpoch(epoch int64) (*model.EpochSummary, error)
+	All(limit int64, cursor *int64) ([]model.EpochSummary, *int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
+}
+
+type ElectedValidatorSeq interface {
+	BulkUpsert(records []model.ElectedValidatorSeq) error
+	FindByEpoch(epoch int64) ([]model.ElectedValidatorSeq, error)
+	DeleteForHeight(h int64) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
 	"time"
 )
 
@@ -13,6 +14,8 @@ type ProposalAgg interface {
 	FindByID(id int64) (*model.ProposalAgg, error)
 	FindByProposalId(proposalId uint64) (*model.ProposalAgg, error)
 	All(limit int64, cursor *int64) ([]model.ProposalAgg, *int64, error)
+	RollbackToHeight(h int64, t types.Time) error
+	DeleteStartedAboveHeight(h int64) (*int64, error)
 }
 
 type GovernanceActivitySeq interface {
@@ -26,4 +29,6 @@ type GovernanceActivitySeq interface {
 	FindLastByProposalIdAndKind(proposalId uint64, kind string, limit int64) ([]model.GovernanceActivitySeq, error)
 	DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
 	DeleteForHeight(h int64) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
 }
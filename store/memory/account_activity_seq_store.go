@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"strings"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+var _ store.AccountActivitySeq = (*AccountActivitySeq)(nil)
+
+func NewAccountActivitySeqStore() *AccountActivitySeq {
+	return &AccountActivitySeq{}
+}
+
+// AccountActivitySeq handles operations on account activities
+type AccountActivitySeq struct {
+	table
+}
+
+// BulkUpsert inserts account activity sequences
+func (s *AccountActivitySeq) BulkUpsert(records []model.AccountActivitySeq) error {
+	for i := range records {
+		record := records[i]
+		s.insert(&record)
+	}
+	return nil
+}
+
+// FindByHeightAndAddress finds account activities by height and address
+func (s *AccountActivitySeq) FindByHeightAndAddress(height int64, address string) ([]model.AccountActivitySeq, error) {
+	return s.last(func(seq *model.AccountActivitySeq) bool {
+		return seq.Height == height && seq.Address == address
+	}, 0, false), nil
+}
+
+// FindByHeight finds account activity sequences by height
+func (s *AccountActivitySeq) FindByHeight(h int64) ([]model.AccountActivitySeq, error) {
+	return s.last(func(seq *model.AccountActivitySeq) bool {
+		return seq.Height == h
+	}, 0, false), nil
+}
+
+// FindMostRecent finds most recent account activity sequence
+func (s *AccountActivitySeq) FindMostRecent() (*model.AccountActivitySeq, error) {
+	records := s.find(nil)
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByTimeDesc(records, func(r interface{}) time.Time { return r.(*model.AccountActivitySeq).Time.Time })
+	return records[0].(*model.AccountActivitySeq), nil
+}
+
+// FindLastByAddress finds last account activity sequences for given address
+func (s *AccountActivitySeq) FindLastByAddress(address string, limit int64) ([]model.AccountActivitySeq, error) {
+	return s.last(func(seq *model.AccountActivitySeq) bool {
+		return seq.Address == address
+	}, limit, true), nil
+}
+
+// FindLastByAddressAndKind finds last account activity sequences for given address and kind
+func (s *AccountActivitySeq) FindLastByAddressAndKind(address string, kind string, limit int64) ([]model.AccountActivitySeq, error) {
+	return s.last(func(seq *model.AccountActivitySeq) bool {
+		return seq.Address == address && seq.Kind == kind
+	}, limit, true), nil
+}
+
+// FindByAddress finds page of account activity sequences for given address
+func (s *AccountActivitySeq) FindByAddress(address string, query store.AddressHistoryQuery) ([]model.AccountActivitySeq, *store.AddressHistoryCursor, error) {
+	records, nextCursor := s.findAddressHistory(func(r interface{}) bool {
+		seq := r.(*model.AccountActivitySeq)
+		if seq.Address != address || !matchesKind(seq.Kind, query.Kinds) {
+			return false
+		}
+		switch query.Direction {
+		case store.AddressHistoryDirectionSent:
+			return strings.HasSuffix(seq.Kind, "Sent")
+		case store.AddressHistoryDirectionReceived:
+			return strings.HasSuffix(seq.Kind, "Received")
+		}
+		return true
+	}, func(r interface{}) *model.Sequence {
+		return r.(*model.AccountActivitySeq).Sequence
+	}, query)
+
+	var result []model.AccountActivitySeq
+	for _, r := range records {
+		result = append(result, *r.(*model.AccountActivitySeq))
+	}
+	return result, nextCursor, nil
+}
+
+// FindTransfers finds page of token transfers, optionally of given token and sent or received by given address
+func (s *AccountActivitySeq) FindTransfers(token string, address string, query store.AddressHistoryQuery) ([]model.AccountActivitySeq, *store.AddressHistoryCursor, error) {
+	records, nextCursor := s.findAddressHistory(func(r interface{}) bool {
+		seq := r.(*model.AccountActivitySeq)
+		switch {
+		case seq.Token == "" || !strings.HasSuffix(seq.Kind, "Sent"),
+			token != "" && seq.Token != token,
+			address != "" && seq.Address != address && seq.Counterparty != address:
+			return false
+		}
+		return true
+	}, func(r interface{}) *model.Sequence {
+		return r.(*model.AccountActivitySeq).Sequence
+	}, query)
+
+	var result []model.AccountActivitySeq
+	for _, r := range records {
+		result = append(result, *r.(*model.AccountActivitySeq))
+	}
+	return result, nextCursor, nil
+}
+
+// DeleteOlderThan deletes account activity sequences older than given threshold
+func (s *AccountActivitySeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.AccountActivitySeq).Time.Before(purgeThreshold)
+	}), nil
+}
+
+// DeleteForHeight deletes account activity sequences for given height
+func (s *AccountActivitySeq) DeleteForHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return accountActivitySeqHeight(r) == h
+	}), nil
+}
+
+// DeleteAboveHeight deletes account activity sequences above given height
+func (s *AccountActivitySeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return accountActivitySeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes account activity sequences within given height range
+func (s *AccountActivitySeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(accountActivitySeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+// last returns sequences matching filter, optionally the most recent first
+func (s *AccountActivitySeq) last(filter func(seq *model.AccountActivitySeq) bool, limit int64, mostRecent bool) []model.AccountActivitySeq {
+	records := s.find(func(r interface{}) bool {
+		return filter(r.(*model.AccountActivitySeq))
+	})
+	if mostRecent {
+		sortByHeight(records, accountActivitySeqHeight, true)
+	}
+
+	var result []model.AccountActivitySeq
+	for _, r := range limitRecords(records, limit) {
+		result = append(result, *r.(*model.AccountActivitySeq))
+	}
+	return result
+}
+
+func accountActivitySeqHeight(r interface{}) int64 {
+	return r.(*model.AccountActivitySeq).Height
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.BlockSeq = (*BlockSeq)(nil)
+
+func NewBlockSeqStore() *BlockSeq {
+	return &BlockSeq{}
+}
+
+// BlockSeq handles operations on block sequences
+type BlockSeq struct {
+	table
+}
+
+// Create creates the block sequence
+func (s *BlockSeq) Create(block *model.BlockSeq) error {
+	s.insert(block)
+	return nil
+}
+
+// Save saves the block sequence
+func (s *BlockSeq) Save(block *model.BlockSeq) error {
+	s.save(block)
+	return nil
+}
+
+// CreateIfNotExists creates the block sequence if it does not exist
+func (s *BlockSeq) CreateIfNotExists(block *model.BlockSeq) error {
+	if _, err := s.FindByHeight(block.Height); err == psql.ErrNotFound {
+		return s.Create(block)
+	}
+	return nil
+}
+
+// FindBy returns a block sequence for a matching attribute. Only id and height are supported
+func (s *BlockSeq) FindBy(key string, value interface{}) (*model.BlockSeq, error) {
+	v, ok := int64Value(value)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+
+	var filter func(b *model.BlockSeq) bool
+	switch key {
+	case "id":
+		filter = func(b *model.BlockSeq) bool { return int64(b.ID) == v }
+	case "height":
+		filter = func(b *model.BlockSeq) bool { return b.Height == v }
+	default:
+		return nil, ErrNotSupported
+	}
+
+	r, ok := s.findFirst(func(r interface{}) bool {
+		return filter(r.(*model.BlockSeq))
+	}, nil)
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.BlockSeq), nil
+}
+
+// FindByID returns a block sequence with matching ID
+func (s *BlockSeq) FindByID(id int64) (*model.BlockSeq, error) {
+	return s.FindBy("id", id)
+}
+
+// FindByHeight returns a block sequence with the matching height
+func (s *BlockSeq) FindByHeight(height int64) (*model.BlockSeq, error) {
+	return s.FindBy("height", height)
+}
+
+// GetAvgRecentTimes gets average block times for given number of most recent blocks
+func (s *BlockSeq) GetAvgRecentTimes(limit int64) store.GetAvgRecentTimesResult {
+	records := s.find(nil)
+	sortByHeight(records, blockSeqHeight, true)
+	records = limitRecords(records, limit)
+
+	var res store.GetAvgRecentTimesResult
+	if len(records) == 0 {
+		return res
+	}
+
+	first := records[len(records)-1].(*model.BlockSeq)
+	last := records[0].(*model.BlockSeq)
+	res.StartHeight = first.Height
+	res.EndHeight = last.Height
+	res.StartTime = first.Time.String()
+	res.EndTime = last.Time.String()
+	res.Count = int64(len(records))
+	res.Diff = last.Time.Sub(first.Time.Time).Seconds()
+	res.Avg = res.Diff / float64(res.Count)
+	return res
+}
+
+// FindMostRecent finds most recent block sequence
+func (s *BlockSeq) FindMostRecent() (*model.BlockSeq, error) {
+	records := s.find(nil)
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByTimeDesc(records, func(r interface{}) time.Time { return r.(*model.BlockSeq).Time.Time })
+	return records[0].(*model.BlockSeq), nil
+}
+
+// DeleteOlderThan is not supported, because block sequences are purged according to summaries
+func (s *BlockSeq) DeleteOlderThan(time.Time, []store.ActivityPeriodRow) (*int64, error) {
+	return nil, ErrNotSupported
+}
+
+// DeleteAboveHeight deletes block sequences above given height
+func (s *BlockSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return blockSeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes block sequences within given height range
+func (s *BlockSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(blockSeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+// Summarize is not supported
+func (s *BlockSeq) Summarize(types.SummaryInterval, []store.ActivityPeriodRow) ([]store.BlockSeqSummary, error) {
+	return nil, ErrNotSupported
+}
+
+func blockSeqHeight(r interface{}) int64 {
+	return r.(*model.BlockSeq).Height
+}
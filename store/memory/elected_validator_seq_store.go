@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+)
+
+var _ store.ElectedValidatorSeq = (*ElectedValidatorSeq)(nil)
+
+func NewElectedValidatorSeqStore() *ElectedValidatorSeq {
+	return &ElectedValidatorSeq{}
+}
+
+// ElectedValidatorSeq handles operations on elected validators
+type ElectedValidatorSeq struct {
+	table
+}
+
+// BulkUpsert inserts elected validator sequences or replaces them when validator is already recorded for epoch
+func (s *ElectedValidatorSeq) BulkUpsert(records []model.ElectedValidatorSeq) error {
+	for i := range records {
+		record := records[i]
+		s.upsert(&record, func(r interface{}) bool {
+			existing := r.(*model.ElectedValidatorSeq)
+			return existing.Epoch == record.Epoch && existing.Address == record.Address
+		})
+	}
+	return nil
+}
+
+// FindByEpoch finds validators elected for given epoch ordered by their index in elected set
+func (s *ElectedValidatorSeq) FindByEpoch(epoch int64) ([]model.ElectedValidatorSeq, error) {
+	records := s.find(func(r interface{}) bool {
+		return r.(*model.ElectedValidatorSeq).Epoch == epoch
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].(*model.ElectedValidatorSeq).Index < records[j].(*model.ElectedValidatorSeq).Index
+	})
+
+	var result []model.ElectedValidatorSeq
+	for _, r := range records {
+		result = append(result, *r.(*model.ElectedValidatorSeq))
+	}
+	return result, nil
+}
+
+// DeleteForHeight deletes elected validator sequences for given height
+func (s *ElectedValidatorSeq) DeleteForHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return electedValidatorSeqHeight(r) == h
+	}), nil
+}
+
+// DeleteAboveHeight deletes elected validator sequences above given height
+func (s *ElectedValidatorSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return electedValidatorSeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes elected validator sequences within given height range
+func (s *ElectedValidatorSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(electedValidatorSeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+func electedValidatorSeqHeight(r interface{}) int64 {
+	return r.(*model.ElectedValidatorSeq).Height
+}
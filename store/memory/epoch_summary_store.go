@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+var _ store.EpochSummary = (*EpochSummary)(nil)
+
+func NewEpochSummaryStore() *EpochSummary {
+	return &EpochSummary{}
+}
+
+// EpochSummary handles operations on epoch summaries
+type EpochSummary struct {
+	table
+}
+
+// BulkUpsert inserts epoch summaries or replaces them when summary of epoch already exists
+func (s *EpochSummary) BulkUpsert(records []model.EpochSummary) error {
+	for i := range records {
+		record := records[i]
+		s.upsert(&record, func(r interface{}) bool {
+			return epochSummaryEpoch(r) == record.Epoch
+		})
+	}
+	return nil
+}
+
+// FindByEpoch finds summary of given epoch
+func (s *EpochSummary) FindByEpoch(epoch int64) (*model.EpochSummary, error) {
+	records := s.find(func(r interface{}) bool {
+		return epochSummaryEpoch(r) == epoch
+	})
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	return records[0].(*model.EpochSummary), nil
+}
+
+// All finds page of epoch summaries, the most recent epochs first. Cursor is the last epoch of previous page
+func (s *EpochSummary) All(limit int64, cursor *int64) ([]model.EpochSummary, *int64, error) {
+	records := s.find(func(r interface{}) bool {
+		return cursor == nil || epochSummaryEpoch(r) < *cursor
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return epochSummaryEpoch(records[i]) > epochSummaryEpoch(records[j])
+	})
+	records = limitRecords(records, limit)
+
+	var result []model.EpochSummary
+	for _, r := range records {
+		result = append(result, *r.(*model.EpochSummary))
+	}
+
+	if limit <= 0 || int64(len(result)) < limit {
+		return result, nil, nil
+	}
+	nextCursor := result[len(result)-1].Epoch
+	return result, &nextCursor, nil
+}
+
+// DeleteAboveHeight deletes epoch summaries above given height
+func (s *EpochSummary) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return epochSummaryHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes epoch summaries within given height range
+func (s *EpochSummary) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(epochSummaryHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+func epochSummaryEpoch(r interface{}) int64 {
+	return r.(*model.EpochSummary).Epoch
+}
+
+func epochSummaryHeight(r interface{}) int64 {
+	return r.(*model.EpochSummary).Height
+}
@@ -0,0 +1,144 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+var _ store.GovernanceActivitySeq = (*GovernanceActivitySeq)(nil)
+
+func NewGovernanceActivitySeqStore() *GovernanceActivitySeq {
+	return &GovernanceActivitySeq{}
+}
+
+// GovernanceActivitySeq handles operations on governance activities
+type GovernanceActivitySeq struct {
+	table
+}
+
+// BulkUpsert inserts governance activity sequences
+func (s *GovernanceActivitySeq) BulkUpsert(records []model.GovernanceActivitySeq) error {
+	for i := range records {
+		record := records[i]
+		s.insert(&record)
+	}
+	return nil
+}
+
+// CreateIfNotExists creates the governance activity if there is no governance activity at its height
+func (s *GovernanceActivitySeq) CreateIfNotExists(governanceActivity *model.GovernanceActivitySeq) error {
+	existing := s.last(func(seq *model.GovernanceActivitySeq) bool {
+		return seq.Height == governanceActivity.Height
+	}, 0, false)
+	if len(existing) == 0 {
+		s.insert(governanceActivity)
+	}
+	return nil
+}
+
+// FindByHeightAndProposalId finds governance activities by height and proposal Id
+func (s *GovernanceActivitySeq) FindByHeightAndProposalId(height int64, proposalId uint64) ([]model.GovernanceActivitySeq, error) {
+	return s.last(func(seq *model.GovernanceActivitySeq) bool {
+		return seq.Height == height && seq.ProposalId == proposalId
+	}, 0, false), nil
+}
+
+// FindByHeight finds governance activity sequences by height
+func (s *GovernanceActivitySeq) FindByHeight(h int64) ([]model.GovernanceActivitySeq, error) {
+	return s.last(func(seq *model.GovernanceActivitySeq) bool {
+		return seq.Height == h
+	}, 0, false), nil
+}
+
+// FindByProposalId finds governance activities by proposal Id starting from the newest one below cursor
+func (s *GovernanceActivitySeq) FindByProposalId(proposalId uint64, limit int64, cursor *int64) ([]model.GovernanceActivitySeq, *int64, error) {
+	records := s.find(func(r interface{}) bool {
+		seq := r.(*model.GovernanceActivitySeq)
+		return seq.ProposalId == proposalId && (cursor == nil || int64(seq.ID) < *cursor)
+	})
+	records = limitRecords(reverse(records), limit)
+
+	var result []model.GovernanceActivitySeq
+	var nextCursor int64
+	for _, r := range records {
+		seq := *r.(*model.GovernanceActivitySeq)
+		result = append(result, seq)
+		nextCursor = int64(seq.ID)
+	}
+	return result, &nextCursor, nil
+}
+
+// FindMostRecent finds most recent governance activity sequence
+func (s *GovernanceActivitySeq) FindMostRecent() (*model.GovernanceActivitySeq, error) {
+	records := s.find(nil)
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByTimeDesc(records, func(r interface{}) time.Time { return r.(*model.GovernanceActivitySeq).Time.Time })
+	return records[0].(*model.GovernanceActivitySeq), nil
+}
+
+// FindLastByProposalId finds last governance activity sequences for given proposal Id
+func (s *GovernanceActivitySeq) FindLastByProposalId(proposalId uint64, limit int64) ([]model.GovernanceActivitySeq, error) {
+	return s.last(func(seq *model.GovernanceActivitySeq) bool {
+		return seq.ProposalId == proposalId
+	}, limit, true), nil
+}
+
+// FindLastByProposalIdAndKind finds last governance activity sequences for given proposal Id and kind
+func (s *GovernanceActivitySeq) FindLastByProposalIdAndKind(proposalId uint64, kind string, limit int64) ([]model.GovernanceActivitySeq, error) {
+	return s.last(func(seq *model.GovernanceActivitySeq) bool {
+		return seq.ProposalId == proposalId && seq.Kind == kind
+	}, limit, true), nil
+}
+
+// DeleteOlderThan deletes governance activity sequences older than given threshold
+func (s *GovernanceActivitySeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.GovernanceActivitySeq).Time.Before(purgeThreshold)
+	}), nil
+}
+
+// DeleteForHeight deletes governance activity sequences for given height
+func (s *GovernanceActivitySeq) DeleteForHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return governanceActivitySeqHeight(r) == h
+	}), nil
+}
+
+// DeleteAboveHeight deletes governance activity sequences above given height
+func (s *GovernanceActivitySeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return governanceActivitySeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes governance activity sequences within given height range
+func (s *GovernanceActivitySeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(governanceActivitySeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+// last returns sequences matching filter, optionally the most recent first
+func (s *GovernanceActivitySeq) last(filter func(seq *model.GovernanceActivitySeq) bool, limit int64, mostRecent bool) []model.GovernanceActivitySeq {
+	records := s.find(func(r interface{}) bool {
+		return filter(r.(*model.GovernanceActivitySeq))
+	})
+	if mostRecent {
+		sortByHeight(records, governanceActivitySeqHeight, true)
+	}
+
+	var result []model.GovernanceActivitySeq
+	for _, r := range limitRecords(records, limit) {
+		result = append(result, *r.(*model.GovernanceActivitySeq))
+	}
+	return result
+}
+
+func governanceActivitySeqHeight(r interface{}) int64 {
+	return r.(*model.GovernanceActivitySeq).Height
+}
@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+// sortByHeight sorts records by height ascending or descending
+func sortByHeight(records []interface{}, height func(r interface{}) int64, desc bool) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if desc {
+			return height(records[i]) > height(records[j])
+		}
+		return height(records[i]) < height(records[j])
+	})
+}
+
+// sortByTime sorts records by time descending
+func sortByTimeDesc(records []interface{}, t func(r interface{}) time.Time) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return t(records[i]).After(t(records[j]))
+	})
+}
+
+// limitRecords returns at most n first records. Non-positive n means no limit
+func limitRecords(records []interface{}, n int64) []interface{} {
+	if n > 0 && int64(len(records)) > n {
+		return records[:n]
+	}
+	return records
+}
+
+func inRange(h int64, startHeight int64, endHeight int64) bool {
+	return h >= startHeight && h <= endHeight
+}
+
+// int64Value converts integer value used in FindBy query
+func int64Value(value interface{}) (int64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), true
+	}
+	return 0, false
+}
+
+// aggFilter returns filter of aggregates by id or address
+func aggFilter(key string, value interface{}, fields func(r interface{}) (types.ID, string)) (func(r interface{}) bool, error) {
+	switch key {
+	case "id":
+		id, ok := int64Value(value)
+		if !ok {
+			return nil, ErrNotSupported
+		}
+		return func(r interface{}) bool {
+			recordID, _ := fields(r)
+			return int64(recordID) == id
+		}, nil
+	case "address":
+		address, ok := value.(string)
+		if !ok {
+			return nil, ErrNotSupported
+		}
+		return func(r interface{}) bool {
+			_, recordAddress := fields(r)
+			return recordAddress == address
+		}, nil
+	}
+	return nil, ErrNotSupported
+}
+
+// reverse returns records in reverse insert order (ie. ordered by id descending)
+func reverse(records []interface{}) []interface{} {
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+	return records
+}
+
+// findAddressHistory returns page of records matching filter and height, time and cursor filters of query, ordered by height and id descending.
+// It returns cursor of the next page as well, the same way as database store
+func (t *table) findAddressHistory(filter func(r interface{}) bool, seq func(r interface{}) *model.Sequence, query store.AddressHistoryQuery) ([]interface{}, *store.AddressHistoryCursor) {
+	records := t.find(func(r interface{}) bool {
+		s := seq(r)
+		switch {
+		case query.StartHeight != nil && s.Height < *query.StartHeight,
+			query.EndHeight != nil && s.Height > *query.EndHeight,
+			query.StartTime != nil && s.Time.Before(*query.StartTime),
+			query.EndTime != nil && s.Time.After(*query.EndTime),
+			query.Cursor != nil && !beforeCursor(s.Height, int64(getID(r)), *query.Cursor):
+			return false
+		}
+		return filter(r)
+	})
+	records = reverse(records)
+	sortByHeight(records, func(r interface{}) int64 { return seq(r).Height }, true)
+	records = limitRecords(records, query.Limit)
+
+	if len(records) == 0 || query.Limit <= 0 || int64(len(records)) < query.Limit {
+		return records, nil
+	}
+	last := records[len(records)-1]
+	return records, &store.AddressHistoryCursor{Height: seq(last).Height, ID: int64(getID(last))}
+}
+
+// beforeCursor checks if record of given height and id precedes cursor in address history order
+func beforeCursor(height int64, id int64, cursor store.AddressHistoryCursor) bool {
+	return height < cursor.Height || (height == cursor.Height && id < cursor.ID)
+}
+
+// matchesKind checks if kind is one of given kinds. Empty kinds match any kind
+func matchesKind(kind string, kinds []string) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
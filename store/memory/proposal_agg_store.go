@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.ProposalAgg = (*ProposalAgg)(nil)
+
+func NewProposalAggStore() *ProposalAgg {
+	return &ProposalAgg{}
+}
+
+// ProposalAgg handles operations on proposal aggregates
+type ProposalAgg struct {
+	table
+}
+
+// Create creates the proposal aggregate
+func (s *ProposalAgg) Create(val *model.ProposalAgg) error {
+	s.insert(val)
+	return nil
+}
+
+// Save saves the proposal aggregate
+func (s *ProposalAgg) Save(val *model.ProposalAgg) error {
+	s.save(val)
+	return nil
+}
+
+// CreateOrUpdate creates a new proposal aggregate. Existing aggregate is kept as it is, the same way as in database store
+func (s *ProposalAgg) CreateOrUpdate(val *model.ProposalAgg) error {
+	if _, err := s.FindByProposalId(val.ProposalId); err == psql.ErrNotFound {
+		s.insert(val)
+	}
+	return nil
+}
+
+// FindBy returns proposal aggregate for a matching attribute. Only id and proposal_id are supported
+func (s *ProposalAgg) FindBy(key string, value interface{}) (*model.ProposalAgg, error) {
+	v, ok := int64Value(value)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+
+	var filter func(agg *model.ProposalAgg) bool
+	switch key {
+	case "id":
+		filter = func(agg *model.ProposalAgg) bool { return int64(agg.ID) == v }
+	case "proposal_id":
+		filter = func(agg *model.ProposalAgg) bool { return int64(agg.ProposalId) == v }
+	default:
+		return nil, ErrNotSupported
+	}
+
+	r, ok := s.findFirst(func(r interface{}) bool {
+		return filter(r.(*model.ProposalAgg))
+	}, nil)
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.ProposalAgg), nil
+}
+
+// FindByID returns proposal aggregate with matching ID
+func (s *ProposalAgg) FindByID(id int64) (*model.ProposalAgg, error) {
+	return s.FindBy("id", id)
+}
+
+// FindByProposalId returns proposal aggregate by proposal Id
+func (s *ProposalAgg) FindByProposalId(proposalId uint64) (*model.ProposalAgg, error) {
+	return s.FindBy("proposal_id", proposalId)
+}
+
+// All returns proposal aggregates starting from the newest one below cursor
+func (s *ProposalAgg) All(limit int64, cursor *int64) ([]model.ProposalAgg, *int64, error) {
+	records := s.find(func(r interface{}) bool {
+		return cursor == nil || int64(r.(*model.ProposalAgg).ID) < *cursor
+	})
+	records = limitRecords(reverse(records), limit)
+
+	var result []model.ProposalAgg
+	var nextCursor int64
+	for _, r := range records {
+		agg := *r.(*model.ProposalAgg)
+		result = append(result, agg)
+		nextCursor = int64(agg.ID)
+	}
+	return result, &nextCursor, nil
+}
+
+// RollbackToHeight is not supported, because it needs governance activity sequences
+func (s *ProposalAgg) RollbackToHeight(int64, types.Time) error {
+	return ErrNotSupported
+}
+
+// DeleteStartedAboveHeight deletes proposal aggregates started above given height
+func (s *ProposalAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.ProposalAgg).StartedAtHeight > h
+	}), nil
+}
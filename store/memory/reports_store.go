@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+var _ store.Reports = (*Reports)(nil)
+
+func NewReportsStore() *Reports {
+	return &Reports{}
+}
+
+// Reports handles operations on reports
+type Reports struct {
+	table
+}
+
+// Create creates the report
+func (s *Reports) Create(val *model.Report) error {
+	s.insert(val)
+	return nil
+}
+
+// Save saves the report
+func (s *Reports) Save(val *model.Report) error {
+	s.save(val)
+	return nil
+}
+
+// FindNotCompletedByIndexVersion returns not completed report by index version and kind
+func (s *Reports) FindNotCompletedByIndexVersion(indexVersion int64, kinds ...model.ReportKind) (*model.Report, error) {
+	return s.first(func(r *model.Report) bool {
+		return r.IndexVersion == indexVersion && r.CompletedAt == nil && hasReportKind(r, kinds)
+	}, false)
+}
+
+// FindNotCompletedByKind returns not completed report by kind
+func (s *Reports) FindNotCompletedByKind(kinds ...model.ReportKind) (*model.Report, error) {
+	return s.first(func(r *model.Report) bool {
+		return r.CompletedAt == nil && hasReportKind(r, kinds)
+	}, false)
+}
+
+// Last returns the last report
+func (s *Reports) Last() (*model.Report, error) {
+	return s.first(nil, true)
+}
+
+// DeleteByKinds deletes reports of given kinds
+func (s *Reports) DeleteByKinds(kinds []model.ReportKind) error {
+	s.delete(func(r interface{}) bool {
+		return hasReportKind(r.(*model.Report), kinds)
+	})
+	return nil
+}
+
+func (s *Reports) first(filter func(r *model.Report) bool, last bool) (*model.Report, error) {
+	records := s.find(func(r interface{}) bool {
+		return filter == nil || filter(r.(*model.Report))
+	})
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	if last {
+		return records[len(records)-1].(*model.Report), nil
+	}
+	return records[0].(*model.Report), nil
+}
+
+func hasReportKind(r *model.Report, kinds []model.ReportKind) bool {
+	for _, kind := range kinds {
+		if r.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotSupported is returned for operations which are not needed outside of database (ie. summaries)
+	ErrNotSupported = errors.New("not supported by memory store")
+)
+
+// New returns a new store which keeps records in memory. It is meant for tests of indexing pipeline
+// which do not need database. Not found records are reported with psql.ErrNotFound, the same way as by database store
+func New() *Store {
+	return &Store{
+		core: &core{
+			NewDatabaseStore(),
+			NewReportsStore(),
+			NewSyncablesStore(),
+			NewSystemEventsStore(),
+		},
+		accounts: &accounts{
+			NewAccountActivitySeqStore(),
+			NewVoterRewardSeqStore(),
+			NewVoterAggStore(),
+		},
+		blocks: &blocks{
+			NewBlockSeqStore(),
+		},
+		validators: &validators{
+			NewValidatorAggStore(),
+			NewValidatorSeqStore(),
+		},
+		validatorGroups: &validatorGroups{
+			NewValidatorGroupAggStore(),
+			NewValidatorGroupSeqStore(),
+		},
+		governance: &governance{
+			NewProposalAggStore(),
+			NewGovernanceActivitySeqStore(),
+		},
+		transactions: &transactions{
+			NewTransactionSeqStore(),
+		},
+		epochs: &epochs{
+			NewEpochSummaryStore(),
+			NewElectedValidatorSeqStore(),
+		},
+		webhooks: &webhooks{
+			NewWebhookSubscriptionsStore(),
+			NewWebhookDeliveriesStore(),
+		},
+	}
+}
+
+// Store handles all memory store operations
+type Store struct {
+	core            *core
+	accounts        *accounts
+	blocks          *blocks
+	validators      *validators
+	validatorGroups *validatorGroups
+	governance      *governance
+	transactions    *transactions
+	epochs          *epochs
+	webhooks        *webhooks
+}
+
+type core struct {
+	*Database
+	*Reports
+	*Syncables
+	*SystemEvents
+}
+
+type accounts struct {
+	*AccountActivitySeq
+	*VoterRewardSeq
+	*VoterAgg
+}
+
+type blocks struct {
+	*BlockSeq
+}
+
+type validators struct {
+	*ValidatorAgg
+	*ValidatorSeq
+}
+
+type validatorGroups struct {
+	*ValidatorGroupAgg
+	*ValidatorGroupSeq
+}
+
+type governance struct {
+	*ProposalAgg
+	*GovernanceActivitySeq
+}
+
+type transactions struct {
+	*TransactionSeq
+}
+
+type epochs struct {
+	*EpochSummary
+	*ElectedValidatorSeq
+}
+
+type webhooks struct {
+	*WebhookSubscriptions
+	*WebhookDeliveries
+}
+
+// GetAccounts gets accounts
+func (s *Store) GetAccounts() *accounts {
+	return s.accounts
+}
+
+// GetBlocks gets blocks
+func (s *Store) GetBlocks() *blocks {
+	return s.blocks
+}
+
+// GetCore gets core
+func (s *Store) GetCore() *core {
+	return s.core
+}
+
+// GetValidators gets validators
+func (s *Store) GetValidators() *validators {
+	return s.validators
+}
+
+// GetValidatorGroups gets validator groups
+func (s *Store) GetValidatorGroups() *validatorGroups {
+	return s.validatorGroups
+}
+
+// GetGovernance gets governance
+func (s *Store) GetGovernance() *governance {
+	return s.governance
+}
+
+// GetTransactions gets transactions
+func (s *Store) GetTransactions() *transactions {
+	return s.transactions
+}
+
+// GetEpochs gets epochs
+func (s *Store) GetEpochs() *epochs {
+	return s.epochs
+}
+
+// GetWebhooks gets webhooks
+func (s *Store) GetWebhooks() *webhooks {
+	return s.webhooks
+}
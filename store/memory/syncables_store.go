@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.Syncables = (*Syncables)(nil)
+
+func NewSyncablesStore() *Syncables {
+	return &Syncables{}
+}
+
+// Syncables handles operations on syncables
+type Syncables struct {
+	table
+}
+
+// Save saves the syncable
+func (s *Syncables) Save(val *model.Syncable) error {
+	s.save(val)
+	return nil
+}
+
+// FindSmallestIndexVersion returns smallest index version
+func (s *Syncables) FindSmallestIndexVersion() (*int64, error) {
+	r, ok := s.findFirst(func(r interface{}) bool {
+		return r.(*model.Syncable).ProcessedAt != nil
+	}, func(a, b interface{}) bool {
+		return a.(*model.Syncable).IndexVersion < b.(*model.Syncable).IndexVersion
+	})
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return &r.(*model.Syncable).IndexVersion, nil
+}
+
+// FindByHeight returns syncable at given height
+func (s *Syncables) FindByHeight(height int64) (*model.Syncable, error) {
+	return s.first(func(r *model.Syncable) bool {
+		return r.Height == height
+	}, false)
+}
+
+// FindMostRecent returns the most recent syncable
+func (s *Syncables) FindMostRecent() (*model.Syncable, error) {
+	return s.first(nil, true)
+}
+
+// FindLastInEpochForHeight finds last_in_epoch syncable for given height
+func (s *Syncables) FindLastInEpochForHeight(height int64) (*model.Syncable, error) {
+	return s.first(func(r *model.Syncable) bool {
+		return r.Height >= height && r.LastInEpoch != nil && *r.LastInEpoch
+	}, true)
+}
+
+// FindLastInEpoch finds last syncable in given epoch
+func (s *Syncables) FindLastInEpoch(epoch int64) (*model.Syncable, error) {
+	return s.first(func(r *model.Syncable) bool {
+		return r.Epoch != nil && *r.Epoch == epoch
+	}, true)
+}
+
+// FindFirstByDifferentIndexVersion returns first syncable with different index version
+func (s *Syncables) FindFirstByDifferentIndexVersion(indexVersion int64) (*model.Syncable, error) {
+	return s.first(func(r *model.Syncable) bool {
+		return r.IndexVersion != indexVersion
+	}, false)
+}
+
+// FindMostRecentByDifferentIndexVersion returns the most recent syncable with different index version
+func (s *Syncables) FindMostRecentByDifferentIndexVersion(indexVersion int64) (*model.Syncable, error) {
+	return s.first(func(r *model.Syncable) bool {
+		return r.IndexVersion != indexVersion
+	}, true)
+}
+
+// CreateOrUpdate creates a new syncable. Existing syncable is kept as it is, the same way as in database store
+func (s *Syncables) CreateOrUpdate(val *model.Syncable) error {
+	if _, err := s.FindByHeight(val.Height); err == psql.ErrNotFound {
+		s.insert(val)
+	}
+	return nil
+}
+
+// SetProcessedAtForRange resets processed at of syncables within given range
+func (s *Syncables) SetProcessedAtForRange(reportID types.ID, startHeight int64, endHeight int64) error {
+	s.update(func(r interface{}) bool {
+		h := r.(*model.Syncable).Height
+		return h >= startHeight && h <= endHeight
+	}, func(r interface{}) {
+		r.(*model.Syncable).ReportID = reportID
+		r.(*model.Syncable).ProcessedAt = nil
+	})
+	return nil
+}
+
+// DeleteAboveHeight deletes syncables above given height
+func (s *Syncables) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.Syncable).Height > h
+	}), nil
+}
+
+// FindGaps returns ranges of heights which are missing or not processed within indexed range.
+// When limit is greater than 0 only the lowest limit gaps are returned
+func (s *Syncables) FindGaps(limit int64) ([]store.SyncableGap, error) {
+	records := s.find(nil)
+	sortByHeight(records, func(r interface{}) int64 { return r.(*model.Syncable).Height }, false)
+
+	var missing, unprocessed []store.SyncableGap
+	for i, r := range records {
+		syncable := r.(*model.Syncable)
+		if i > 0 {
+			if prevHeight := records[i-1].(*model.Syncable).Height; syncable.Height > prevHeight+1 {
+				missing = append(missing, store.SyncableGap{StartHeight: prevHeight + 1, EndHeight: syncable.Height - 1})
+			}
+		}
+		if syncable.ProcessedAt == nil {
+			if n := len(unprocessed); n > 0 && unprocessed[n-1].EndHeight+1 == syncable.Height {
+				unprocessed[n-1].EndHeight = syncable.Height
+			} else {
+				unprocessed = append(unprocessed, store.SyncableGap{StartHeight: syncable.Height, EndHeight: syncable.Height})
+			}
+		}
+	}
+
+	gaps := append(missing, unprocessed...)
+	sort.SliceStable(gaps, func(i, j int) bool {
+		return gaps[i].StartHeight < gaps[j].StartHeight
+	})
+	if limit > 0 && int64(len(gaps)) > limit {
+		gaps = gaps[:limit]
+	}
+	return gaps, nil
+}
+
+func (s *Syncables) first(filter func(r *model.Syncable) bool, mostRecent bool) (*model.Syncable, error) {
+	records := s.find(func(r interface{}) bool {
+		return filter == nil || filter(r.(*model.Syncable))
+	})
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByHeight(records, func(r interface{}) int64 { return r.(*model.Syncable).Height }, mostRecent)
+	return records[0].(*model.Syncable), nil
+}
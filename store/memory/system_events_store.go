@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+var _ store.SystemEvents = (*SystemEvents)(nil)
+
+func NewSystemEventsStore() *SystemEvents {
+	return &SystemEvents{}
+}
+
+// SystemEvents handles operations on system events
+type SystemEvents struct {
+	table
+}
+
+// BulkUpsert inserts system events or updates existing ones with the same height, actor and kind
+func (s *SystemEvents) BulkUpsert(records []model.SystemEvent) error {
+	for i := range records {
+		record := records[i]
+		s.upsert(&record, func(r interface{}) bool {
+			e := r.(*model.SystemEvent)
+			return e.Height == record.Height && e.Actor == record.Actor && e.Kind == record.Kind
+		})
+	}
+	return nil
+}
+
+// FindByHeight returns system events at given height
+func (s *SystemEvents) FindByHeight(height int64) ([]model.SystemEvent, error) {
+	return s.filter(func(e *model.SystemEvent) bool {
+		return e.Height == height
+	}), nil
+}
+
+// FindByActor returns system events of given actor
+func (s *SystemEvents) FindByActor(actorAddress string, query store.FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
+	return s.filter(func(e *model.SystemEvent) bool {
+		return e.Actor == actorAddress &&
+			(query.Kind == nil || e.Kind == *query.Kind) &&
+			(query.MinHeight == nil || e.Height > *query.MinHeight)
+	}), nil
+}
+
+// FindAll returns page of system events
+func (s *SystemEvents) FindAll(query store.FindAll) ([]model.SystemEvent, error) {
+	records := s.filter(nil)
+
+	offset := (query.Page - 1) * query.Limit
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= int64(len(records)) {
+		return nil, nil
+	}
+	records = records[offset:]
+	if query.Limit > 0 && int64(len(records)) > query.Limit {
+		records = records[:query.Limit]
+	}
+	return records, nil
+}
+
+// FindUnique returns system event of given actor and kind at given height
+func (s *SystemEvents) FindUnique(height int64, address string, kind model.SystemEventKind) (*model.SystemEvent, error) {
+	records := s.filter(func(e *model.SystemEvent) bool {
+		return e.Height == height && e.Actor == address && e.Kind == kind
+	})
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	return &records[0], nil
+}
+
+// FindMostRecent returns the most recent system event
+func (s *SystemEvents) FindMostRecent() (*model.SystemEvent, error) {
+	records := s.find(nil)
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByTimeDesc(records, func(r interface{}) time.Time { return r.(*model.SystemEvent).Time.Time })
+	return records[0].(*model.SystemEvent), nil
+}
+
+// DeleteOlderThan deletes system events older than given threshold
+func (s *SystemEvents) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.SystemEvent).Time.Before(purgeThreshold)
+	}), nil
+}
+
+// DeleteAboveHeight deletes system events above given height
+func (s *SystemEvents) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.SystemEvent).Height > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes system events within given height range
+func (s *SystemEvents) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(r.(*model.SystemEvent).Height, startHeight, endHeight)
+	}), nil
+}
+
+func (s *SystemEvents) filter(filter func(e *model.SystemEvent) bool) []model.SystemEvent {
+	var result []model.SystemEvent
+	for _, r := range s.find(func(r interface{}) bool {
+		return filter == nil || filter(r.(*model.SystemEvent))
+	}) {
+		result = append(result, *r.(*model.SystemEvent))
+	}
+	return result
+}
@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+// table keeps copies of records of one model. Records are copied on every write and read,
+// so callers cannot change stored records without saving them, the same way as with database
+type table struct {
+	mu      sync.RWMutex
+	lastID  types.ID
+	records []interface{}
+}
+
+// insert stores copy of record and sets its ID
+func (t *table) insert(record interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.insertLocked(record)
+}
+
+// save replaces stored record with the same ID or inserts record without ID
+func (t *table) save(record interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := getID(record)
+	if id.Valid() {
+		for i, r := range t.records {
+			if getID(r) == id {
+				setTimestamps(record, false)
+				t.records[i] = clone(record)
+				return
+			}
+		}
+	}
+	t.insertLocked(record)
+}
+
+// upsert replaces stored record with the same key or inserts new one
+func (t *table) upsert(record interface{}, sameKey func(r interface{}) bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, r := range t.records {
+		if sameKey(r) {
+			setID(record, getID(r))
+			t.records[i] = clone(record)
+			return
+		}
+	}
+	t.insertLocked(record)
+}
+
+// find returns copies of records matching given filter in insert order
+func (t *table) find(filter func(r interface{}) bool) []interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var result []interface{}
+	for _, r := range t.records {
+		if filter == nil || filter(r) {
+			result = append(result, clone(r))
+		}
+	}
+	return result
+}
+
+// findFirst returns copy of the first record matching given filter after sorting with given less func
+func (t *table) findFirst(filter func(r interface{}) bool, less func(a, b interface{}) bool) (interface{}, bool) {
+	result := t.find(filter)
+	if len(result) == 0 {
+		return nil, false
+	}
+	if less != nil {
+		sort.SliceStable(result, func(i, j int) bool {
+			return less(result[i], result[j])
+		})
+	}
+	return result[0], true
+}
+
+// delete removes records matching given filter and returns number of removed records
+func (t *table) delete(filter func(r interface{}) bool) *int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var count int64
+	var kept []interface{}
+	for _, r := range t.records {
+		if filter(r) {
+			count++
+			continue
+		}
+		kept = append(kept, r)
+	}
+	t.records = kept
+	return &count
+}
+
+// update changes stored records matching given filter in place
+func (t *table) update(filter func(r interface{}) bool, fn func(r interface{})) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, r := range t.records {
+		if filter(r) {
+			fn(r)
+		}
+	}
+}
+
+func (t *table) insertLocked(record interface{}) {
+	t.lastID++
+	setID(record, t.lastID)
+	setTimestamps(record, true)
+	t.records = append(t.records, clone(record))
+}
+
+// clone copies record together with its embedded structs (ie. model.Model or model.Sequence)
+func clone(record interface{}) interface{} {
+	src := reflect.ValueOf(record).Elem()
+	dst := reflect.New(src.Type())
+	dst.Elem().Set(src)
+
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Type().Field(i)
+		if !field.Anonymous || field.Type.Kind() != reflect.Ptr || src.Field(i).IsNil() {
+			continue
+		}
+		embedded := reflect.New(field.Type.Elem())
+		embedded.Elem().Set(src.Field(i).Elem())
+		dst.Elem().Field(i).Set(embedded)
+	}
+	return dst.Interface()
+}
+
+func getID(record interface{}) types.ID {
+	switch m := modelOf(record).(type) {
+	case *model.Model:
+		return m.ID
+	case *model.ModelWithTimestamps:
+		return m.ID
+	}
+	return 0
+}
+
+func setID(record interface{}, id types.ID) {
+	switch m := modelOf(record).(type) {
+	case *model.Model:
+		m.ID = id
+	case *model.ModelWithTimestamps:
+		m.ID = id
+	}
+}
+
+func setTimestamps(record interface{}, created bool) {
+	if m, ok := modelOf(record).(*model.ModelWithTimestamps); ok {
+		now := types.Time{Time: time.Now()}
+		if created {
+			m.CreatedAt = now
+		}
+		m.UpdatedAt = now
+	}
+}
+
+// modelOf returns embedded model of record. Nil embedded model is created, the same way gorm does it
+func modelOf(record interface{}) interface{} {
+	v := reflect.ValueOf(record).Elem()
+	for _, name := range []string{"Model", "ModelWithTimestamps"} {
+		field := v.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return field.Interface()
+	}
+	panic(fmt.Sprintf("record does not embed model [type=%T]", record))
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+var _ store.TransactionSeq = (*TransactionSeq)(nil)
+
+func NewTransactionSeqStore() *TransactionSeq {
+	return &TransactionSeq{}
+}
+
+// TransactionSeq handles operations on transactions
+type TransactionSeq struct {
+	table
+}
+
+// BulkUpsert inserts transaction sequences
+func (s *TransactionSeq) BulkUpsert(records []model.TransactionSeq) error {
+	for i := range records {
+		record := records[i]
+		s.insert(&record)
+	}
+	return nil
+}
+
+// FindByHeight finds transaction sequences by height
+func (s *TransactionSeq) FindByHeight(h int64) ([]model.TransactionSeq, error) {
+	records := s.find(func(r interface{}) bool {
+		return transactionSeqHeight(r) == h
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].(*model.TransactionSeq).Index < records[j].(*model.TransactionSeq).Index
+	})
+
+	var result []model.TransactionSeq
+	for _, r := range records {
+		result = append(result, *r.(*model.TransactionSeq))
+	}
+	return result, nil
+}
+
+// FindByHash finds transaction sequence by hash
+func (s *TransactionSeq) FindByHash(hash string) (*model.TransactionSeq, error) {
+	r, ok := s.findFirst(func(r interface{}) bool {
+		return r.(*model.TransactionSeq).Hash == hash
+	}, func(a, b interface{}) bool {
+		return transactionSeqHeight(a) > transactionSeqHeight(b)
+	})
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.TransactionSeq), nil
+}
+
+// FindByAddress finds page of transaction sequences sent or received by given address
+func (s *TransactionSeq) FindByAddress(address string, query store.AddressHistoryQuery) ([]model.TransactionSeq, *store.AddressHistoryCursor, error) {
+	records, nextCursor := s.findAddressHistory(func(r interface{}) bool {
+		seq := r.(*model.TransactionSeq)
+		switch query.Direction {
+		case store.AddressHistoryDirectionSent:
+			if seq.FromAddress != address {
+				return false
+			}
+		case store.AddressHistoryDirectionReceived:
+			if seq.ToAddress != address {
+				return false
+			}
+		default:
+			if seq.FromAddress != address && seq.ToAddress != address {
+				return false
+			}
+		}
+		return len(query.Kinds) == 0 || hasOperationOfKind(seq, query.Kinds)
+	}, func(r interface{}) *model.Sequence {
+		return r.(*model.TransactionSeq).Sequence
+	}, query)
+
+	var result []model.TransactionSeq
+	for _, r := range records {
+		result = append(result, *r.(*model.TransactionSeq))
+	}
+	return result, nextCursor, nil
+}
+
+// DeleteOlderThan deletes transaction sequences older than given threshold
+func (s *TransactionSeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.TransactionSeq).Time.Before(purgeThreshold)
+	}), nil
+}
+
+// DeleteForHeight deletes transaction sequences for given height
+func (s *TransactionSeq) DeleteForHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return transactionSeqHeight(r) == h
+	}), nil
+}
+
+// DeleteAboveHeight deletes transaction sequences above given height
+func (s *TransactionSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return transactionSeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes transaction sequences within given height range
+func (s *TransactionSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(transactionSeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+func transactionSeqHeight(r interface{}) int64 {
+	return r.(*model.TransactionSeq).Height
+}
+
+// hasOperationOfKind checks if any operation of transaction has one of given names
+func hasOperationOfKind(seq *model.TransactionSeq, kinds []string) bool {
+	var operations []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(seq.Operations.RawMessage, &operations); err != nil {
+		return false
+	}
+	for _, operation := range operations {
+		if matchesKind(operation.Name, kinds) {
+			return true
+		}
+	}
+	return false
+}
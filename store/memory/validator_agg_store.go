@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.ValidatorAgg = (*ValidatorAgg)(nil)
+
+func NewValidatorAggStore() *ValidatorAgg {
+	return &ValidatorAgg{}
+}
+
+// ValidatorAgg handles operations on validator aggregates
+type ValidatorAgg struct {
+	table
+}
+
+// Create creates the validator aggregate
+func (s *ValidatorAgg) Create(val *model.ValidatorAgg) error {
+	s.insert(val)
+	return nil
+}
+
+// Save saves the validator aggregate
+func (s *ValidatorAgg) Save(val *model.ValidatorAgg) error {
+	s.save(val)
+	return nil
+}
+
+// FindBy returns validator aggregate for a matching attribute. Only id and address are supported
+func (s *ValidatorAgg) FindBy(key string, value interface{}) (*model.ValidatorAgg, error) {
+	filter, err := aggFilter(key, value, func(r interface{}) (types.ID, string) {
+		agg := r.(*model.ValidatorAgg)
+		return agg.ID, agg.Address
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := s.findFirst(filter, nil)
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.ValidatorAgg), nil
+}
+
+// FindByID returns validator aggregate with matching ID
+func (s *ValidatorAgg) FindByID(id int64) (*model.ValidatorAgg, error) {
+	return s.FindBy("id", id)
+}
+
+// FindByAddress returns validator aggregate with matching address
+func (s *ValidatorAgg) FindByAddress(key string) (*model.ValidatorAgg, error) {
+	return s.FindBy("address", key)
+}
+
+// GetAllForHeightGreaterThan returns validators who have been validating since given height
+func (s *ValidatorAgg) GetAllForHeightGreaterThan(height int64) ([]model.ValidatorAgg, error) {
+	return s.filter(func(agg *model.ValidatorAgg) bool {
+		return agg.RecentAsValidatorHeight >= height
+	}), nil
+}
+
+// All returns all validator aggregates
+func (s *ValidatorAgg) All() ([]model.ValidatorAgg, error) {
+	return s.filter(nil), nil
+}
+
+// RollbackToHeight is not supported, because it needs validator sequences
+func (s *ValidatorAgg) RollbackToHeight(int64, types.Time) error {
+	return ErrNotSupported
+}
+
+// DeleteStartedAboveHeight deletes validator aggregates started above given height
+func (s *ValidatorAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.ValidatorAgg).StartedAtHeight > h
+	}), nil
+}
+
+func (s *ValidatorAgg) filter(filter func(agg *model.ValidatorAgg) bool) []model.ValidatorAgg {
+	var result []model.ValidatorAgg
+	for _, r := range s.find(func(r interface{}) bool {
+		return filter == nil || filter(r.(*model.ValidatorAgg))
+	}) {
+		result = append(result, *r.(*model.ValidatorAgg))
+	}
+	return result
+}
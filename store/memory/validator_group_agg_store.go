@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.ValidatorGroupAgg = (*ValidatorGroupAgg)(nil)
+
+func NewValidatorGroupAggStore() *ValidatorGroupAgg {
+	return &ValidatorGroupAgg{}
+}
+
+// ValidatorGroupAgg handles operations on validator group aggregates
+type ValidatorGroupAgg struct {
+	table
+}
+
+// Create creates the validator group aggregate
+func (s *ValidatorGroupAgg) Create(val *model.ValidatorGroupAgg) error {
+	s.insert(val)
+	return nil
+}
+
+// Save saves the validator group aggregate
+func (s *ValidatorGroupAgg) Save(val *model.ValidatorGroupAgg) error {
+	s.save(val)
+	return nil
+}
+
+// CreateOrUpdate creates a new validator group aggregate. Existing aggregate is kept as it is, the same way as in database store
+func (s *ValidatorGroupAgg) CreateOrUpdate(val *model.ValidatorGroupAgg) error {
+	if _, err := s.FindByAddress(val.Address); err == psql.ErrNotFound {
+		s.insert(val)
+	}
+	return nil
+}
+
+// FindBy returns validator group aggregate for a matching attribute. Only id and address are supported
+func (s *ValidatorGroupAgg) FindBy(key string, value interface{}) (*model.ValidatorGroupAgg, error) {
+	filter, err := aggFilter(key, value, func(r interface{}) (types.ID, string) {
+		agg := r.(*model.ValidatorGroupAgg)
+		return agg.ID, agg.Address
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := s.findFirst(filter, nil)
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.ValidatorGroupAgg), nil
+}
+
+// FindByID returns validator group aggregate with matching ID
+func (s *ValidatorGroupAgg) FindByID(id int64) (*model.ValidatorGroupAgg, error) {
+	return s.FindBy("id", id)
+}
+
+// FindByAddress returns validator group aggregate with matching address
+func (s *ValidatorGroupAgg) FindByAddress(key string) (*model.ValidatorGroupAgg, error) {
+	return s.FindBy("address", key)
+}
+
+// All returns all validator group aggregates
+func (s *ValidatorGroupAgg) All() ([]model.ValidatorGroupAgg, error) {
+	var result []model.ValidatorGroupAgg
+	for _, r := range s.find(nil) {
+		result = append(result, *r.(*model.ValidatorGroupAgg))
+	}
+	return result, nil
+}
+
+// RollbackToHeight is not supported, because it needs validator group sequences
+func (s *ValidatorGroupAgg) RollbackToHeight(int64, types.Time) error {
+	return ErrNotSupported
+}
+
+// DeleteStartedAboveHeight deletes validator group aggregates started above given height
+func (s *ValidatorGroupAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.ValidatorGroupAgg).StartedAtHeight > h
+	}), nil
+}
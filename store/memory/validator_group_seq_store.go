@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.ValidatorGroupSeq = (*ValidatorGroupSeq)(nil)
+
+func NewValidatorGroupSeqStore() *ValidatorGroupSeq {
+	return &ValidatorGroupSeq{}
+}
+
+// ValidatorGroupSeq handles operations on validator group sequences
+type ValidatorGroupSeq struct {
+	table
+}
+
+// BulkUpsert inserts validator group sequences or updates existing ones with the same height and address
+func (s *ValidatorGroupSeq) BulkUpsert(records []model.ValidatorGroupSeq) error {
+	for i := range records {
+		record := records[i]
+		s.upsert(&record, func(r interface{}) bool {
+			seq := r.(*model.ValidatorGroupSeq)
+			return seq.Height == record.Height && seq.Address == record.Address
+		})
+	}
+	return nil
+}
+
+// FindByHeightAndAddress finds validator sequence by height and address
+func (s *ValidatorGroupSeq) FindByHeightAndAddress(height int64, address string) (*model.ValidatorGroupSeq, error) {
+	records := s.filter(func(seq *model.ValidatorGroupSeq) bool {
+		return seq.Height == height && seq.Address == address
+	})
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	return &records[0], nil
+}
+
+// FindByHeight finds validator group sequences by height
+func (s *ValidatorGroupSeq) FindByHeight(h int64) ([]model.ValidatorGroupSeq, error) {
+	return s.filter(func(seq *model.ValidatorGroupSeq) bool {
+		return seq.Height == h
+	}), nil
+}
+
+// FindMostRecent finds most recent validator group sequence
+func (s *ValidatorGroupSeq) FindMostRecent() (*model.ValidatorGroupSeq, error) {
+	records := s.find(nil)
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByTimeDesc(records, func(r interface{}) time.Time { return r.(*model.ValidatorGroupSeq).Time.Time })
+	return records[0].(*model.ValidatorGroupSeq), nil
+}
+
+// FindLastByAddress finds last validator group sequences for given address
+func (s *ValidatorGroupSeq) FindLastByAddress(address string, limit int64) ([]model.ValidatorGroupSeq, error) {
+	records := s.find(func(r interface{}) bool {
+		return r.(*model.ValidatorGroupSeq).Address == address
+	})
+	sortByHeight(records, validatorGroupSeqHeight, true)
+
+	var result []model.ValidatorGroupSeq
+	for _, r := range limitRecords(records, limit) {
+		result = append(result, *r.(*model.ValidatorGroupSeq))
+	}
+	return result, nil
+}
+
+// DeleteOlderThan deletes validator group sequences older than given threshold
+func (s *ValidatorGroupSeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.ValidatorGroupSeq).Time.Before(purgeThreshold)
+	}), nil
+}
+
+// DeleteAboveHeight deletes validator group sequences above given height
+func (s *ValidatorGroupSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return validatorGroupSeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes validator group sequences within given height range
+func (s *ValidatorGroupSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(validatorGroupSeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+// Summarize is not supported
+func (s *ValidatorGroupSeq) Summarize(types.SummaryInterval, []store.ActivityPeriodRow) ([]store.ValidatorGroupSeqSummary, error) {
+	return nil, ErrNotSupported
+}
+
+func (s *ValidatorGroupSeq) filter(filter func(seq *model.ValidatorGroupSeq) bool) []model.ValidatorGroupSeq {
+	var result []model.ValidatorGroupSeq
+	for _, r := range s.find(func(r interface{}) bool {
+		return filter(r.(*model.ValidatorGroupSeq))
+	}) {
+		result = append(result, *r.(*model.ValidatorGroupSeq))
+	}
+	return result
+}
+
+func validatorGroupSeqHeight(r interface{}) int64 {
+	return r.(*model.ValidatorGroupSeq).Height
+}
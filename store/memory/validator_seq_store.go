@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.ValidatorSeq = (*ValidatorSeq)(nil)
+
+func NewValidatorSeqStore() *ValidatorSeq {
+	return &ValidatorSeq{}
+}
+
+// ValidatorSeq handles operations on validator sequences
+type ValidatorSeq struct {
+	table
+}
+
+// BulkUpsert inserts validator sequences or updates existing ones with the same height and address
+func (s *ValidatorSeq) BulkUpsert(records []model.ValidatorSeq) error {
+	for i := range records {
+		record := records[i]
+		s.upsert(&record, func(r interface{}) bool {
+			seq := r.(*model.ValidatorSeq)
+			return seq.Height == record.Height && seq.Address == record.Address
+		})
+	}
+	return nil
+}
+
+// FindByHeightAndAddress finds validator sequence by height and address
+func (s *ValidatorSeq) FindByHeightAndAddress(height int64, address string) (*model.ValidatorSeq, error) {
+	records := s.filter(func(seq *model.ValidatorSeq) bool {
+		return seq.Height == height && seq.Address == address
+	})
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	return &records[0], nil
+}
+
+// FindByHeight finds validator sequences by height
+func (s *ValidatorSeq) FindByHeight(h int64) ([]model.ValidatorSeq, error) {
+	return s.filter(func(seq *model.ValidatorSeq) bool {
+		return seq.Height == h
+	}), nil
+}
+
+// FindMostRecent finds most recent validator sequence
+func (s *ValidatorSeq) FindMostRecent() (*model.ValidatorSeq, error) {
+	records := s.find(nil)
+	if len(records) == 0 {
+		return nil, psql.ErrNotFound
+	}
+	sortByTimeDesc(records, func(r interface{}) time.Time { return r.(*model.ValidatorSeq).Time.Time })
+	return records[0].(*model.ValidatorSeq), nil
+}
+
+// FindLastByAddress finds last validator sequences for given address
+func (s *ValidatorSeq) FindLastByAddress(address string, limit int64) ([]model.ValidatorSeq, error) {
+	records := s.find(func(r interface{}) bool {
+		return r.(*model.ValidatorSeq).Address == address
+	})
+	sortByHeight(records, validatorSeqHeight, true)
+
+	var result []model.ValidatorSeq
+	for _, r := range limitRecords(records, limit) {
+		result = append(result, *r.(*model.ValidatorSeq))
+	}
+	return result, nil
+}
+
+// DeleteOlderThan deletes validator sequences older than given threshold
+func (s *ValidatorSeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.ValidatorSeq).Time.Before(purgeThreshold)
+	}), nil
+}
+
+// DeleteAboveHeight deletes validator sequences above given height
+func (s *ValidatorSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return validatorSeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes validator sequences within given height range
+func (s *ValidatorSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(validatorSeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+// Summarize is not supported
+func (s *ValidatorSeq) Summarize(types.SummaryInterval, []store.ActivityPeriodRow) ([]store.ValidatorSeqSummary, error) {
+	return nil, ErrNotSupported
+}
+
+func (s *ValidatorSeq) filter(filter func(seq *model.ValidatorSeq) bool) []model.ValidatorSeq {
+	var result []model.ValidatorSeq
+	for _, r := range s.find(func(r interface{}) bool {
+		return filter(r.(*model.ValidatorSeq))
+	}) {
+		result = append(result, *r.(*model.ValidatorSeq))
+	}
+	return result
+}
+
+func validatorSeqHeight(r interface{}) int64 {
+	return r.(*model.ValidatorSeq).Height
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.VoterAgg = (*VoterAgg)(nil)
+
+func NewVoterAggStore() *VoterAgg {
+	return &VoterAgg{}
+}
+
+// VoterAgg handles operations on voter aggregates
+type VoterAgg struct {
+	table
+}
+
+// Create creates the voter aggregate
+func (s *VoterAgg) Create(val *model.VoterAgg) error {
+	s.insert(val)
+	return nil
+}
+
+// Save saves the voter aggregate
+func (s *VoterAgg) Save(val *model.VoterAgg) error {
+	s.save(val)
+	return nil
+}
+
+// FindByAddressAndGroup returns position of voter in validator group
+func (s *VoterAgg) FindByAddressAndGroup(address string, group string) (*model.VoterAgg, error) {
+	r, ok := s.findFirst(func(r interface{}) bool {
+		agg := r.(*model.VoterAgg)
+		return agg.Address == address && agg.Group == group
+	}, nil)
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.VoterAgg), nil
+}
+
+// FindByAddress returns positions of voter with pending or active votes, largest first
+func (s *VoterAgg) FindByAddress(address string) ([]model.VoterAgg, error) {
+	return s.filter(func(agg *model.VoterAgg) bool {
+		return agg.Address == address
+	}, 0), nil
+}
+
+// FindTopByGroup returns positions of voters of validator group with most votes
+func (s *VoterAgg) FindTopByGroup(group string, limit int64) ([]model.VoterAgg, error) {
+	return s.filter(func(agg *model.VoterAgg) bool {
+		return agg.Group == group
+	}, limit), nil
+}
+
+// RollbackToHeight is not supported, because it needs account activity sequences
+func (s *VoterAgg) RollbackToHeight(int64, types.Time) error {
+	return ErrNotSupported
+}
+
+// DeleteStartedAboveHeight deletes voter aggregates started above given height
+func (s *VoterAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.VoterAgg).StartedAtHeight > h
+	}), nil
+}
+
+// filter returns matching positions with pending or active votes, sorted by votes descending
+func (s *VoterAgg) filter(filter func(agg *model.VoterAgg) bool, limit int64) []model.VoterAgg {
+	records := s.find(func(r interface{}) bool {
+		agg := r.(*model.VoterAgg)
+		return filter(agg) && totalVotes(agg).Sign() > 0
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return totalVotes(records[i].(*model.VoterAgg)).Cmp(totalVotes(records[j].(*model.VoterAgg))) > 0
+	})
+
+	var result []model.VoterAgg
+	for _, r := range limitRecords(records, limit) {
+		result = append(result, *r.(*model.VoterAgg))
+	}
+	return result
+}
+
+func totalVotes(agg *model.VoterAgg) *big.Int {
+	return new(big.Int).Add(&agg.PendingVotes.Int, &agg.ActiveVotes.Int)
+}
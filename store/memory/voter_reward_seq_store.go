@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+)
+
+var _ store.VoterRewardSeq = (*VoterRewardSeq)(nil)
+
+func NewVoterRewardSeqStore() *VoterRewardSeq {
+	return &VoterRewardSeq{}
+}
+
+// VoterRewardSeq handles operations on voter rewards
+type VoterRewardSeq struct {
+	table
+}
+
+// BulkUpsert inserts voter reward sequences or replaces them when reward of voter for group is already recorded at height
+func (s *VoterRewardSeq) BulkUpsert(records []model.VoterRewardSeq) error {
+	for i := range records {
+		record := records[i]
+		s.upsert(&record, func(r interface{}) bool {
+			existing := r.(*model.VoterRewardSeq)
+			return existing.Height == record.Height && existing.Address == record.Address && existing.Group == record.Group
+		})
+	}
+	return nil
+}
+
+// FindByHeight finds voter reward sequences by height
+func (s *VoterRewardSeq) FindByHeight(h int64) ([]model.VoterRewardSeq, error) {
+	records := s.find(func(r interface{}) bool {
+		return voterRewardSeqHeight(r) == h
+	})
+
+	var result []model.VoterRewardSeq
+	for _, r := range records {
+		result = append(result, *r.(*model.VoterRewardSeq))
+	}
+	return result, nil
+}
+
+// FindByAddress finds page of voter reward sequences of given voter
+func (s *VoterRewardSeq) FindByAddress(address string, query store.AddressHistoryQuery) ([]model.VoterRewardSeq, *store.AddressHistoryCursor, error) {
+	records, nextCursor := s.findAddressHistory(func(r interface{}) bool {
+		return r.(*model.VoterRewardSeq).Address == address
+	}, func(r interface{}) *model.Sequence {
+		return r.(*model.VoterRewardSeq).Sequence
+	}, query)
+
+	var result []model.VoterRewardSeq
+	for _, r := range records {
+		result = append(result, *r.(*model.VoterRewardSeq))
+	}
+	return result, nextCursor, nil
+}
+
+// DeleteForHeight deletes voter reward sequences for given height
+func (s *VoterRewardSeq) DeleteForHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return voterRewardSeqHeight(r) == h
+	}), nil
+}
+
+// DeleteAboveHeight deletes voter reward sequences above given height
+func (s *VoterRewardSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return voterRewardSeqHeight(r) > h
+	}), nil
+}
+
+// DeleteForHeightRange deletes voter reward sequences within given height range
+func (s *VoterRewardSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return inRange(voterRewardSeqHeight(r), startHeight, endHeight)
+	}), nil
+}
+
+func voterRewardSeqHeight(r interface{}) int64 {
+	return r.(*model.VoterRewardSeq).Height
+}
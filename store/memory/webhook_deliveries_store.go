@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.WebhookDeliveries = (*WebhookDeliveries)(nil)
+
+func NewWebhookDeliveriesStore() *WebhookDeliveries {
+	return &WebhookDeliveries{}
+}
+
+// WebhookDeliveries handles operations on webhook deliveries log
+type WebhookDeliveries struct {
+	table
+}
+
+// Create creates the webhook delivery
+func (s *WebhookDeliveries) Create(val *model.WebhookDelivery) error {
+	s.insert(val)
+	return nil
+}
+
+// Save saves the webhook delivery
+func (s *WebhookDeliveries) Save(val *model.WebhookDelivery) error {
+	s.save(val)
+	return nil
+}
+
+// BulkCreateIfNotExists creates webhook deliveries skipping events already enqueued for subscription
+func (s *WebhookDeliveries) BulkCreateIfNotExists(records []model.WebhookDelivery) error {
+	for i := range records {
+		r := records[i]
+		_, ok := s.findFirst(func(rec interface{}) bool {
+			d := rec.(*model.WebhookDelivery)
+			return d.SubscriptionID == r.SubscriptionID && d.Height == r.Height && d.Actor == r.Actor && d.Kind == r.Kind
+		}, nil)
+		if !ok {
+			s.insert(&r)
+		}
+	}
+	return nil
+}
+
+// FindPending returns deliveries which are due for next attempt at given time, oldest first.
+// Delivery waiting behind earlier pending delivery of the same subscription is skipped, so events are sent in order
+func (s *WebhookDeliveries) FindPending(t time.Time, limit int64) ([]model.WebhookDelivery, error) {
+	blocked := map[types.ID]bool{}
+	records := s.find(func(r interface{}) bool {
+		d := r.(*model.WebhookDelivery)
+		if !d.Pending() || blocked[d.SubscriptionID] {
+			return false
+		}
+		blocked[d.SubscriptionID] = true
+		return !d.NextAttemptAt.After(t)
+	})
+
+	var result []model.WebhookDelivery
+	for _, r := range limitRecords(records, limit) {
+		result = append(result, *r.(*model.WebhookDelivery))
+	}
+	return result, nil
+}
+
+// FindBySubscriptionID returns most recent deliveries of subscription
+func (s *WebhookDeliveries) FindBySubscriptionID(subscriptionID types.ID, limit int64) ([]model.WebhookDelivery, error) {
+	records := s.find(func(r interface{}) bool {
+		return r.(*model.WebhookDelivery).SubscriptionID == subscriptionID
+	})
+
+	var result []model.WebhookDelivery
+	for _, r := range limitRecords(reverse(records), limit) {
+		result = append(result, *r.(*model.WebhookDelivery))
+	}
+	return result, nil
+}
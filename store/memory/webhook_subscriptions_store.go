@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+var _ store.WebhookSubscriptions = (*WebhookSubscriptions)(nil)
+
+func NewWebhookSubscriptionsStore() *WebhookSubscriptions {
+	return &WebhookSubscriptions{}
+}
+
+// WebhookSubscriptions handles operations on webhook subscriptions
+type WebhookSubscriptions struct {
+	table
+}
+
+// Create creates the webhook subscription
+func (s *WebhookSubscriptions) Create(val *model.WebhookSubscription) error {
+	s.insert(val)
+	return nil
+}
+
+// FindByID returns webhook subscription by id
+func (s *WebhookSubscriptions) FindByID(id types.ID) (*model.WebhookSubscription, error) {
+	r, ok := s.findFirst(func(r interface{}) bool {
+		return r.(*model.WebhookSubscription).ID == id
+	}, nil)
+	if !ok {
+		return nil, psql.ErrNotFound
+	}
+	return r.(*model.WebhookSubscription), nil
+}
+
+// FindAll returns all webhook subscriptions
+func (s *WebhookSubscriptions) FindAll() ([]model.WebhookSubscription, error) {
+	var result []model.WebhookSubscription
+	for _, r := range s.find(nil) {
+		result = append(result, *r.(*model.WebhookSubscription))
+	}
+	return result, nil
+}
+
+// DeleteByID deletes webhook subscription
+func (s *WebhookSubscriptions) DeleteByID(id types.ID) (*int64, error) {
+	return s.delete(func(r interface{}) bool {
+		return r.(*model.WebhookSubscription).ID == id
+	}), nil
+}
@@ -7,6 +7,8 @@ var (
 		  time,
 		  transaction_hash,
 		  address,
+		  counterparty,
+		  token,
 		  amount,
 		  kind,
           data
@@ -37,6 +37,8 @@ func (s AccountActivitySeq) BulkUpsert(records []model.AccountActivitySeq) error
 				r.Time,
 				r.TransactionHash,
 				r.Address,
+				r.Counterparty,
+				r.Token,
 				r.Amount.String(),
 				r.Kind,
 				r.Data,
@@ -121,6 +123,57 @@ func (s AccountActivitySeq) FindLastByAddressAndKind(address string, kind string
 	return result, checkErr(err)
 }
 
+// FindByAddress finds page of account activity sequences for given address
+func (s AccountActivitySeq) FindByAddress(address string, query store.AddressHistoryQuery) ([]model.AccountActivitySeq, *store.AddressHistoryCursor, error) {
+	tx := s.db.Where("address = ?", address)
+
+	if len(query.Kinds) > 0 {
+		tx = tx.Where("kind IN (?)", query.Kinds)
+	}
+
+	switch query.Direction {
+	case store.AddressHistoryDirectionSent:
+		tx = tx.Where("kind LIKE ?", "%Sent")
+	case store.AddressHistoryDirectionReceived:
+		tx = tx.Where("kind LIKE ?", "%Received")
+	}
+
+	var result []model.AccountActivitySeq
+	if err := findAddressHistory(tx, query, &result); err != nil {
+		return nil, nil, checkErr(err)
+	}
+
+	if len(result) == 0 {
+		return result, nil, nil
+	}
+	return result, nextAddressHistoryCursor(query, len(result), result[len(result)-1].Height, result[len(result)-1].ID), nil
+}
+
+// FindTransfers finds page of token transfers, optionally of given token and sent or received by given address
+func (s AccountActivitySeq) FindTransfers(token string, address string, query store.AddressHistoryQuery) ([]model.AccountActivitySeq, *store.AddressHistoryCursor, error) {
+	// Only token transfers carry token. Every transfer is indexed as sent and received sequence, sent ones are enough to list them
+	tx := s.db.
+		Where("token <> ''").
+		Where("kind LIKE ?", "%Sent")
+
+	if token != "" {
+		tx = tx.Where("token = ?", token)
+	}
+	if address != "" {
+		tx = tx.Where("address = ? OR counterparty = ?", address, address)
+	}
+
+	var result []model.AccountActivitySeq
+	if err := findAddressHistory(tx, query, &result); err != nil {
+		return nil, nil, checkErr(err)
+	}
+
+	if len(result) == 0 {
+		return result, nil, nil
+	}
+	return result, nextAddressHistoryCursor(query, len(result), result[len(result)-1].Height, result[len(result)-1].ID), nil
+}
+
 // DeleteOlderThan deletes account activity sequence older than given threshold
 func (s *AccountActivitySeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
 	tx := s.db.
@@ -147,4 +200,32 @@ func (s *AccountActivitySeq) DeleteForHeight(h int64) (*int64, error) {
 	}
 
 	return &tx.RowsAffected, nil
-}
\ No newline at end of file
+}
+
+// DeleteAboveHeight deletes account activity sequences above given height
+func (s *AccountActivitySeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.AccountActivitySeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes account activity sequences within given height range
+func (s *AccountActivitySeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.AccountActivitySeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
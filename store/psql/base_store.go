@@ -3,6 +3,7 @@ package psql
 import (
 	"fmt"
 	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
 
 	"github.com/figment-networks/indexing-engine/store/bulk"
 	"github.com/jinzhu/gorm"
@@ -71,6 +72,41 @@ func findMostRecent(db *gorm.DB, orderField string, record interface{}) error {
 		Error
 }
 
+// findAddressHistory finds page of records matching height, time and cursor filters of query, the newest records first
+func findAddressHistory(db *gorm.DB, query store.AddressHistoryQuery, dst interface{}) error {
+	if query.StartHeight != nil {
+		db = db.Where("height >= ?", *query.StartHeight)
+	}
+	if query.EndHeight != nil {
+		db = db.Where("height <= ?", *query.EndHeight)
+	}
+	if query.StartTime != nil {
+		db = db.Where("time >= ?", *query.StartTime)
+	}
+	if query.EndTime != nil {
+		db = db.Where("time <= ?", *query.EndTime)
+	}
+	if query.Cursor != nil {
+		db = db.Where("(height, id) < (?, ?)", query.Cursor.Height, query.Cursor.ID)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+
+	return db.
+		Order("height DESC, id DESC").
+		Find(dst).
+		Error
+}
+
+// nextAddressHistoryCursor returns cursor of page following the one ending with given sequence. It returns nil when there are no more pages
+func nextAddressHistoryCursor(query store.AddressHistoryQuery, count int, lastHeight int64, lastId types.ID) *store.AddressHistoryCursor {
+	if query.Limit <= 0 || int64(count) < query.Limit {
+		return nil
+	}
+	return &store.AddressHistoryCursor{Height: lastHeight, ID: int64(lastId)}
+}
+
 func checkErr(err error) error {
 	if gorm.IsRecordNotFoundError(err) {
 		return ErrNotFound
@@ -153,3 +153,31 @@ func (s *BlockSeq) Summarize(interval types.SummaryInterval, activityPeriods []s
 	}
 	return models, nil
 }
+
+// DeleteAboveHeight deletes block sequences above given height
+func (s *BlockSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.BlockSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes block sequences within given height range
+func (s *BlockSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.BlockSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,24 @@
+package psql
+
+const (
+	bulkInsertElectedValidatorSeqs = `
+		INSERT INTO elected_validator_sequences (
+		  height,
+		  time,
+		  epoch,
+		  address,
+		  signer,
+		  "group",
+		  index
+		)
+		VALUES @values
+
+		ON CONFLICT (epoch, address) DO UPDATE
+		SET
+		  height = excluded.height,
+		  time = excluded.time,
+		  signer = excluded.signer,
+		  "group" = excluded."group",
+		  index = excluded.index;
+	`
+)
@@ -0,0 +1,103 @@
+package psql
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.ElectedValidatorSeq = (*ElectedValidatorSeq)(nil)
+
+func NewElectedValidatorSeqStore(db *gorm.DB) *ElectedValidatorSeq {
+	return &ElectedValidatorSeq{scoped(db, model.ElectedValidatorSeq{})}
+}
+
+// ElectedValidatorSeq handles operations on elected validators
+type ElectedValidatorSeq struct {
+	baseStore
+}
+
+// BulkUpsert inserts elected validator sequences or replaces them when validator is already recorded for epoch
+func (s ElectedValidatorSeq) BulkUpsert(records []model.ElectedValidatorSeq) error {
+	var err error
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.baseStore.BulkUpsert(bulkInsertElectedValidatorSeqs, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				r.Height,
+				r.Time,
+				r.Epoch,
+				r.Address,
+				r.Signer,
+				r.Group,
+				r.Index,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByEpoch finds validators elected for given epoch ordered by their index in elected set
+func (s ElectedValidatorSeq) FindByEpoch(epoch int64) ([]model.ElectedValidatorSeq, error) {
+	var result []model.ElectedValidatorSeq
+
+	err := s.db.
+		Where("epoch = ?", epoch).
+		Order("index ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// DeleteForHeight deletes elected validator sequences for given height
+func (s *ElectedValidatorSeq) DeleteForHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height = ?", h).
+		Delete(&model.ElectedValidatorSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteAboveHeight deletes elected validator sequences above given height
+func (s *ElectedValidatorSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.ElectedValidatorSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes elected validator sequences within given height range
+func (s *ElectedValidatorSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.ElectedValidatorSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,33 @@
+package psql
+
+const (
+	bulkInsertEpochSummaries = `
+		INSERT INTO epoch_summaries (
+		  height,
+		  time,
+		  epoch,
+		  target_voting_yield,
+		  rewards_multiplier,
+		  elected_validators_count,
+		  validator_rewards,
+		  voter_rewards,
+		  community_fund_rewards,
+		  carbon_fund_rewards,
+		  total_votes
+		)
+		VALUES @values
+
+		ON CONFLICT (epoch) DO UPDATE
+		SET
+		  height = excluded.height,
+		  time = excluded.time,
+		  target_voting_yield = excluded.target_voting_yield,
+		  rewards_multiplier = excluded.rewards_multiplier,
+		  elected_validators_count = excluded.elected_validators_count,
+		  validator_rewards = excluded.validator_rewards,
+		  voter_rewards = excluded.voter_rewards,
+		  community_fund_rewards = excluded.community_fund_rewards,
+		  carbon_fund_rewards = excluded.carbon_fund_rewards,
+		  total_votes = excluded.total_votes;
+	`
+)
@@ -0,0 +1,118 @@
+package psql
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.EpochSummary = (*EpochSummary)(nil)
+
+func NewEpochSummaryStore(db *gorm.DB) *EpochSummary {
+	return &EpochSummary{scoped(db, model.EpochSummary{})}
+}
+
+// EpochSummary handles operations on epoch summaries
+type EpochSummary struct {
+	baseStore
+}
+
+// BulkUpsert inserts epoch summaries in bulk or updates them when summary of epoch already exists
+func (s EpochSummary) BulkUpsert(records []model.EpochSummary) error {
+	var err error
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.baseStore.BulkUpsert(bulkInsertEpochSummaries, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				r.Height,
+				r.Time,
+				r.Epoch,
+				r.TargetVotingYield.String(),
+				r.RewardsMultiplier.String(),
+				r.ElectedValidatorsCount,
+				r.ValidatorRewards.String(),
+				r.VoterRewards.String(),
+				r.CommunityFundRewards.String(),
+				r.CarbonFundRewards.String(),
+				r.TotalVotes.String(),
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByEpoch finds summary of given epoch
+func (s EpochSummary) FindByEpoch(epoch int64) (*model.EpochSummary, error) {
+	result := &model.EpochSummary{}
+
+	err := s.db.
+		Where("epoch = ?", epoch).
+		First(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// All finds page of epoch summaries, the most recent epochs first. Cursor is the last epoch of previous page
+func (s EpochSummary) All(limit int64, cursor *int64) ([]model.EpochSummary, *int64, error) {
+	var result []model.EpochSummary
+
+	tx := s.db.
+		Order("epoch DESC")
+
+	if cursor != nil {
+		tx = tx.Where("epoch < ?", *cursor)
+	}
+
+	if limit > 0 {
+		tx = tx.Limit(limit)
+	}
+
+	if err := tx.Find(&result).Error; err != nil {
+		return nil, nil, checkErr(err)
+	}
+
+	if limit <= 0 || int64(len(result)) < limit {
+		return result, nil, nil
+	}
+	nextCursor := result[len(result)-1].Epoch
+	return result, &nextCursor, nil
+}
+
+// DeleteAboveHeight deletes epoch summaries above given height
+func (s *EpochSummary) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.EpochSummary{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes epoch summaries within given height range
+func (s *EpochSummary) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.EpochSummary{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
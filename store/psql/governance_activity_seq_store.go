@@ -188,3 +188,31 @@ func (s *GovernanceActivitySeq) DeleteForHeight(h int64) (*int64, error) {
 
 	return &tx.RowsAffected, nil
 }
+
+// DeleteAboveHeight deletes governance activity sequences above given height
+func (s *GovernanceActivitySeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.GovernanceActivitySeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes governance activity sequences within given height range
+func (s *GovernanceActivitySeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.GovernanceActivitySeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
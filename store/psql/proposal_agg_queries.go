@@ -0,0 +1,64 @@
+package psql
+
+const (
+	rollbackProposalAggVotesQuery = `
+		UPDATE proposal_aggregates
+		SET
+		  yes_votes_total = s.yes_votes_total,
+		  yes_votes_weight_total = s.yes_votes_weight_total::TEXT,
+		  no_votes_total = s.no_votes_total,
+		  no_votes_weight_total = s.no_votes_weight_total::TEXT,
+		  abstain_votes_total = s.abstain_votes_total,
+		  abstain_votes_weight_total = s.abstain_votes_weight_total::TEXT,
+		  votes_total = s.votes_total,
+		  votes_weight_total = s.votes_weight_total::TEXT
+		FROM (
+		  SELECT
+		    agg.id,
+		    COUNT(CASE WHEN seq.kind = 'ProposalVoted' AND (seq.data->>'Value')::INT = 3 THEN 1 END) AS yes_votes_total,
+		    COALESCE(SUM(CASE WHEN seq.kind = 'ProposalVoted' AND (seq.data->>'Value')::INT = 3 THEN (seq.data->>'Weight')::DECIMAL(65, 0) END), 0) AS yes_votes_weight_total,
+		    COUNT(CASE WHEN seq.kind = 'ProposalVoted' AND (seq.data->>'Value')::INT = 2 THEN 1 END) AS no_votes_total,
+		    COALESCE(SUM(CASE WHEN seq.kind = 'ProposalVoted' AND (seq.data->>'Value')::INT = 2 THEN (seq.data->>'Weight')::DECIMAL(65, 0) END), 0) AS no_votes_weight_total,
+		    COUNT(CASE WHEN seq.kind = 'ProposalVoted' AND (seq.data->>'Value')::INT = 1 THEN 1 END) AS abstain_votes_total,
+		    COALESCE(SUM(CASE WHEN seq.kind = 'ProposalVoted' AND (seq.data->>'Value')::INT = 1 THEN (seq.data->>'Weight')::DECIMAL(65, 0) END), 0) AS abstain_votes_weight_total,
+		    COUNT(CASE WHEN seq.kind = 'ProposalVoted' THEN 1 END) AS votes_total,
+		    COALESCE(SUM(CASE seq.kind
+		      WHEN 'ProposalVoted' THEN (seq.data->>'Weight')::DECIMAL(65, 0)
+		      WHEN 'ProposalUpvoted' THEN (seq.data->>'Upvotes')::DECIMAL(65, 0)
+		    END), 0) AS votes_weight_total
+		  FROM proposal_aggregates AS agg
+		  LEFT JOIN governance_activity_sequences AS seq ON seq.proposal_id = agg.proposal_id AND seq.height <= ?
+		  WHERE agg.recent_at_height > ?
+		  GROUP BY agg.id
+		) AS s
+		WHERE proposal_aggregates.id = s.id;
+	`
+
+	rollbackProposalAggStagesQuery = `
+		UPDATE proposal_aggregates
+		SET
+		  dequeued_at_height = CASE WHEN dequeued_at_height > ? THEN 0 ELSE dequeued_at_height END,
+		  dequeued_at = CASE WHEN dequeued_at_height > ? THEN ? ELSE dequeued_at END,
+		  approved_at_height = CASE WHEN approved_at_height > ? THEN 0 ELSE approved_at_height END,
+		  approved_at = CASE WHEN approved_at_height > ? THEN ? ELSE approved_at END,
+		  executed_at_height = CASE WHEN executed_at_height > ? THEN 0 ELSE executed_at_height END,
+		  executed_at = CASE WHEN executed_at_height > ? THEN ? ELSE executed_at END,
+		  expired_at_height = CASE WHEN expired_at_height > ? THEN 0 ELSE expired_at_height END,
+		  expired_at = CASE WHEN expired_at_height > ? THEN ? ELSE expired_at END,
+		  recent_stage = COALESCE((
+		    SELECT stages.stage
+		    FROM (VALUES
+		      ('dequeued', dequeued_at_height),
+		      ('approved', approved_at_height),
+		      ('executed', executed_at_height),
+		      ('expired', expired_at_height)
+		    ) AS stages (stage, height)
+		    WHERE stages.height > 0 AND stages.height <= ?
+		    ORDER BY stages.height DESC
+		    LIMIT 1
+		  ), 'proposed'),
+		  recent_at_height = ?,
+		  recent_at = ?
+		WHERE recent_at_height > ?;
+	`
+)
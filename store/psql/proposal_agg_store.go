@@ -3,6 +3,7 @@ package psql
 import (
 	"github.com/figment-networks/celo-indexer/model"
 	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -84,3 +85,41 @@ func (s ProposalAgg) All(limit int64, cursor *int64) ([]model.ProposalAgg, *int6
 
 	return result, &nextCursor, nil
 }
+
+// RollbackToHeight recalculates vote totals from activities up to given height, reverts stages reached above it
+// and resets recent height of proposals to it. Proposals which recent height is already reset are skipped,
+// so it is safe to run again when rollback is interrupted
+func (s *ProposalAgg) RollbackToHeight(h int64, t types.Time) error {
+	var zeroTime types.Time
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(rollbackProposalAggVotesQuery, h, h).Error; err != nil {
+			return err
+		}
+		return tx.Exec(rollbackProposalAggStagesQuery,
+			h, h, zeroTime,
+			h, h, zeroTime,
+			h, h, zeroTime,
+			h, h, zeroTime,
+			h,
+			h, t,
+			h,
+		).Error
+	})
+
+	return checkErr(err)
+}
+
+// DeleteStartedAboveHeight deletes proposal aggregates started above given height
+func (s *ProposalAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("started_at_height > ?", h).
+		Delete(&model.ProposalAgg{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
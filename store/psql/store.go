@@ -37,6 +37,9 @@ type Store struct {
 	validators      *validators
 	validatorGroups *validatorGroups
 	governance      *governance
+	transactions    *transactions
+	epochs          *epochs
+	webhooks        *webhooks
 }
 
 type core struct {
@@ -48,6 +51,8 @@ type core struct {
 
 type accounts struct {
 	*AccountActivitySeq
+	*VoterRewardSeq
+	*VoterAgg
 }
 
 type blocks struct {
@@ -72,11 +77,27 @@ type governance struct {
 	*GovernanceActivitySeq
 }
 
+type transactions struct {
+	*TransactionSeq
+}
+
+type epochs struct {
+	*EpochSummary
+	*ElectedValidatorSeq
+}
+
+type webhooks struct {
+	*WebhookSubscriptions
+	*WebhookDeliveries
+}
+
 // GetAccounts gets accounts
 func (s *Store) GetAccounts() *accounts {
 	if s.accounts == nil {
 		s.accounts = &accounts{
 			NewAccountActivitySeqStore(s.db),
+			NewVoterRewardSeqStore(s.db),
+			NewVoterAggStore(s.db),
 		}
 	}
 	return s.accounts
@@ -141,6 +162,38 @@ func (s *Store) GetGovernance() *governance {
 	return s.governance
 }
 
+// GetTransactions gets transactions
+func (s *Store) GetTransactions() *transactions {
+	if s.transactions == nil {
+		s.transactions = &transactions{
+			NewTransactionSeqStore(s.db),
+		}
+	}
+	return s.transactions
+}
+
+// GetEpochs gets epochs
+func (s *Store) GetEpochs() *epochs {
+	if s.epochs == nil {
+		s.epochs = &epochs{
+			NewEpochSummaryStore(s.db),
+			NewElectedValidatorSeqStore(s.db),
+		}
+	}
+	return s.epochs
+}
+
+// GetWebhooks gets webhooks
+func (s *Store) GetWebhooks() *webhooks {
+	if s.webhooks == nil {
+		s.webhooks = &webhooks{
+			NewWebhookSubscriptionsStore(s.db),
+			NewWebhookDeliveriesStore(s.db),
+		}
+	}
+	return s.webhooks
+}
+
 // Test checks the connection status
 func (s *Store) Test() error {
 	return s.db.DB().Ping()
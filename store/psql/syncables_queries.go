@@ -0,0 +1,30 @@
+package psql
+
+const (
+	// findSyncableGapsQuery finds holes between indexed heights and ranges of consecutive unprocessed heights
+	findSyncableGapsQuery = `
+		WITH indexed AS (
+		  SELECT height, LEAD(height) OVER (ORDER BY height) AS next_height
+		  FROM syncables
+		),
+		missing AS (
+		  SELECT height + 1 AS start_height, next_height - 1 AS end_height
+		  FROM indexed
+		  WHERE next_height - height > 1
+		),
+		unprocessed AS (
+		  SELECT MIN(height) AS start_height, MAX(height) AS end_height
+		  FROM (
+		    SELECT height, height - ROW_NUMBER() OVER (ORDER BY height) AS grp
+		    FROM syncables
+		    WHERE processed_at IS NULL
+		  ) t
+		  GROUP BY grp
+		)
+		SELECT start_height, end_height FROM missing
+		UNION ALL
+		SELECT start_height, end_height FROM unprocessed
+		ORDER BY start_height
+		LIMIT ?
+	`
+)
@@ -128,3 +128,43 @@ func (s Syncables) SetProcessedAtForRange(reportID types.ID, startHeight int64,
 
 	return checkErr(err)
 }
+
+// DeleteAboveHeight deletes syncables above given height
+func (s *Syncables) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.Syncable{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// FindGaps returns ranges of heights which are missing or not processed within indexed range.
+// When limit is greater than 0 only the lowest limit gaps are returned
+func (s *Syncables) FindGaps(limit int64) ([]store.SyncableGap, error) {
+	// LIMIT NULL returns all rows
+	var limitArg interface{}
+	if limit > 0 {
+		limitArg = limit
+	}
+
+	rows, err := s.db.Raw(findSyncableGapsQuery, limitArg).Rows()
+	if err != nil {
+		return nil, checkErr(err)
+	}
+	defer rows.Close()
+
+	var gaps []store.SyncableGap
+	for rows.Next() {
+		var gap store.SyncableGap
+		if err := s.db.ScanRows(rows, &gap); err != nil {
+			return nil, err
+		}
+		gaps = append(gaps, gap)
+	}
+	return gaps, nil
+}
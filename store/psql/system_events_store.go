@@ -145,3 +145,31 @@ func (s *SystemEvents) DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
 
 	return &tx.RowsAffected, checkErr(tx.Error)
 }
+
+// DeleteAboveHeight deletes system events above given height
+func (s *SystemEvents) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.SystemEvent{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes system events within given height range
+func (s *SystemEvents) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.SystemEvent{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
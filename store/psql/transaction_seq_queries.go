@@ -0,0 +1,24 @@
+package psql
+
+var (
+	bulkInsertTransactionSeqs = `
+		INSERT INTO transaction_sequences (
+		  height,
+		  time,
+		  hash,
+		  index,
+		  from_address,
+		  to_address,
+		  nonce,
+		  gas,
+		  gas_price,
+		  gas_used,
+		  fee_currency,
+		  gateway_fee,
+		  gateway_fee_recipient,
+		  success,
+		  operations
+		)
+		VALUES @values;
+	`
+)
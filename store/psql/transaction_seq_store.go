@@ -0,0 +1,180 @@
+package psql
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.TransactionSeq = (*TransactionSeq)(nil)
+
+func NewTransactionSeqStore(db *gorm.DB) *TransactionSeq {
+	return &TransactionSeq{scoped(db, model.TransactionSeq{})}
+}
+
+// TransactionSeq handles operations on transactions
+type TransactionSeq struct {
+	baseStore
+}
+
+// BulkUpsert insert transaction sequences in bulk
+func (s TransactionSeq) BulkUpsert(records []model.TransactionSeq) error {
+	var err error
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.baseStore.BulkUpsert(bulkInsertTransactionSeqs, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				r.Height,
+				r.Time,
+				r.Hash,
+				r.Index,
+				r.FromAddress,
+				r.ToAddress,
+				r.Nonce,
+				r.Gas,
+				r.GasPrice.String(),
+				r.GasUsed,
+				r.FeeCurrency,
+				r.GatewayFee.String(),
+				r.GatewayFeeRecipient,
+				r.Success,
+				r.Operations,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByHeight finds transaction sequences by height
+func (s TransactionSeq) FindByHeight(h int64) ([]model.TransactionSeq, error) {
+	var result []model.TransactionSeq
+
+	err := s.db.
+		Where("height = ?", h).
+		Order("index ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByHash finds transaction sequence by hash
+func (s TransactionSeq) FindByHash(hash string) (*model.TransactionSeq, error) {
+	result := &model.TransactionSeq{}
+
+	err := s.db.
+		Where("hash = ?", hash).
+		Order("height DESC").
+		First(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByAddress finds page of transaction sequences sent or received by given address
+func (s TransactionSeq) FindByAddress(address string, query store.AddressHistoryQuery) ([]model.TransactionSeq, *store.AddressHistoryCursor, error) {
+	var tx *gorm.DB
+	switch query.Direction {
+	case store.AddressHistoryDirectionSent:
+		tx = s.db.Where("from_address = ?", address)
+	case store.AddressHistoryDirectionReceived:
+		tx = s.db.Where("to_address = ?", address)
+	default:
+		tx = s.db.Where("from_address = ? OR to_address = ?", address, address)
+	}
+
+	// Transaction matches kind when any of its operations has that name
+	if len(query.Kinds) > 0 {
+		var conditions []string
+		var args []interface{}
+		for _, kind := range query.Kinds {
+			operations, err := json.Marshal([]map[string]string{{"name": kind}})
+			if err != nil {
+				return nil, nil, err
+			}
+			conditions = append(conditions, "operations @> ?::jsonb")
+			args = append(args, string(operations))
+		}
+		tx = tx.Where(strings.Join(conditions, " OR "), args...)
+	}
+
+	var result []model.TransactionSeq
+	if err := findAddressHistory(tx, query, &result); err != nil {
+		return nil, nil, checkErr(err)
+	}
+
+	if len(result) == 0 {
+		return result, nil, nil
+	}
+	return result, nextAddressHistoryCursor(query, len(result), result[len(result)-1].Height, result[len(result)-1].ID), nil
+}
+
+// DeleteOlderThan deletes transaction sequences older than given threshold
+func (s *TransactionSeq) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("time < ?", purgeThreshold).
+		Delete(&model.TransactionSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeight deletes transaction sequences for given height
+func (s *TransactionSeq) DeleteForHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height = ?", h).
+		Delete(&model.TransactionSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteAboveHeight deletes transaction sequences above given height
+func (s *TransactionSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.TransactionSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes transaction sequences within given height range
+func (s *TransactionSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.TransactionSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,39 @@
+package psql
+
+const (
+	rollbackValidatorAggUptimeQuery = `
+		UPDATE validator_aggregates
+		SET
+		  accumulated_uptime = validator_aggregates.accumulated_uptime - s.signed_count,
+		  accumulated_uptime_count = validator_aggregates.accumulated_uptime_count - s.total_count,
+		  proposed_blocks_count = GREATEST(validator_aggregates.proposed_blocks_count - s.proposed_count, 0),
+		  recent_missed_blocks = s.remaining_missed_blocks,
+		  missed_in_row_count = CASE
+		    WHEN position(B'0' in s.remaining_missed_blocks) > 0 THEN position(B'0' in s.remaining_missed_blocks) - 1
+		    ELSE GREATEST(validator_aggregates.missed_in_row_count - s.recorded_count, length(s.remaining_missed_blocks))
+		  END
+		FROM (
+		  SELECT
+		    seq.address,
+		    COUNT(CASE WHEN seq.signed THEN 1 END) AS signed_count,
+		    COUNT(seq.signed) AS total_count,
+		    COUNT(CASE WHEN seq.proposed THEN 1 END) AS proposed_count,
+		    COUNT(*) AS recorded_count,
+		    substring(agg.recent_missed_blocks from COUNT(*)::int + 1) AS remaining_missed_blocks
+		  FROM validator_sequences AS seq
+		  JOIN validator_aggregates AS agg ON agg.address = seq.address
+		  WHERE seq.height > ? AND agg.recent_at_height > ?
+		  GROUP BY seq.address, agg.recent_missed_blocks
+		) AS s
+		WHERE validator_aggregates.address = s.address;
+	`
+
+	rollbackValidatorAggRecentQuery = `
+		UPDATE validator_aggregates
+		SET
+		  recent_at_height = ?,
+		  recent_at = ?,
+		  recent_as_validator_height = LEAST(recent_as_validator_height, ?)
+		WHERE recent_at_height > ?;
+	`
+)
@@ -3,6 +3,7 @@ package psql
 import (
 	"github.com/figment-networks/celo-indexer/model"
 	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -67,3 +68,30 @@ func (s ValidatorAgg) All() ([]model.ValidatorAgg, error) {
 
 	return result, checkErr(err)
 }
+
+// RollbackToHeight reverts uptime and recent missed blocks recorded above given height and resets recent height to it.
+// Aggregates which recent height is already reset are skipped, so it is safe to run again when rollback is interrupted
+func (s *ValidatorAgg) RollbackToHeight(h int64, t types.Time) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(rollbackValidatorAggUptimeQuery, h, h).Error; err != nil {
+			return err
+		}
+		return tx.Exec(rollbackValidatorAggRecentQuery, h, t, h, h).Error
+	})
+
+	return checkErr(err)
+}
+
+// DeleteStartedAboveHeight deletes validator aggregates started above given height
+func (s *ValidatorAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("started_at_height > ?", h).
+		Delete(&model.ValidatorAgg{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,32 @@
+package psql
+
+const (
+	rollbackValidatorGroupAggRecentMissedBlocksQuery = `
+		UPDATE validator_group_aggregates
+		SET
+		  recent_missed_blocks = s.remaining_missed_blocks,
+		  missed_in_row_count = CASE
+		    WHEN position(B'0' in s.remaining_missed_blocks) > 0 THEN position(B'0' in s.remaining_missed_blocks) - 1
+		    ELSE GREATEST(validator_group_aggregates.missed_in_row_count - s.recorded_count, length(s.remaining_missed_blocks))
+		  END
+		FROM (
+		  SELECT
+		    seq.address,
+		    COUNT(*) AS recorded_count,
+		    substring(agg.recent_missed_blocks from COUNT(*)::int + 1) AS remaining_missed_blocks
+		  FROM validator_group_sequences AS seq
+		  JOIN validator_group_aggregates AS agg ON agg.address = seq.address
+		  WHERE seq.height > ? AND agg.recent_at_height > ?
+		  GROUP BY seq.address, agg.recent_missed_blocks
+		) AS s
+		WHERE validator_group_aggregates.address = s.address;
+	`
+
+	rollbackValidatorGroupAggRecentQuery = `
+		UPDATE validator_group_aggregates
+		SET
+		  recent_at_height = ?,
+		  recent_at = ?
+		WHERE recent_at_height > ?;
+	`
+)
@@ -177,3 +177,31 @@ func (s *ValidatorGroupSeq) Summarize(interval types.SummaryInterval, activityPe
 	}
 	return models, nil
 }
+
+// DeleteAboveHeight deletes validator group sequences above given height
+func (s *ValidatorGroupSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.ValidatorGroupSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes validator group sequences within given height range
+func (s *ValidatorGroupSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.ValidatorGroupSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -8,7 +8,8 @@ const (
 		  address,
 		  affiliation,
 		  signed,
-          score
+          score,
+		  proposed
 		)
 		VALUES @values
 		
@@ -16,6 +17,7 @@ const (
 		SET
 		  affiliation = excluded.affiliation,
 		  signed = excluded.signed,
+		  proposed = excluded.proposed,
 		  score = excluded.score;
 	`
 
@@ -37,6 +39,7 @@ const (
 		validator_sequences.address,
 		validator_sequences.affiliation,
 		validator_sequences.signed,
+		validator_sequences.proposed,
 		validator_sequences.score,
 		validator_aggregates.recent_name as recent_name,
 		validator_aggregates.recent_metadata_url as recent_metadata_url
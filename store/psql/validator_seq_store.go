@@ -42,6 +42,7 @@ func (s ValidatorSeq) BulkUpsert(records []model.ValidatorSeq) error {
 				r.Affiliation,
 				r.Signed,
 				r.Score.String(),
+				r.Proposed,
 			}
 		})
 		if err != nil {
@@ -174,3 +175,31 @@ func (s *ValidatorSeq) Summarize(interval types.SummaryInterval, activityPeriods
 	}
 	return models, nil
 }
+
+// DeleteAboveHeight deletes validator sequences above given height
+func (s *ValidatorSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.ValidatorSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes validator sequences within given height range
+func (s *ValidatorSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.ValidatorSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,55 @@
+package psql
+
+const (
+	rollbackVoterAggVotesQuery = `
+		UPDATE voter_aggregates
+		SET
+		  pending_votes = GREATEST(voter_aggregates.pending_votes - s.pending_votes, 0),
+		  active_votes = GREATEST(voter_aggregates.active_votes - s.active_votes, 0)
+		FROM (
+		  SELECT
+		    d.address,
+		    d.group,
+		    SUM(d.pending_votes) AS pending_votes,
+		    SUM(d.active_votes) AS active_votes
+		  FROM (
+		    SELECT
+		      lower(address) AS address,
+		      lower(data->>'Group') AS group,
+		      CASE kind
+		        WHEN 'ValidatorGroupVoteCastSent' THEN amount
+		        WHEN 'ValidatorGroupVoteActivatedSent' THEN -amount
+		        WHEN 'ValidatorGroupPendingVoteRevokedSent' THEN -amount
+		        ELSE 0
+		      END AS pending_votes,
+		      CASE kind
+		        WHEN 'ValidatorGroupVoteActivatedSent' THEN amount
+		        WHEN 'ValidatorGroupActiveVoteRevokedSent' THEN -amount
+		        ELSE 0
+		      END AS active_votes
+		    FROM account_activity_sequences
+		    WHERE height > ?
+		      AND kind IN ('ValidatorGroupVoteCastSent', 'ValidatorGroupVoteActivatedSent', 'ValidatorGroupPendingVoteRevokedSent', 'ValidatorGroupActiveVoteRevokedSent')
+		    UNION ALL
+		    SELECT
+		      lower(address) AS address,
+		      lower("group") AS group,
+		      0 AS pending_votes,
+		      amount AS active_votes
+		    FROM voter_reward_sequences
+		    WHERE height > ?
+		  ) AS d
+		  GROUP BY d.address, d.group
+		) AS s
+		WHERE lower(voter_aggregates.address) = s.address AND lower(voter_aggregates."group") = s.group
+		  AND voter_aggregates.recent_at_height > ?;
+	`
+
+	rollbackVoterAggRecentQuery = `
+		UPDATE voter_aggregates
+		SET
+		  recent_at_height = ?,
+		  recent_at = ?
+		WHERE recent_at_height > ?;
+	`
+)
@@ -0,0 +1,100 @@
+package psql
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.VoterAgg = (*VoterAgg)(nil)
+
+func NewVoterAggStore(db *gorm.DB) *VoterAgg {
+	return &VoterAgg{scoped(db, model.VoterAgg{})}
+}
+
+// VoterAgg handles operations on voter aggregates
+type VoterAgg struct {
+	baseStore
+}
+
+// Create creates the voter aggregate
+func (s VoterAgg) Create(val *model.VoterAgg) error {
+	return s.baseStore.Create(val)
+}
+
+// Save saves the voter aggregate
+func (s VoterAgg) Save(val *model.VoterAgg) error {
+	return s.baseStore.Save(val)
+}
+
+// FindByAddressAndGroup returns position of voter in validator group
+func (s VoterAgg) FindByAddressAndGroup(address string, group string) (*model.VoterAgg, error) {
+	result := &model.VoterAgg{}
+
+	err := s.db.
+		Where("address = ? AND \"group\" = ?", address, group).
+		First(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByAddress returns positions of voter with pending or active votes, largest first
+func (s VoterAgg) FindByAddress(address string) ([]model.VoterAgg, error) {
+	var result []model.VoterAgg
+
+	err := s.db.
+		Where("address = ? AND (pending_votes > 0 OR active_votes > 0)", address).
+		Order("active_votes + pending_votes DESC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindTopByGroup returns positions of voters of validator group with most votes
+func (s VoterAgg) FindTopByGroup(group string, limit int64) ([]model.VoterAgg, error) {
+	var result []model.VoterAgg
+
+	tx := s.db.
+		Where("\"group\" = ? AND (pending_votes > 0 OR active_votes > 0)", group).
+		Order("active_votes + pending_votes DESC")
+
+	if limit > 0 {
+		tx = tx.Limit(limit)
+	}
+
+	err := tx.
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// RollbackToHeight reverts votes changed above given height and resets recent height of voters to it.
+// Aggregates which recent height is already reset are skipped, so it is safe to run again when rollback is interrupted
+func (s *VoterAgg) RollbackToHeight(h int64, t types.Time) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(rollbackVoterAggVotesQuery, h, h, h).Error; err != nil {
+			return err
+		}
+		return tx.Exec(rollbackVoterAggRecentQuery, h, t, h).Error
+	})
+
+	return checkErr(err)
+}
+
+// DeleteStartedAboveHeight deletes voter aggregates started above given height
+func (s *VoterAgg) DeleteStartedAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("started_at_height > ?", h).
+		Delete(&model.VoterAgg{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,23 @@
+package psql
+
+const (
+	bulkInsertVoterRewardSeqs = `
+		INSERT INTO voter_reward_sequences (
+		  height,
+		  time,
+		  epoch,
+		  address,
+		  "group",
+		  amount,
+		  active_votes
+		)
+		VALUES @values
+
+		ON CONFLICT (height, address, "group") DO UPDATE
+		SET
+		  time = excluded.time,
+		  epoch = excluded.epoch,
+		  amount = excluded.amount,
+		  active_votes = excluded.active_votes;
+	`
+)
@@ -0,0 +1,117 @@
+package psql
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.VoterRewardSeq = (*VoterRewardSeq)(nil)
+
+func NewVoterRewardSeqStore(db *gorm.DB) *VoterRewardSeq {
+	return &VoterRewardSeq{scoped(db, model.VoterRewardSeq{})}
+}
+
+// VoterRewardSeq handles operations on voter rewards
+type VoterRewardSeq struct {
+	baseStore
+}
+
+// BulkUpsert inserts voter reward sequences in bulk or replaces them when reward of voter for group is already recorded at height
+func (s VoterRewardSeq) BulkUpsert(records []model.VoterRewardSeq) error {
+	var err error
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.baseStore.BulkUpsert(bulkInsertVoterRewardSeqs, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				r.Height,
+				r.Time,
+				r.Epoch,
+				r.Address,
+				r.Group,
+				r.Amount.String(),
+				r.ActiveVotes.String(),
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByHeight finds voter reward sequences by height
+func (s VoterRewardSeq) FindByHeight(h int64) ([]model.VoterRewardSeq, error) {
+	var result []model.VoterRewardSeq
+
+	err := s.db.
+		Where("height = ?", h).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByAddress finds page of voter reward sequences of given voter
+func (s VoterRewardSeq) FindByAddress(address string, query store.AddressHistoryQuery) ([]model.VoterRewardSeq, *store.AddressHistoryCursor, error) {
+	tx := s.db.Where("address = ?", address)
+
+	var result []model.VoterRewardSeq
+	if err := findAddressHistory(tx, query, &result); err != nil {
+		return nil, nil, checkErr(err)
+	}
+
+	if len(result) == 0 {
+		return result, nil, nil
+	}
+	return result, nextAddressHistoryCursor(query, len(result), result[len(result)-1].Height, result[len(result)-1].ID), nil
+}
+
+// DeleteForHeight deletes voter reward sequences for given height
+func (s *VoterRewardSeq) DeleteForHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height = ?", h).
+		Delete(&model.VoterRewardSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteAboveHeight deletes voter reward sequences above given height
+func (s *VoterRewardSeq) DeleteAboveHeight(h int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height > ?", h).
+		Delete(&model.VoterRewardSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteForHeightRange deletes voter reward sequences within given height range
+func (s *VoterRewardSeq) DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("height >= ? AND height <= ?", startHeight, endHeight).
+		Delete(&model.VoterRewardSeq{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
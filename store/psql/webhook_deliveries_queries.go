@@ -0,0 +1,20 @@
+package psql
+
+const (
+	bulkInsertWebhookDeliveries = `
+		INSERT INTO webhook_deliveries (
+		  created_at,
+		  updated_at,
+		  subscription_id,
+		  height,
+		  actor,
+		  kind,
+		  payload,
+		  attempts,
+		  next_attempt_at
+		)
+		VALUES @values
+
+		ON CONFLICT (subscription_id, height, actor, kind) DO NOTHING;
+	`
+)
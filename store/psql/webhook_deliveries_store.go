@@ -0,0 +1,100 @@
+package psql
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.WebhookDeliveries = (*WebhookDeliveries)(nil)
+
+func NewWebhookDeliveriesStore(db *gorm.DB) *WebhookDeliveries {
+	return &WebhookDeliveries{scoped(db, model.WebhookDelivery{})}
+}
+
+// WebhookDeliveries handles operations on webhook deliveries log
+type WebhookDeliveries struct {
+	baseStore
+}
+
+// Create creates the webhook delivery
+func (s WebhookDeliveries) Create(val *model.WebhookDelivery) error {
+	return s.baseStore.Create(val)
+}
+
+// Save saves the webhook delivery
+func (s WebhookDeliveries) Save(val *model.WebhookDelivery) error {
+	return s.baseStore.Save(val)
+}
+
+// BulkCreateIfNotExists creates webhook deliveries in bulk skipping events already enqueued for subscription
+func (s WebhookDeliveries) BulkCreateIfNotExists(records []model.WebhookDelivery) error {
+	var err error
+
+	now := time.Now()
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = s.baseStore.BulkUpsert(bulkInsertWebhookDeliveries, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+			return bulk.Row{
+				now,
+				now,
+				r.SubscriptionID,
+				r.Height,
+				r.Actor,
+				r.Kind,
+				r.Payload,
+				r.Attempts,
+				r.NextAttemptAt,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindPending returns deliveries which are due for next attempt at given time, oldest first.
+// Delivery waiting behind earlier pending delivery of the same subscription is skipped, so events are sent in order
+func (s WebhookDeliveries) FindPending(t time.Time, limit int64) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	err := s.db.
+		Where("delivered_at IS NULL AND next_attempt_at <= ?", t).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM webhook_deliveries earlier
+			WHERE earlier.subscription_id = webhook_deliveries.subscription_id
+				AND earlier.id < webhook_deliveries.id
+				AND earlier.delivered_at IS NULL
+				AND earlier.next_attempt_at IS NOT NULL
+		)`).
+		Order("id").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindBySubscriptionID returns most recent deliveries of subscription
+func (s WebhookDeliveries) FindBySubscriptionID(subscriptionID types.ID, limit int64) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	err := s.db.
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
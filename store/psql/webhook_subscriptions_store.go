@@ -0,0 +1,59 @@
+package psql
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var _ store.WebhookSubscriptions = (*WebhookSubscriptions)(nil)
+
+func NewWebhookSubscriptionsStore(db *gorm.DB) *WebhookSubscriptions {
+	return &WebhookSubscriptions{scoped(db, model.WebhookSubscription{})}
+}
+
+// WebhookSubscriptions handles operations on webhook subscriptions
+type WebhookSubscriptions struct {
+	baseStore
+}
+
+// Create creates the webhook subscription
+func (s WebhookSubscriptions) Create(val *model.WebhookSubscription) error {
+	return s.baseStore.Create(val)
+}
+
+// FindByID returns webhook subscription by id
+func (s WebhookSubscriptions) FindByID(id types.ID) (*model.WebhookSubscription, error) {
+	result := &model.WebhookSubscription{}
+
+	err := findBy(s.db, result, "id", id)
+
+	return result, checkErr(err)
+}
+
+// FindAll returns all webhook subscriptions
+func (s WebhookSubscriptions) FindAll() ([]model.WebhookSubscription, error) {
+	var result []model.WebhookSubscription
+
+	err := s.db.
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// DeleteByID deletes webhook subscription together with its deliveries
+func (s WebhookSubscriptions) DeleteByID(id types.ID) (*int64, error) {
+	tx := s.db.
+		Unscoped().
+		Where("id = ?", id).
+		Delete(&model.WebhookSubscription{})
+
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
@@ -0,0 +1,18 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+)
+
+type TransactionSeq interface {
+	BulkUpsert(records []model.TransactionSeq) error
+	FindByHeight(h int64) ([]model.TransactionSeq, error)
+	FindByHash(hash string) (*model.TransactionSeq, error)
+	FindByAddress(address string, query AddressHistoryQuery) ([]model.TransactionSeq, *AddressHistoryCursor, error)
+	DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
+	DeleteForHeight(h int64) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
+}
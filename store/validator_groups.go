@@ -14,6 +14,8 @@ type ValidatorGroupAgg interface {
 	FindByID(id int64) (*model.ValidatorGroupAgg, error)
 	FindByAddress(key string) (*model.ValidatorGroupAgg, error)
 	All() ([]model.ValidatorGroupAgg, error)
+	RollbackToHeight(h int64, t types.Time) error
+	DeleteStartedAboveHeight(h int64) (*int64, error)
 }
 
 type ValidatorGroupSeq interface {
@@ -23,6 +25,8 @@ type ValidatorGroupSeq interface {
 	FindLastByAddress(address string, limit int64) ([]model.ValidatorGroupSeq, error)
 	FindMostRecent() (*model.ValidatorGroupSeq, error)
 	DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
 	Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]ValidatorGroupSeqSummary, error)
 }
 
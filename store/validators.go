@@ -14,6 +14,8 @@ type ValidatorAgg interface {
 	FindByAddress(key string) (*model.ValidatorAgg, error)
 	GetAllForHeightGreaterThan(height int64) ([]model.ValidatorAgg, error)
 	All() ([]model.ValidatorAgg, error)
+	RollbackToHeight(h int64, t types.Time) error
+	DeleteStartedAboveHeight(h int64) (*int64, error)
 }
 
 type ValidatorSeq interface {
@@ -23,6 +25,8 @@ type ValidatorSeq interface {
 	FindMostRecent() (*model.ValidatorSeq, error)
 	FindLastByAddress(address string, limit int64) ([]model.ValidatorSeq, error)
 	DeleteOlderThan(purgeThreshold time.Time) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
 	Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]ValidatorSeqSummary, error)
 }
 
@@ -0,0 +1,16 @@
+package store
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+type VoterAgg interface {
+	Create(*model.VoterAgg) error
+	Save(*model.VoterAgg) error
+	FindByAddressAndGroup(address string, group string) (*model.VoterAgg, error)
+	FindByAddress(address string) ([]model.VoterAgg, error)
+	FindTopByGroup(group string, limit int64) ([]model.VoterAgg, error)
+	RollbackToHeight(h int64, t types.Time) error
+	DeleteStartedAboveHeight(h int64) (*int64, error)
+}
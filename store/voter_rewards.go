@@ -0,0 +1,14 @@
+package store
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+)
+
+type VoterRewardSeq interface {
+	BulkUpsert(records []model.VoterRewardSeq) error
+	FindByHeight(h int64) ([]model.VoterRewardSeq, error)
+	FindByAddress(address string, query AddressHistoryQuery) ([]model.VoterRewardSeq, *AddressHistoryCursor, error)
+	DeleteForHeight(h int64) (*int64, error)
+	DeleteAboveHeight(h int64) (*int64, error)
+	DeleteForHeightRange(startHeight int64, endHeight int64) (*int64, error)
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+type WebhookSubscriptions interface {
+	Create(subscription *model.WebhookSubscription) error
+	FindByID(id types.ID) (*model.WebhookSubscription, error)
+	FindAll() ([]model.WebhookSubscription, error)
+	DeleteByID(id types.ID) (*int64, error)
+}
+
+type WebhookDeliveries interface {
+	Create(delivery *model.WebhookDelivery) error
+	Save(delivery *model.WebhookDelivery) error
+	BulkCreateIfNotExists(records []model.WebhookDelivery) error
+	FindPending(t time.Time, limit int64) ([]model.WebhookDelivery, error)
+	FindBySubscriptionID(subscriptionID types.ID, limit int64) ([]model.WebhookDelivery, error)
+}
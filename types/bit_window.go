@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strings"
+)
+
+const (
+	bitSet   = "1"
+	bitUnset = "0"
+)
+
+// BitWindow is a rolling window of bits stored in BIT VARYING column. First bit is the most recent one
+type BitWindow string
+
+// Push adds most recent bit to window and drops the oldest bits which do not fit in window of given size
+func (w BitWindow) Push(bit bool, size int64) BitWindow {
+	next := bitUnset
+	if bit {
+		next = bitSet
+	}
+
+	pushed := next + string(w)
+	if int64(len(pushed)) > size {
+		pushed = pushed[:size]
+	}
+	return BitWindow(pushed)
+}
+
+// Count returns number of set bits among n most recent ones
+func (w BitWindow) Count(n int64) int64 {
+	bits := string(w)
+	if int64(len(bits)) > n {
+		bits = bits[:n]
+	}
+	return int64(strings.Count(bits, bitSet))
+}
+
+// Len returns number of bits in window
+func (w BitWindow) Len() int64 {
+	return int64(len(w))
+}
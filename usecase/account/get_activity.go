@@ -0,0 +1,25 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getActivityUseCase struct {
+	db *psql.Store
+}
+
+func NewGetActivityUseCase(db *psql.Store) *getActivityUseCase {
+	return &getActivityUseCase{
+		db: db,
+	}
+}
+
+func (uc *getActivityUseCase) Execute(address string, query store.AddressHistoryQuery) (*ActivityListView, error) {
+	activities, nextCursor, err := uc.db.GetAccounts().AccountActivitySeq.FindByAddress(address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToActivityListView(activities, nextCursor), nil
+}
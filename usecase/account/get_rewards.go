@@ -0,0 +1,25 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getRewardsUseCase struct {
+	db *psql.Store
+}
+
+func NewGetRewardsUseCase(db *psql.Store) *getRewardsUseCase {
+	return &getRewardsUseCase{
+		db: db,
+	}
+}
+
+func (uc *getRewardsUseCase) Execute(address string, query store.AddressHistoryQuery) (*RewardListView, error) {
+	voterRewards, nextCursor, err := uc.db.GetAccounts().VoterRewardSeq.FindByAddress(address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToRewardListView(voterRewards, nextCursor), nil
+}
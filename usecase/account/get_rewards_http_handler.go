@@ -0,0 +1,66 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getRewardsHttpHandler)(nil)
+)
+
+type getRewardsHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getRewardsUseCase
+}
+
+func NewGetRewardsHttpHandler(db *psql.Store, c figmentclient.Client) *getRewardsHttpHandler {
+	return &getRewardsHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getRewardsHttpHandler) Handle(c *gin.Context) {
+	var uri uriParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+
+	var params historyParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid filter or pagination params"))
+		return
+	}
+
+	query, err := params.toQuery()
+	if err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid filter or pagination params"))
+		return
+	}
+
+	ds, err := h.getUseCase().Execute(uri.Address, query)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, ds)
+}
+
+func (h *getRewardsHttpHandler) getUseCase() *getRewardsUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetRewardsUseCase(h.db)
+	}
+	return h.useCase
+}
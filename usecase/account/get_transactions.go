@@ -0,0 +1,25 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getTransactionsUseCase struct {
+	db *psql.Store
+}
+
+func NewGetTransactionsUseCase(db *psql.Store) *getTransactionsUseCase {
+	return &getTransactionsUseCase{
+		db: db,
+	}
+}
+
+func (uc *getTransactionsUseCase) Execute(address string, query store.AddressHistoryQuery) (*TransactionListView, error) {
+	transactions, nextCursor, err := uc.db.GetTransactions().TransactionSeq.FindByAddress(address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToTransactionListView(transactions, nextCursor), nil
+}
@@ -0,0 +1,66 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getTransactionsHttpHandler)(nil)
+)
+
+type getTransactionsHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getTransactionsUseCase
+}
+
+func NewGetTransactionsHttpHandler(db *psql.Store, c figmentclient.Client) *getTransactionsHttpHandler {
+	return &getTransactionsHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getTransactionsHttpHandler) Handle(c *gin.Context) {
+	var uri uriParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+
+	var params historyParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid filter or pagination params"))
+		return
+	}
+
+	query, err := params.toQuery()
+	if err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid filter or pagination params"))
+		return
+	}
+
+	ds, err := h.getUseCase().Execute(uri.Address, query)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, ds)
+}
+
+func (h *getTransactionsHttpHandler) getUseCase() *getTransactionsUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetTransactionsUseCase(h.db)
+	}
+	return h.useCase
+}
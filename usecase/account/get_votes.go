@@ -0,0 +1,24 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getVotesUseCase struct {
+	db *psql.Store
+}
+
+func NewGetVotesUseCase(db *psql.Store) *getVotesUseCase {
+	return &getVotesUseCase{
+		db: db,
+	}
+}
+
+func (uc *getVotesUseCase) Execute(address string) (*VoteListView, error) {
+	voterAggs, err := uc.db.GetAccounts().VoterAgg.FindByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToVoteListView(voterAggs), nil
+}
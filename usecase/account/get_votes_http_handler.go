@@ -0,0 +1,52 @@
+package account
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getVotesHttpHandler)(nil)
+)
+
+type getVotesHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getVotesUseCase
+}
+
+func NewGetVotesHttpHandler(db *psql.Store, c figmentclient.Client) *getVotesHttpHandler {
+	return &getVotesHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getVotesHttpHandler) Handle(c *gin.Context) {
+	var uri uriParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+
+	ds, err := h.getUseCase().Execute(uri.Address)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, ds)
+}
+
+func (h *getVotesHttpHandler) getUseCase() *getVotesUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetVotesUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,60 @@
+package account
+
+import (
+	"strings"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/store"
+)
+
+const (
+	defaultHistoryPageSize int64 = 15
+)
+
+// historyParams are query params shared by address history endpoints
+type historyParams struct {
+	Kinds       []string   `form:"kind" binding:"-"`
+	Direction   string     `form:"direction" binding:"omitempty,oneof=sent received"`
+	StartHeight *int64     `form:"start_height" binding:"omitempty,min=0"`
+	EndHeight   *int64     `form:"end_height" binding:"omitempty,min=0"`
+	StartTime   *time.Time `form:"start_time" time_format:"2006-01-02T15:04:05Z07:00" binding:"-"`
+	EndTime     *time.Time `form:"end_time" time_format:"2006-01-02T15:04:05Z07:00" binding:"-"`
+	Cursor      string     `form:"cursor" binding:"-"`
+	PageSize    *int64     `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// toQuery maps params to address history query. Kinds can be given as repeated or comma separated params
+func (p historyParams) toQuery() (store.AddressHistoryQuery, error) {
+	var kinds []string
+	for _, param := range p.Kinds {
+		for _, kind := range strings.Split(param, ",") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				kinds = append(kinds, kind)
+			}
+		}
+	}
+
+	limit := defaultHistoryPageSize
+	if p.PageSize != nil {
+		limit = *p.PageSize
+	}
+
+	var cursor *store.AddressHistoryCursor
+	if p.Cursor != "" {
+		var err error
+		if cursor, err = store.ParseAddressHistoryCursor(p.Cursor); err != nil {
+			return store.AddressHistoryQuery{}, err
+		}
+	}
+
+	return store.AddressHistoryQuery{
+		Kinds:       kinds,
+		Direction:   store.AddressHistoryDirection(p.Direction),
+		StartHeight: p.StartHeight,
+		EndHeight:   p.EndHeight,
+		StartTime:   p.StartTime,
+		EndTime:     p.EndTime,
+		Cursor:      cursor,
+		Limit:       limit,
+	}, nil
+}
@@ -3,6 +3,8 @@ package account
 import (
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/usecase/transaction"
 )
 
 type HeightDetailsView struct {
@@ -100,3 +102,61 @@ func ToBalanceDetails(rawAccountInfo *figmentclient.AccountInfo) *BalanceDetails
 
 	return details
 }
+
+type ActivityListView struct {
+	Items      []model.AccountActivitySeq  `json:"items"`
+	NextCursor *store.AddressHistoryCursor `json:"next_cursor,omitempty"`
+}
+
+func ToActivityListView(accountActivitySeqs []model.AccountActivitySeq, nextCursor *store.AddressHistoryCursor) *ActivityListView {
+	if accountActivitySeqs == nil {
+		accountActivitySeqs = []model.AccountActivitySeq{}
+	}
+
+	return &ActivityListView{
+		Items:      accountActivitySeqs,
+		NextCursor: nextCursor,
+	}
+}
+
+type TransactionListView struct {
+	Items      []transaction.DetailsView   `json:"items"`
+	NextCursor *store.AddressHistoryCursor `json:"next_cursor,omitempty"`
+}
+
+func ToTransactionListView(transactionSeqs []model.TransactionSeq, nextCursor *store.AddressHistoryCursor) *TransactionListView {
+	return &TransactionListView{
+		Items:      transaction.ToListView(transactionSeqs).Items,
+		NextCursor: nextCursor,
+	}
+}
+
+type RewardListView struct {
+	Items      []model.VoterRewardSeq      `json:"items"`
+	NextCursor *store.AddressHistoryCursor `json:"next_cursor,omitempty"`
+}
+
+func ToRewardListView(voterRewardSeqs []model.VoterRewardSeq, nextCursor *store.AddressHistoryCursor) *RewardListView {
+	if voterRewardSeqs == nil {
+		voterRewardSeqs = []model.VoterRewardSeq{}
+	}
+
+	return &RewardListView{
+		Items:      voterRewardSeqs,
+		NextCursor: nextCursor,
+	}
+}
+
+type VoteListView struct {
+	Items []model.VoterAgg `json:"items"`
+}
+
+func ToVoteListView(voterAggs []model.VoterAgg) *VoteListView {
+	if voterAggs == nil {
+		voterAggs = []model.VoterAgg{}
+	}
+
+	return &VoteListView{
+		Items: voterAggs,
+	}
+}
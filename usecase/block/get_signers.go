@@ -0,0 +1,39 @@
+package block
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getSignersUseCase struct {
+	db *psql.Store
+}
+
+func NewGetSignersUseCase(db *psql.Store) *getSignersUseCase {
+	return &getSignersUseCase{
+		db: db,
+	}
+}
+
+func (uc *getSignersUseCase) Execute(height int64) (*SignersView, error) {
+	blockSeq, err := uc.db.GetBlocks().BlockSeq.FindByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	syncable, err := uc.db.GetCore().Syncables.FindByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Signers cannot be mapped when validators elected for epoch of block are not indexed
+	var electedValidatorSeqs []model.ElectedValidatorSeq
+	if syncable.Epoch != nil {
+		electedValidatorSeqs, err = uc.db.GetEpochs().ElectedValidatorSeq.FindByEpoch(*syncable.Epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ToSignersView(blockSeq, electedValidatorSeqs), nil
+}
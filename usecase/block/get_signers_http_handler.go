@@ -0,0 +1,56 @@
+package block
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getSignersHttpHandler)(nil)
+)
+
+type getSignersHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getSignersUseCase
+}
+
+func NewGetSignersHttpHandler(db *psql.Store, c figmentclient.Client) *getSignersHttpHandler {
+	return &getSignersHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetSignersRequest struct {
+	Height int64 `uri:"height" binding:"min=1"`
+}
+
+func (h *getSignersHttpHandler) Handle(c *gin.Context) {
+	var req GetSignersRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid height"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Height)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getSignersHttpHandler) getUseCase() *getSignersUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetSignersUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -2,6 +2,7 @@ package block
 
 import (
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/model"
 )
 
 type DetailsView struct {
@@ -15,3 +16,39 @@ func ToDetailsView(rawBlock *figmentclient.Block) *DetailsView {
 
 	return view
 }
+
+type SignerView struct {
+	Address string `json:"address"`
+	Signer  string `json:"signer"`
+	Group   string `json:"group"`
+	Index   int64  `json:"index"`
+	Signed  bool   `json:"signed"`
+}
+
+type SignersView struct {
+	Height   int64        `json:"height"`
+	Proposer string       `json:"proposer"`
+	Round    uint64       `json:"round"`
+	Items    []SignerView `json:"items"`
+}
+
+// ToSignersView maps signers bitmap of block against validators elected for epoch of block
+func ToSignersView(blockSeq *model.BlockSeq, electedValidatorSeqs []model.ElectedValidatorSeq) *SignersView {
+	items := []SignerView{}
+	for _, electedValidatorSeq := range electedValidatorSeqs {
+		items = append(items, SignerView{
+			Address: electedValidatorSeq.Address,
+			Signer:  electedValidatorSeq.Signer,
+			Group:   electedValidatorSeq.Group,
+			Index:   electedValidatorSeq.Index,
+			Signed:  blockSeq.IsSigner(electedValidatorSeq.Index),
+		})
+	}
+
+	return &SignersView{
+		Height:   blockSeq.Height,
+		Proposer: blockSeq.Proposer,
+		Round:    blockSeq.Round,
+		Items:    items,
+	}
+}
@@ -18,7 +18,8 @@ func NewGetStatusUseCase(db *psql.Store, c figmentclient.Client) *getStatusUseCa
 	}
 }
 
-func (uc *getStatusUseCase) Execute(ctx context.Context) (*DetailsView, error) {
+// Execute returns status of the application and chain together with at most gapsLimit lowest gaps (all gaps when gapsLimit is 0)
+func (uc *getStatusUseCase) Execute(ctx context.Context, gapsLimit int64) (*DetailsView, error) {
 	mostRecentSyncable, err := uc.db.GetCore().Syncables.FindMostRecent()
 	if err != nil && err != psql.ErrNotFound {
 		return nil, err
@@ -29,5 +30,10 @@ func (uc *getStatusUseCase) Execute(ctx context.Context) (*DetailsView, error) {
 		return nil, err
 	}
 
-	return ToDetailsView(mostRecentSyncable, chainStatus), nil
+	gaps, err := uc.db.GetCore().Syncables.FindGaps(gapsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDetailsView(mostRecentSyncable, chainStatus, gaps), nil
 }
@@ -26,7 +26,7 @@ func NewGetStatusCmdHandler(db *psql.Store, c figmentclient.Client) *GetStatusCm
 func (h *GetStatusCmdHandler) Handle(ctx context.Context) {
 	logger.Info("chain get status use case [handler=cmd]")
 
-	details, err := h.getUseCase().Execute(ctx)
+	details, err := h.getUseCase().Execute(ctx, 0)
 	if err != nil {
 		logger.Error(err)
 		return
@@ -49,6 +49,10 @@ func (h *GetStatusCmdHandler) Handle(ctx context.Context) {
 		fmt.Println("Last indexed time:", details.LastIndexedTime)
 		fmt.Println("Last indexed at:", details.LastIndexedAt)
 		fmt.Println("Lag behind head:", details.Lag)
+		fmt.Println("Gaps:", len(details.Gaps))
+		for _, gap := range details.Gaps {
+			fmt.Printf("  %d - %d\n", gap.StartHeight, gap.EndHeight)
+		}
 	}
 	fmt.Println("")
 }
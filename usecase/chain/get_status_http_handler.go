@@ -10,6 +10,11 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// statusGapsLimit is the maximum number of gaps returned by status endpoint
+	statusGapsLimit = 100
+)
+
 var (
 	_ types.HttpHandler = (*getStatusHttpHandler)(nil)
 )
@@ -29,7 +34,7 @@ func NewGetStatusHttpHandler(db *psql.Store, client figmentclient.Client) *getSt
 }
 
 func (h *getStatusHttpHandler) Handle(c *gin.Context) {
-	resp, err := h.getUseCase().Execute(c)
+	resp, err := h.getUseCase().Execute(c, statusGapsLimit)
 	if err != nil {
 		logger.Error(err)
 		http.ServerError(c, err)
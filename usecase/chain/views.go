@@ -4,6 +4,7 @@ import (
 	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/config"
 	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
 	"github.com/figment-networks/celo-indexer/types"
 )
 
@@ -20,14 +21,19 @@ type DetailsView struct {
 	LastIndexedTime   types.Time `json:"last_indexed_time,omitempty"`
 	LastIndexedAt     types.Time `json:"last_indexed_at,omitempty"`
 	Lag               int64      `json:"indexing_lag,omitempty"`
+
+	Gaps []store.SyncableGap `json:"gaps,omitempty"`
+
+	Nodes []figmentclient.NodeHealth `json:"nodes,omitempty"`
 }
 
-func ToDetailsView(recentSyncable *model.Syncable, rawChainStatus *figmentclient.ChainStatus) *DetailsView {
+func ToDetailsView(recentSyncable *model.Syncable, rawChainStatus *figmentclient.ChainStatus, gaps []store.SyncableGap) *DetailsView {
 	view := &DetailsView{
 		AppName:    config.AppName,
 		AppVersion: config.AppVersion,
 		GoVersion:  config.GoVersion,
 		ChainId:    rawChainStatus.ChainId,
+		Nodes:      rawChainStatus.Nodes,
 	}
 
 	view.IndexingStarted = recentSyncable != nil
@@ -38,6 +44,7 @@ func ToDetailsView(recentSyncable *model.Syncable, rawChainStatus *figmentclient
 		view.LastIndexedAt = recentSyncable.CreatedAt
 
 		view.Lag = rawChainStatus.LastBlockHeight - recentSyncable.Height
+		view.Gaps = gaps
 	}
 
 	return view
@@ -14,9 +14,14 @@ func NewCmdHandlers(cfg *config.Config, db *psql.Store, nodeClient figmentclient
 	return &CmdHandlers{
 		GetStatus:        chain.NewGetStatusCmdHandler(db, nodeClient),
 		StartIndexer:     indexing.NewStartCmdHandler(cfg, db, nodeClient),
+		FollowIndexer:    indexing.NewFollowCmdHandler(cfg, db, nodeClient),
 		BackfillIndexer:  indexing.NewBackfillCmdHandler(cfg, db, nodeClient),
+		ReindexIndexer:   indexing.NewReindexCmdHandler(cfg, db, nodeClient),
+		RepairIndexer:    indexing.NewRepairCmdHandler(cfg, db, nodeClient),
+		VerifyIndexer:    indexing.NewVerifyCmdHandler(cfg, db, nodeClient),
 		PurgeIndexer:     indexing.NewPurgeCmdHandler(cfg, db, nodeClient),
 		SummarizeIndexer: indexing.NewSummarizeCmdHandler(cfg, db, nodeClient),
+		RunIndexer:       indexing.NewRunCmdHandler(cfg, db, nodeClient),
 		UpdateProposals: governance.NewUpdateProposalsCmdHandler(db, theCeloClient),
 	}
 }
@@ -24,8 +29,13 @@ func NewCmdHandlers(cfg *config.Config, db *psql.Store, nodeClient figmentclient
 type CmdHandlers struct {
 	GetStatus        *chain.GetStatusCmdHandler
 	StartIndexer     *indexing.StartCmdHandler
+	FollowIndexer    *indexing.FollowCmdHandler
 	BackfillIndexer  *indexing.BackfillCmdHandler
+	ReindexIndexer   *indexing.ReindexCmdHandler
+	RepairIndexer    *indexing.RepairCmdHandler
+	VerifyIndexer    *indexing.VerifyCmdHandler
 	PurgeIndexer     *indexing.PurgeCmdHandler
 	SummarizeIndexer *indexing.SummarizeCmdHandler
+	RunIndexer       *indexing.RunCmdHandler
 	UpdateProposals  *governance.UpdateProposalsCmdHandler
 }
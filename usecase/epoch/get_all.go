@@ -0,0 +1,24 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getAllUseCase struct {
+	db *psql.Store
+}
+
+func NewGetAllUseCase(db *psql.Store) *getAllUseCase {
+	return &getAllUseCase{
+		db: db,
+	}
+}
+
+func (uc *getAllUseCase) Execute(cursor *int64, limit int64) (*ListView, error) {
+	epochSummaries, nextCursor, err := uc.db.GetEpochs().EpochSummary.All(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(epochSummaries, nextCursor), nil
+}
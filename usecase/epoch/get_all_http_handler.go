@@ -0,0 +1,66 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageSize int64 = 15
+)
+
+var (
+	_ types.HttpHandler = (*getAllHttpHandler)(nil)
+)
+
+type getAllHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getAllUseCase
+}
+
+type GetAllRequest struct {
+	Cursor   *int64 `form:"cursor" binding:"-"`
+	PageSize *int64 `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+func NewGetAllHttpHandler(db *psql.Store, c figmentclient.Client) *getAllHttpHandler {
+	return &getAllHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getAllHttpHandler) Handle(c *gin.Context) {
+	var req GetAllRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid pagination params"))
+		return
+	}
+
+	limit := defaultPageSize
+	if req.PageSize != nil {
+		limit = *req.PageSize
+	}
+
+	ds, err := h.getUseCase().Execute(req.Cursor, limit)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, ds)
+}
+
+func (h *getAllHttpHandler) getUseCase() *getAllUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetAllUseCase(h.db)
+	}
+	return h.useCase
+}
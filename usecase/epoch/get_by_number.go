@@ -0,0 +1,20 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getByNumberUseCase struct {
+	db *psql.Store
+}
+
+func NewGetByNumberUseCase(db *psql.Store) *getByNumberUseCase {
+	return &getByNumberUseCase{
+		db: db,
+	}
+}
+
+func (uc *getByNumberUseCase) Execute(number int64) (*model.EpochSummary, error) {
+	return uc.db.GetEpochs().EpochSummary.FindByEpoch(number)
+}
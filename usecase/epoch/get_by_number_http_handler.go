@@ -0,0 +1,56 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getByNumberHttpHandler)(nil)
+)
+
+type getByNumberHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getByNumberUseCase
+}
+
+type GetByNumberRequest struct {
+	Number int64 `uri:"number" binding:"min=1"`
+}
+
+func NewGetByNumberHttpHandler(db *psql.Store, c figmentclient.Client) *getByNumberHttpHandler {
+	return &getByNumberHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getByNumberHttpHandler) Handle(c *gin.Context) {
+	var req GetByNumberRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid epoch number"))
+		return
+	}
+
+	ds, err := h.getUseCase().Execute(req.Number)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, ds)
+}
+
+func (h *getByNumberHttpHandler) getUseCase() *getByNumberUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetByNumberUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,24 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getElectedUseCase struct {
+	db *psql.Store
+}
+
+func NewGetElectedUseCase(db *psql.Store) *getElectedUseCase {
+	return &getElectedUseCase{
+		db: db,
+	}
+}
+
+func (uc *getElectedUseCase) Execute(number int64) (*ElectedListView, error) {
+	electedValidatorSeqs, err := uc.db.GetEpochs().ElectedValidatorSeq.FindByEpoch(number)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToElectedListView(electedValidatorSeqs), nil
+}
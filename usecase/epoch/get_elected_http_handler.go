@@ -0,0 +1,52 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getElectedHttpHandler)(nil)
+)
+
+type getElectedHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getElectedUseCase
+}
+
+func NewGetElectedHttpHandler(db *psql.Store, c figmentclient.Client) *getElectedHttpHandler {
+	return &getElectedHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getElectedHttpHandler) Handle(c *gin.Context) {
+	var req GetByNumberRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid epoch number"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Number)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getElectedHttpHandler) getUseCase() *getElectedUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetElectedUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,35 @@
+package epoch
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+)
+
+type ListView struct {
+	Items      []model.EpochSummary `json:"items"`
+	NextCursor *int64               `json:"next_cursor,omitempty"`
+}
+
+func ToListView(epochSummaries []model.EpochSummary, nextCursor *int64) *ListView {
+	if epochSummaries == nil {
+		epochSummaries = []model.EpochSummary{}
+	}
+
+	return &ListView{
+		Items:      epochSummaries,
+		NextCursor: nextCursor,
+	}
+}
+
+type ElectedListView struct {
+	Items []model.ElectedValidatorSeq `json:"items"`
+}
+
+func ToElectedListView(electedValidatorSeqs []model.ElectedValidatorSeq) *ElectedListView {
+	if electedValidatorSeqs == nil {
+		electedValidatorSeqs = []model.ElectedValidatorSeq{}
+	}
+
+	return &ElectedListView{
+		Items: electedValidatorSeqs,
+	}
+}
@@ -8,12 +8,16 @@ import (
 	"github.com/figment-networks/celo-indexer/usecase/account"
 	"github.com/figment-networks/celo-indexer/usecase/block"
 	"github.com/figment-networks/celo-indexer/usecase/chain"
+	"github.com/figment-networks/celo-indexer/usecase/epoch"
 	"github.com/figment-networks/celo-indexer/usecase/governance"
 	"github.com/figment-networks/celo-indexer/usecase/health"
+	"github.com/figment-networks/celo-indexer/usecase/indexing"
 	"github.com/figment-networks/celo-indexer/usecase/systemevent"
 	"github.com/figment-networks/celo-indexer/usecase/transaction"
+	"github.com/figment-networks/celo-indexer/usecase/transfer"
 	"github.com/figment-networks/celo-indexer/usecase/validator"
 	"github.com/figment-networks/celo-indexer/usecase/validatorgroup"
+	"github.com/figment-networks/celo-indexer/usecase/webhook"
 )
 
 func NewHttpHandlers(cfg *config.Config, db *psql.Store, c figmentclient.Client) *HttpHandlers {
@@ -23,20 +27,36 @@ func NewHttpHandlers(cfg *config.Config, db *psql.Store, c figmentclient.Client)
 		GetBlockByHeight:           block.NewGetByHeightHttpHandler(db, c),
 		GetBlockTimes:              block.NewGetBlockTimesHttpHandler(db, c),
 		GetBlockSummary:            block.NewGetBlockSummaryHttpHandler(db, c),
+		GetBlockSigners:            block.NewGetSignersHttpHandler(db, c),
 		GetTransactionsByHeight:    transaction.NewGetByHeightHttpHandler(db, c),
+		GetTransactionByHash:       transaction.NewGetByHashHttpHandler(db, c),
 		GetAccountByHeight:         account.NewGetByHeightHttpHandler(db, c),
 		GetAccountDetails:          account.NewGetDetailsHttpHandler(db, c),
+		GetAccountTransactions:     account.NewGetTransactionsHttpHandler(db, c),
+		GetAccountActivity:         account.NewGetActivityHttpHandler(db, c),
+		GetAccountRewards:          account.NewGetRewardsHttpHandler(db, c),
+		GetAccountVotes:            account.NewGetVotesHttpHandler(db, c),
+		GetTransfers:               transfer.NewGetAllHttpHandler(db, c),
+		GetEpochs:                  epoch.NewGetAllHttpHandler(db, c),
+		GetEpochByNumber:           epoch.NewGetByNumberHttpHandler(db, c),
+		GetEpochElected:            epoch.NewGetElectedHttpHandler(db, c),
 		GetValidatorsByHeight:      validator.NewGetByHeightHttpHandler(cfg, db, c),
 		GetValidatorByAddress:      validator.NewGetByAddressHttpHandler(db, c),
 		GetValidatorSummary:        validator.NewGetSummaryHttpHandler(db, c),
 		GetValidatorsForMinHeight:  validator.NewGetForMinHeightHttpHandler(db, c),
 		GetValidatorGroupsByHeight: validatorgroup.NewGetByHeightHttpHandler(cfg, db, c),
 		GetValidatorGroupByAddress: validatorgroup.NewGetByAddressHttpHandler(db, c),
+		GetValidatorGroupVoters:    validatorgroup.NewGetVotersHttpHandler(db, c),
 		GetValidatorGroupSummary:   validatorgroup.NewGetSummaryHttpHandler(db, c),
 		GetSystemEventsForAddress:  systemevent.NewGetForAddressHttpHandler(db, c),
 		GetSystemEvents:            systemevent.NewGetAllHttpHandler(db, c),
 		GetProposals:               governance.NewGetProposalsHttpHandler(db, c),
 		GetProposalActivity:        governance.NewGetActivityHttpHandler(db, c),
+		GetWebhookSubscriptions:    webhook.NewGetSubscriptionsHttpHandler(db, c),
+		CreateWebhookSubscription:  webhook.NewCreateSubscriptionHttpHandler(db, c),
+		DeleteWebhookSubscription:  webhook.NewDeleteSubscriptionHttpHandler(db, c),
+		GetWebhookDeliveries:       webhook.NewGetDeliveriesHttpHandler(db, c),
+		RunPipeline:                indexing.NewRunHttpHandler(cfg, db, c),
 	}
 }
 
@@ -46,18 +66,34 @@ type HttpHandlers struct {
 	GetBlockTimes              types.HttpHandler
 	GetBlockSummary            types.HttpHandler
 	GetBlockByHeight           types.HttpHandler
+	GetBlockSigners            types.HttpHandler
 	GetTransactionsByHeight    types.HttpHandler
+	GetTransactionByHash       types.HttpHandler
 	GetAccountByHeight         types.HttpHandler
 	GetAccountDetails          types.HttpHandler
+	GetAccountTransactions     types.HttpHandler
+	GetAccountActivity         types.HttpHandler
+	GetAccountRewards          types.HttpHandler
+	GetAccountVotes            types.HttpHandler
+	GetTransfers               types.HttpHandler
+	GetEpochs                  types.HttpHandler
+	GetEpochByNumber           types.HttpHandler
+	GetEpochElected            types.HttpHandler
 	GetValidatorsByHeight      types.HttpHandler
 	GetValidatorByAddress      types.HttpHandler
 	GetValidatorSummary        types.HttpHandler
 	GetValidatorsForMinHeight  types.HttpHandler
 	GetValidatorGroupsByHeight types.HttpHandler
 	GetValidatorGroupByAddress types.HttpHandler
+	GetValidatorGroupVoters    types.HttpHandler
 	GetValidatorGroupSummary   types.HttpHandler
 	GetSystemEventsForAddress  types.HttpHandler
 	GetSystemEvents            types.HttpHandler
 	GetProposals               types.HttpHandler
 	GetProposalActivity        types.HttpHandler
+	GetWebhookSubscriptions    types.HttpHandler
+	CreateWebhookSubscription  types.HttpHandler
+	DeleteWebhookSubscription  types.HttpHandler
+	GetWebhookDeliveries       types.HttpHandler
+	RunPipeline                types.HttpHandler
 }
@@ -0,0 +1,69 @@
+package indexing
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type followUseCase struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+}
+
+func NewFollowUseCase(cfg *config.Config, db *psql.Store, c figmentclient.Client) *followUseCase {
+	return &followUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (uc *followUseCase) Execute(ctx context.Context, batchSize int64, parallelHeights int64) error {
+	if err := NewStartUseCase(uc.cfg, uc.db, uc.client).canExecute(); err != nil {
+		return err
+	}
+
+	pollInterval, err := time.ParseDuration(uc.cfg.FollowPollInterval)
+	if err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(
+		uc.cfg,
+		uc.client,
+		uc.db.GetCore().Syncables,
+		uc.db.GetCore().Database,
+		uc.db.GetCore().Reports,
+		uc.db.GetBlocks().BlockSeq,
+		uc.db.GetValidators().ValidatorSeq,
+		uc.db.GetAccounts().AccountActivitySeq,
+		uc.db.GetValidatorGroups().ValidatorGroupSeq,
+		uc.db.GetValidators().ValidatorAgg,
+		uc.db.GetValidatorGroups().ValidatorGroupAgg,
+		uc.db.GetGovernance().ProposalAgg,
+		uc.db.GetCore().SystemEvents,
+		uc.db.GetGovernance().GovernanceActivitySeq,
+		uc.db.GetTransactions().TransactionSeq,
+		uc.db.GetEpochs().EpochSummary,
+		uc.db.GetAccounts().VoterRewardSeq,
+		uc.db.GetAccounts().VoterAgg,
+		uc.db.GetEpochs().ElectedValidatorSeq,
+		uc.db.GetWebhooks().WebhookSubscriptions,
+		uc.db.GetWebhooks().WebhookDeliveries,
+	)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.Follow(ctx, indexer.FollowConfig{
+		BatchSize:       batchSize,
+		ParallelHeights: parallelHeights,
+		PollInterval:    pollInterval,
+	})
+}
@@ -0,0 +1,47 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+type FollowCmdHandler struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *followUseCase
+}
+
+func NewFollowCmdHandler(cfg *config.Config, db *psql.Store, c figmentclient.Client) *FollowCmdHandler {
+	return &FollowCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *FollowCmdHandler) Handle(ctx context.Context, batchSize int64, parallelHeights int64) {
+	if parallelHeights == 0 {
+		parallelHeights = h.cfg.ParallelHeights
+	}
+
+	logger.Info(fmt.Sprintf("running follow use case [handler=cmd] [batchSize=%d] [parallelHeights=%d]", batchSize, parallelHeights))
+
+	err := h.getUseCase().Execute(ctx, batchSize, parallelHeights)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *FollowCmdHandler) getUseCase() *followUseCase {
+	if h.useCase == nil {
+		return NewFollowUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
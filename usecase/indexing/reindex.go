@@ -0,0 +1,87 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrReindexRunning = errors.New("reindex already running")
+)
+
+type reindexUseCase struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+}
+
+func NewReindexUseCase(cfg *config.Config, db *psql.Store, c figmentclient.Client) *reindexUseCase {
+	return &reindexUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type ReindexUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	TargetIds   []int64
+}
+
+func (uc *reindexUseCase) Execute(ctx context.Context, useCaseConfig ReindexUseCaseConfig) error {
+	if err := uc.canExecute(); err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(
+		uc.cfg,
+		uc.client,
+		uc.db.GetCore().Syncables,
+		uc.db.GetCore().Database,
+		uc.db.GetCore().Reports,
+		uc.db.GetBlocks().BlockSeq,
+		uc.db.GetValidators().ValidatorSeq,
+		uc.db.GetAccounts().AccountActivitySeq,
+		uc.db.GetValidatorGroups().ValidatorGroupSeq,
+		uc.db.GetValidators().ValidatorAgg,
+		uc.db.GetValidatorGroups().ValidatorGroupAgg,
+		uc.db.GetGovernance().ProposalAgg,
+		uc.db.GetCore().SystemEvents,
+		uc.db.GetGovernance().GovernanceActivitySeq,
+		uc.db.GetTransactions().TransactionSeq,
+		uc.db.GetEpochs().EpochSummary,
+		uc.db.GetAccounts().VoterRewardSeq,
+		uc.db.GetAccounts().VoterAgg,
+		uc.db.GetEpochs().ElectedValidatorSeq,
+		uc.db.GetWebhooks().WebhookSubscriptions,
+		uc.db.GetWebhooks().WebhookDeliveries,
+	)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.Reindex(ctx, indexer.ReindexConfig{
+		StartHeight: useCaseConfig.StartHeight,
+		EndHeight:   useCaseConfig.EndHeight,
+		TargetIds:   useCaseConfig.TargetIds,
+	})
+}
+
+// canExecute checks if range reindex is already running
+// if is it running we skip reindexing
+func (uc *reindexUseCase) canExecute() error {
+	if _, err := uc.db.GetCore().Reports.FindNotCompletedByKind(model.ReportKindRangeReindex); err != nil {
+		if err == psql.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return ErrReindexRunning
+}
@@ -0,0 +1,48 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+type ReindexCmdHandler struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *reindexUseCase
+}
+
+func NewReindexCmdHandler(cfg *config.Config, db *psql.Store, c figmentclient.Client) *ReindexCmdHandler {
+	return &ReindexCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *ReindexCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64, targetIds []int64) {
+	logger.Info("running reindex use case [handler=cmd]")
+
+	useCaseConfig := ReindexUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		TargetIds:   targetIds,
+	}
+	err := h.getUseCase().Execute(ctx, useCaseConfig)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *ReindexCmdHandler) getUseCase() *reindexUseCase {
+	if h.useCase == nil {
+		return NewReindexUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
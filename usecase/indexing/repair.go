@@ -0,0 +1,85 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrRepairRunning = errors.New("repair already running")
+)
+
+type repairUseCase struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+}
+
+func NewRepairUseCase(cfg *config.Config, db *psql.Store, c figmentclient.Client) *repairUseCase {
+	return &repairUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (uc *repairUseCase) Execute(ctx context.Context) error {
+	if err := uc.canExecute(); err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(
+		uc.cfg,
+		uc.client,
+		uc.db.GetCore().Syncables,
+		uc.db.GetCore().Database,
+		uc.db.GetCore().Reports,
+		uc.db.GetBlocks().BlockSeq,
+		uc.db.GetValidators().ValidatorSeq,
+		uc.db.GetAccounts().AccountActivitySeq,
+		uc.db.GetValidatorGroups().ValidatorGroupSeq,
+		uc.db.GetValidators().ValidatorAgg,
+		uc.db.GetValidatorGroups().ValidatorGroupAgg,
+		uc.db.GetGovernance().ProposalAgg,
+		uc.db.GetCore().SystemEvents,
+		uc.db.GetGovernance().GovernanceActivitySeq,
+		uc.db.GetTransactions().TransactionSeq,
+		uc.db.GetEpochs().EpochSummary,
+		uc.db.GetAccounts().VoterRewardSeq,
+		uc.db.GetAccounts().VoterAgg,
+		uc.db.GetEpochs().ElectedValidatorSeq,
+		uc.db.GetWebhooks().WebhookSubscriptions,
+		uc.db.GetWebhooks().WebhookDeliveries,
+	)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.Repair(ctx)
+}
+
+// canExecute checks if sequential reindex or other repair is already running
+// if is it running we skip repair
+func (uc *repairUseCase) canExecute() error {
+	if _, err := uc.db.GetCore().Reports.FindNotCompletedByKind(model.ReportKindSequentialReindex); err != nil {
+		if err != psql.ErrNotFound {
+			return err
+		}
+	} else {
+		return ErrRunningSequentialReindex
+	}
+
+	if _, err := uc.db.GetCore().Reports.FindNotCompletedByKind(model.ReportKindRepair); err != nil {
+		if err == psql.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return ErrRepairRunning
+}
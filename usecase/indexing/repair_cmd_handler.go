@@ -0,0 +1,43 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+type RepairCmdHandler struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *repairUseCase
+}
+
+func NewRepairCmdHandler(cfg *config.Config, db *psql.Store, c figmentclient.Client) *RepairCmdHandler {
+	return &RepairCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *RepairCmdHandler) Handle(ctx context.Context) {
+	logger.Info("running repair use case [handler=cmd]")
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *RepairCmdHandler) getUseCase() *repairUseCase {
+	if h.useCase == nil {
+		return NewRepairUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
@@ -0,0 +1,120 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrRunHeightRequired = errors.New("height is required")
+)
+
+type runUseCase struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+}
+
+func NewRunUseCase(cfg *config.Config, db *psql.Store, c figmentclient.Client) *runUseCase {
+	return &runUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type RunUseCaseConfig struct {
+	Height    int64
+	TargetIds []int64
+	Dry       bool
+}
+
+// Execute runs pipeline for single height and returns everything it fetched and created.
+// In dry mode persistor stage is skipped, so nothing is written to database
+func (uc *runUseCase) Execute(ctx context.Context, useCaseConfig RunUseCaseConfig) (*RunView, error) {
+	if useCaseConfig.Height <= 0 {
+		return nil, ErrRunHeightRequired
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(
+		uc.cfg,
+		uc.client,
+		uc.db.GetCore().Syncables,
+		uc.db.GetCore().Database,
+		uc.db.GetCore().Reports,
+		uc.db.GetBlocks().BlockSeq,
+		uc.db.GetValidators().ValidatorSeq,
+		uc.db.GetAccounts().AccountActivitySeq,
+		uc.db.GetValidatorGroups().ValidatorGroupSeq,
+		uc.db.GetValidators().ValidatorAgg,
+		uc.db.GetValidatorGroups().ValidatorGroupAgg,
+		uc.db.GetGovernance().ProposalAgg,
+		uc.db.GetCore().SystemEvents,
+		uc.db.GetGovernance().GovernanceActivitySeq,
+		uc.db.GetTransactions().TransactionSeq,
+		uc.db.GetEpochs().EpochSummary,
+		uc.db.GetAccounts().VoterRewardSeq,
+		uc.db.GetAccounts().VoterAgg,
+		uc.db.GetEpochs().ElectedValidatorSeq,
+		uc.db.GetWebhooks().WebhookSubscriptions,
+		uc.db.GetWebhooks().WebhookDeliveries,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := indexingPipeline.Run(ctx, indexer.RunConfig{
+		Height:           useCaseConfig.Height,
+		DesiredTargetIDs: useCaseConfig.TargetIds,
+		Dry:              useCaseConfig.Dry,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunView{
+		Height: payload.CurrentHeight,
+		Dry:    useCaseConfig.Dry,
+
+		HeightMeta: payload.HeightMeta,
+		Syncable:   payload.Syncable,
+
+		Raw: RawView{
+			Block:             payload.RawBlock,
+			Validators:        payload.RawValidators,
+			ValidatorGroups:   payload.RawValidatorGroups,
+			Transactions:      payload.RawTransactions,
+			EpochSummary:      payload.RawEpochSummary,
+			ElectedValidators: payload.RawElectedValidators,
+		},
+		ParsedGovernanceLogs: payload.ParsedGovernanceLogs,
+		Sequences: SequencesView{
+			NewBlock:             payload.NewBlockSequence,
+			UpdatedBlock:         payload.UpdatedBlockSequence,
+			Validators:           payload.ValidatorSequences,
+			ValidatorGroups:      payload.ValidatorGroupSequences,
+			AccountActivities:    payload.AccountActivitySequences,
+			GovernanceActivities: payload.GovernanceActivitySequences,
+			Transactions:         payload.TransactionSequences,
+			EpochSummary:         payload.EpochSummary,
+			VoterRewards:         payload.VoterRewardSequences,
+			ElectedValidators:    payload.ElectedValidatorSequences,
+		},
+		Aggregates: AggregatesView{
+			NewValidators:          payload.NewValidatorAggregates,
+			UpdatedValidators:      payload.UpdatedValidatorAggregates,
+			NewValidatorGroups:     payload.NewValidatorGroupAggregates,
+			UpdatedValidatorGroups: payload.UpdatedValidatorGroupAggregates,
+			NewProposals:           payload.NewProposalAggregates,
+			UpdatedProposals:       payload.UpdatedProposalAggregates,
+			NewVoters:              payload.NewVoterAggregates,
+			UpdatedVoters:          payload.UpdatedVoterAggregates,
+		},
+		SystemEvents: payload.SystemEvents,
+	}, nil
+}
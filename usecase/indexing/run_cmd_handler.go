@@ -0,0 +1,70 @@
+package indexing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+type RunCmdHandler struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *runUseCase
+}
+
+func NewRunCmdHandler(cfg *config.Config, db *psql.Store, c figmentclient.Client) *RunCmdHandler {
+	return &RunCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+// Handle runs pipeline for given height and writes its result as JSON to output file, or to stdout when output is not given
+func (h *RunCmdHandler) Handle(ctx context.Context, height int64, targetIds []int64, dry bool, output string) {
+	logger.Info("running run use case [handler=cmd]")
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			logger.Error(err)
+			return
+		}
+		defer file.Close()
+		out = file
+	}
+
+	useCaseConfig := RunUseCaseConfig{
+		Height:    height,
+		TargetIds: targetIds,
+		Dry:       dry,
+	}
+	resp, err := h.getUseCase().Execute(ctx, useCaseConfig)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(resp); err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *RunCmdHandler) getUseCase() *runUseCase {
+	if h.useCase == nil {
+		return NewRunUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
@@ -0,0 +1,97 @@
+package indexing
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+)
+
+var (
+	_ types.HttpHandler = (*runHttpHandler)(nil)
+)
+
+type runHttpHandler struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *runUseCase
+}
+
+func NewRunHttpHandler(cfg *config.Config, db *psql.Store, c figmentclient.Client) *runHttpHandler {
+	return &runHttpHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type RunRequest struct {
+	Height  int64  `form:"height" binding:"required"`
+	Targets string `form:"targets" binding:"-"`
+	// Dry is a pointer so run is dry when it is not given
+	Dry *bool `form:"dry" binding:"-"`
+}
+
+func (h *runHttpHandler) Handle(c *gin.Context) {
+	var req RunRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid height or dry"))
+		return
+	}
+
+	targetIds, err := parseTargetIds(req.Targets)
+	if err != nil {
+		http.BadRequest(c, errors.New("invalid targets"))
+		return
+	}
+
+	dry := req.Dry == nil || *req.Dry
+
+	resp, err := h.getUseCase().Execute(c, RunUseCaseConfig{
+		Height:    req.Height,
+		TargetIds: targetIds,
+		Dry:       dry,
+	})
+	if errors.Cause(err) == indexer.ErrTargetNotFound {
+		http.BadRequest(c, err)
+		return
+	}
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *runHttpHandler) getUseCase() *runUseCase {
+	if h.useCase == nil {
+		h.useCase = NewRunUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
+
+// parseTargetIds parses comma separated list of target ids
+func parseTargetIds(value string) ([]int64, error) {
+	var targetIds []int64
+	if value == "" {
+		return targetIds, nil
+	}
+	for _, rawTargetId := range strings.Split(value, ",") {
+		targetId, err := strconv.ParseInt(strings.TrimSpace(rawTargetId), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		targetIds = append(targetIds, targetId)
+	}
+	return targetIds, nil
+}
@@ -29,7 +29,7 @@ func NewStartUseCase(cfg *config.Config, db *psql.Store, c figmentclient.Client)
 	}
 }
 
-func (uc *startUseCase) Execute(ctx context.Context, batchSize int64) error {
+func (uc *startUseCase) Execute(ctx context.Context, batchSize int64, parallelHeights int64) error {
 	if err := uc.canExecute(); err != nil {
 		return err
 	}
@@ -49,13 +49,21 @@ func (uc *startUseCase) Execute(ctx context.Context, batchSize int64) error {
 		uc.db.GetGovernance().ProposalAgg,
 		uc.db.GetCore().SystemEvents,
 		uc.db.GetGovernance().GovernanceActivitySeq,
+		uc.db.GetTransactions().TransactionSeq,
+		uc.db.GetEpochs().EpochSummary,
+		uc.db.GetAccounts().VoterRewardSeq,
+		uc.db.GetAccounts().VoterAgg,
+		uc.db.GetEpochs().ElectedValidatorSeq,
+		uc.db.GetWebhooks().WebhookSubscriptions,
+		uc.db.GetWebhooks().WebhookDeliveries,
 	)
 	if err != nil {
 		return err
 	}
 
 	return indexingPipeline.Start(ctx, indexer.IndexConfig{
-		BatchSize: batchSize,
+		BatchSize:       batchSize,
+		ParallelHeights: parallelHeights,
 	})
 }
 
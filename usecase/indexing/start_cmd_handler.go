@@ -25,10 +25,14 @@ func NewStartCmdHandler(cfg *config.Config, db *psql.Store, c figmentclient.Clie
 	}
 }
 
-func (h *StartCmdHandler) Handle(ctx context.Context, batchSize int64) {
-	logger.Info(fmt.Sprintf("running indexer use case [handler=cmd] [batchSize=%d]", batchSize))
+func (h *StartCmdHandler) Handle(ctx context.Context, batchSize int64, parallelHeights int64) {
+	if parallelHeights == 0 {
+		parallelHeights = h.cfg.ParallelHeights
+	}
+
+	logger.Info(fmt.Sprintf("running indexer use case [handler=cmd] [batchSize=%d] [parallelHeights=%d]", batchSize, parallelHeights))
 
-	err := h.getUseCase().Execute(ctx, batchSize)
+	err := h.getUseCase().Execute(ctx, batchSize, parallelHeights)
 	if err != nil {
 		logger.Error(err)
 		return
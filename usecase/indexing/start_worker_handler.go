@@ -32,11 +32,12 @@ func NewRunWorkerHandler(cfg *config.Config, db *psql.Store, c figmentclient.Cli
 
 func (h *runWorkerHandler) Handle() {
 	batchSize := h.cfg.DefaultBatchSize
+	parallelHeights := h.cfg.ParallelHeights
 	ctx := context.Background()
 
-	logger.Info(fmt.Sprintf("running indexer use case [handler=worker] [batchSize=%d]", batchSize))
+	logger.Info(fmt.Sprintf("running indexer use case [handler=worker] [batchSize=%d] [parallelHeights=%d]", batchSize, parallelHeights))
 
-	err := h.getUseCase().Execute(ctx, batchSize)
+	err := h.getUseCase().Execute(ctx, batchSize, parallelHeights)
 	if err != nil {
 		logger.Error(err)
 		return
@@ -0,0 +1,68 @@
+package indexing
+
+import (
+	"context"
+	"io"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type verifyUseCase struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+}
+
+func NewVerifyUseCase(cfg *config.Config, db *psql.Store, c figmentclient.Client) *verifyUseCase {
+	return &verifyUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type VerifyUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	SampleSize  int64
+	Output      io.Writer
+}
+
+func (uc *verifyUseCase) Execute(ctx context.Context, useCaseConfig VerifyUseCaseConfig) error {
+	indexingPipeline, err := indexer.NewPipeline(
+		uc.cfg,
+		uc.client,
+		uc.db.GetCore().Syncables,
+		uc.db.GetCore().Database,
+		uc.db.GetCore().Reports,
+		uc.db.GetBlocks().BlockSeq,
+		uc.db.GetValidators().ValidatorSeq,
+		uc.db.GetAccounts().AccountActivitySeq,
+		uc.db.GetValidatorGroups().ValidatorGroupSeq,
+		uc.db.GetValidators().ValidatorAgg,
+		uc.db.GetValidatorGroups().ValidatorGroupAgg,
+		uc.db.GetGovernance().ProposalAgg,
+		uc.db.GetCore().SystemEvents,
+		uc.db.GetGovernance().GovernanceActivitySeq,
+		uc.db.GetTransactions().TransactionSeq,
+		uc.db.GetEpochs().EpochSummary,
+		uc.db.GetAccounts().VoterRewardSeq,
+		uc.db.GetAccounts().VoterAgg,
+		uc.db.GetEpochs().ElectedValidatorSeq,
+		uc.db.GetWebhooks().WebhookSubscriptions,
+		uc.db.GetWebhooks().WebhookDeliveries,
+	)
+	if err != nil {
+		return err
+	}
+
+	return indexingPipeline.Verify(ctx, indexer.VerifyConfig{
+		StartHeight: useCaseConfig.StartHeight,
+		EndHeight:   useCaseConfig.EndHeight,
+		SampleSize:  useCaseConfig.SampleSize,
+		Output:      useCaseConfig.Output,
+	})
+}
@@ -0,0 +1,63 @@
+package indexing
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+type VerifyCmdHandler struct {
+	cfg    *config.Config
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *verifyUseCase
+}
+
+func NewVerifyCmdHandler(cfg *config.Config, db *psql.Store, c figmentclient.Client) *VerifyCmdHandler {
+	return &VerifyCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+// Handle verifies heights and writes discrepancy report to output file, or to stdout when output is not given
+func (h *VerifyCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64, sampleSize int64, output string) {
+	logger.Info("running verify use case [handler=cmd]")
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			logger.Error(err)
+			return
+		}
+		defer file.Close()
+		out = file
+	}
+
+	useCaseConfig := VerifyUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		SampleSize:  sampleSize,
+		Output:      out,
+	}
+	err := h.getUseCase().Execute(ctx, useCaseConfig)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *VerifyCmdHandler) getUseCase() *verifyUseCase {
+	if h.useCase == nil {
+		return NewVerifyUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
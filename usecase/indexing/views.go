@@ -0,0 +1,58 @@
+package indexing
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/indexer"
+	"github.com/figment-networks/celo-indexer/model"
+)
+
+// RunView is a result of running pipeline for single height
+type RunView struct {
+	Height int64 `json:"height"`
+	Dry    bool  `json:"dry"`
+
+	HeightMeta indexer.HeightMeta `json:"height_meta"`
+	Syncable   *model.Syncable    `json:"syncable"`
+
+	Raw                  RawView                         `json:"raw"`
+	ParsedGovernanceLogs []*indexer.ParsedGovernanceLogs `json:"parsed_governance_logs"`
+	Sequences            SequencesView                   `json:"sequences"`
+	Aggregates           AggregatesView                  `json:"aggregates"`
+	SystemEvents         []model.SystemEvent             `json:"system_events"`
+}
+
+// RawView contains data fetched from node
+type RawView struct {
+	Block             *figmentclient.Block              `json:"block"`
+	Validators        []*figmentclient.Validator        `json:"validators"`
+	ValidatorGroups   []*figmentclient.ValidatorGroup   `json:"validator_groups"`
+	Transactions      []*figmentclient.Transaction      `json:"transactions"`
+	EpochSummary      *figmentclient.EpochSummary       `json:"epoch_summary"`
+	ElectedValidators []*figmentclient.ElectedValidator `json:"elected_validators"`
+}
+
+// SequencesView contains sequences mapped from raw data
+type SequencesView struct {
+	NewBlock             *model.BlockSeq               `json:"new_block"`
+	UpdatedBlock         *model.BlockSeq               `json:"updated_block"`
+	Validators           []model.ValidatorSeq          `json:"validators"`
+	ValidatorGroups      []model.ValidatorGroupSeq     `json:"validator_groups"`
+	AccountActivities    []model.AccountActivitySeq    `json:"account_activities"`
+	GovernanceActivities []model.GovernanceActivitySeq `json:"governance_activities"`
+	Transactions         []model.TransactionSeq        `json:"transactions"`
+	EpochSummary         *model.EpochSummary           `json:"epoch_summary"`
+	VoterRewards         []model.VoterRewardSeq        `json:"voter_rewards"`
+	ElectedValidators    []model.ElectedValidatorSeq   `json:"elected_validators"`
+}
+
+// AggregatesView contains aggregates created or updated at height
+type AggregatesView struct {
+	NewValidators          []model.ValidatorAgg      `json:"new_validators"`
+	UpdatedValidators      []model.ValidatorAgg      `json:"updated_validators"`
+	NewValidatorGroups     []model.ValidatorGroupAgg `json:"new_validator_groups"`
+	UpdatedValidatorGroups []model.ValidatorGroupAgg `json:"updated_validator_groups"`
+	NewProposals           []model.ProposalAgg       `json:"new_proposals"`
+	UpdatedProposals       []model.ProposalAgg       `json:"updated_proposals"`
+	NewVoters              []model.VoterAgg          `json:"new_voters"`
+	UpdatedVoters          []model.VoterAgg          `json:"updated_voters"`
+}
@@ -0,0 +1,24 @@
+package transaction
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getByHashUseCase struct {
+	db *psql.Store
+}
+
+func NewGetByHashUseCase(db *psql.Store) *getByHashUseCase {
+	return &getByHashUseCase{
+		db: db,
+	}
+}
+
+func (uc *getByHashUseCase) Execute(hash string) (*DetailsView, error) {
+	tx, err := uc.db.GetTransactions().TransactionSeq.FindByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDetailsView(*tx), nil
+}
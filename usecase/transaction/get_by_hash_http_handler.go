@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getByHashHttpHandler)(nil)
+)
+
+type getByHashHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getByHashUseCase
+}
+
+func NewGetByHashHttpHandler(db *psql.Store, c figmentclient.Client) *getByHashHttpHandler {
+	return &getByHashHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetByHashRequest struct {
+	Hash string `uri:"hash" binding:"required"`
+}
+
+func (h *getByHashHttpHandler) Handle(c *gin.Context) {
+	var req GetByHashRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid hash"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Hash)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getByHashHttpHandler) getUseCase() *getByHashUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetByHashUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -1,25 +1,21 @@
 package transaction
 
 import (
-	"context"
-	"github.com/figment-networks/celo-indexer/client/figmentclient"
 	"github.com/figment-networks/celo-indexer/store/psql"
 	"github.com/pkg/errors"
 )
 
 type getByHeightUseCase struct {
-	db     *psql.Store
-	client figmentclient.Client
+	db *psql.Store
 }
 
-func NewGetByHeightUseCase(db *psql.Store, c figmentclient.Client) *getByHeightUseCase {
+func NewGetByHeightUseCase(db *psql.Store) *getByHeightUseCase {
 	return &getByHeightUseCase{
-		db:     db,
-		client: c,
+		db: db,
 	}
 }
 
-func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64) (*ListView, error) {
+func (uc *getByHeightUseCase) Execute(height *int64) (*ListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.GetCore().Syncables.FindMostRecent()
 	if err != nil {
@@ -36,7 +32,7 @@ func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64) (*List
 		return nil, errors.New("height is not indexed yet")
 	}
 
-	txs, err := uc.client.GetTransactionsByHeight(ctx, *height)
+	txs, err := uc.db.GetTransactions().TransactionSeq.FindByHeight(*height)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (h *getByHeightHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	ds, err := h.getUseCase().Execute(c, req.Height)
+	ds, err := h.getUseCase().Execute(req.Height)
 	if err != nil {
 		logger.Error(err)
 		http.ServerError(c, err)
@@ -52,7 +52,7 @@ func (h *getByHeightHttpHandler) Handle(c *gin.Context) {
 
 func (h *getByHeightHttpHandler) getUseCase() *getByHeightUseCase {
 	if h.useCase == nil {
-		return NewGetByHeightUseCase(h.db, h.client)
+		h.useCase = NewGetByHeightUseCase(h.db)
 	}
 	return h.useCase
 }
@@ -1,15 +1,59 @@
 package transaction
 
 import (
-	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
 )
 
+type DetailsView struct {
+	Height              int64          `json:"height"`
+	Time                types.Time     `json:"time"`
+	Hash                string         `json:"hash"`
+	Index               int64          `json:"index"`
+	From                string         `json:"from"`
+	To                  string         `json:"to"`
+	Nonce               uint64         `json:"nonce"`
+	Gas                 uint64         `json:"gas"`
+	GasPrice            types.Quantity `json:"gas_price"`
+	GasUsed             uint64         `json:"gas_used"`
+	FeeCurrency         string         `json:"fee_currency"`
+	GatewayFee          types.Quantity `json:"gateway_fee"`
+	GatewayFeeRecipient string         `json:"gateway_fee_recipient"`
+	Success             bool           `json:"success"`
+	Operations          types.Jsonb    `json:"operations"`
+}
+
+func ToDetailsView(m model.TransactionSeq) *DetailsView {
+	return &DetailsView{
+		Height:              m.Height,
+		Time:                m.Time,
+		Hash:                m.Hash,
+		Index:               m.Index,
+		From:                m.FromAddress,
+		To:                  m.ToAddress,
+		Nonce:               m.Nonce,
+		Gas:                 m.Gas,
+		GasPrice:            m.GasPrice,
+		GasUsed:             m.GasUsed,
+		FeeCurrency:         m.FeeCurrency,
+		GatewayFee:          m.GatewayFee,
+		GatewayFeeRecipient: m.GatewayFeeRecipient,
+		Success:             m.Success,
+		Operations:          m.Operations,
+	}
+}
+
 type ListView struct {
-	Items []*figmentclient.Transaction `json:"items"`
+	Items []DetailsView `json:"items"`
 }
 
-func ToListView(rawTransactions []*figmentclient.Transaction) *ListView {
+func ToListView(transactions []model.TransactionSeq) *ListView {
+	items := []DetailsView{}
+	for _, m := range transactions {
+		items = append(items, *ToDetailsView(m))
+	}
+
 	return &ListView{
-		Items: rawTransactions,
+		Items: items,
 	}
 }
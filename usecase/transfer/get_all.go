@@ -0,0 +1,25 @@
+package transfer
+
+import (
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getAllUseCase struct {
+	db *psql.Store
+}
+
+func NewGetAllUseCase(db *psql.Store) *getAllUseCase {
+	return &getAllUseCase{
+		db: db,
+	}
+}
+
+func (uc *getAllUseCase) Execute(token string, address string, query store.AddressHistoryQuery) (*ListView, error) {
+	transfers, nextCursor, err := uc.db.GetAccounts().AccountActivitySeq.FindTransfers(token, address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(transfers, nextCursor), nil
+}
@@ -0,0 +1,43 @@
+package transfer
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+type ItemView struct {
+	Height          int64          `json:"height"`
+	Time            types.Time     `json:"time"`
+	TransactionHash string         `json:"transaction_hash"`
+	Token           string         `json:"token"`
+	From            string         `json:"from"`
+	To              string         `json:"to"`
+	Amount          types.Quantity `json:"amount"`
+}
+
+type ListView struct {
+	Items      []ItemView                  `json:"items"`
+	NextCursor *store.AddressHistoryCursor `json:"next_cursor,omitempty"`
+}
+
+// ToListView maps sent token transfer sequences to transfers
+func ToListView(accountActivitySeqs []model.AccountActivitySeq, nextCursor *store.AddressHistoryCursor) *ListView {
+	items := []ItemView{}
+	for _, s := range accountActivitySeqs {
+		items = append(items, ItemView{
+			Height:          s.Height,
+			Time:            s.Time,
+			TransactionHash: s.TransactionHash,
+			Token:           s.Token,
+			From:            s.Address,
+			To:              s.Counterparty,
+			Amount:          s.Amount,
+		})
+	}
+
+	return &ListView{
+		Items:      items,
+		NextCursor: nextCursor,
+	}
+}
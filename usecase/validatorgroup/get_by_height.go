@@ -63,6 +63,13 @@ func (uc *getByHeightUseCase) Execute(height *int64) (SeqListView, error) {
 			uc.db.GetGovernance().ProposalAgg,
 			uc.db.GetCore().SystemEvents,
 			uc.db.GetGovernance().GovernanceActivitySeq,
+			uc.db.GetTransactions().TransactionSeq,
+			uc.db.GetEpochs().EpochSummary,
+			uc.db.GetAccounts().VoterRewardSeq,
+			uc.db.GetAccounts().VoterAgg,
+			uc.db.GetEpochs().ElectedValidatorSeq,
+			uc.db.GetWebhooks().WebhookSubscriptions,
+			uc.db.GetWebhooks().WebhookDeliveries,
 		)
 		if err != nil {
 			return SeqListView{}, err
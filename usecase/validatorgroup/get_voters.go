@@ -0,0 +1,24 @@
+package validatorgroup
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getVotersUseCase struct {
+	db *psql.Store
+}
+
+func NewGetVotersUseCase(db *psql.Store) *getVotersUseCase {
+	return &getVotersUseCase{
+		db: db,
+	}
+}
+
+func (uc *getVotersUseCase) Execute(address string, limit int64) (*VoterListView, error) {
+	voterAggs, err := uc.db.GetAccounts().VoterAgg.FindTopByGroup(address, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToVoterListView(voterAggs), nil
+}
@@ -0,0 +1,71 @@
+package validatorgroup
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultVotersLimit int64 = 10
+)
+
+var (
+	_ types.HttpHandler = (*getVotersHttpHandler)(nil)
+)
+
+type getVotersHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getVotersUseCase
+}
+
+func NewGetVotersHttpHandler(db *psql.Store, c figmentclient.Client) *getVotersHttpHandler {
+	return &getVotersHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetVotersRequest struct {
+	Address string `uri:"address" binding:"required"`
+	Limit   *int64 `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+func (h *getVotersHttpHandler) Handle(c *gin.Context) {
+	var req GetVotersRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error(err)
+		http.BadRequest(c, errors.New("invalid limit"))
+		return
+	}
+
+	limit := defaultVotersLimit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	ds, err := h.getUseCase().Execute(req.Address, limit)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, ds)
+}
+
+func (h *getVotersHttpHandler) getUseCase() *getVotersUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetVotersUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -78,3 +78,17 @@ func ToSeqListView(validatorGroupSeqs []model.ValidatorGroupSeq) SeqListView {
 		Items: items,
 	}
 }
+
+type VoterListView struct {
+	Items []model.VoterAgg `json:"items"`
+}
+
+func ToVoterListView(voterAggs []model.VoterAgg) *VoterListView {
+	if voterAggs == nil {
+		voterAggs = []model.VoterAgg{}
+	}
+
+	return &VoterListView{
+		Items: voterAggs,
+	}
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/pkg/errors"
+)
+
+const secretLength = 32
+
+var (
+	ErrSubscriptionUrlInvalid  = errors.New("url must be absolute http or https url")
+	ErrSubscriptionKindInvalid = errors.New("kind is invalid")
+)
+
+type createSubscriptionUseCase struct {
+	db *psql.Store
+}
+
+func NewCreateSubscriptionUseCase(db *psql.Store) *createSubscriptionUseCase {
+	return &createSubscriptionUseCase{
+		db: db,
+	}
+}
+
+func (uc *createSubscriptionUseCase) Execute(rawUrl string, actors []string, kinds []string, secret string) (*CreatedSubscriptionView, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrSubscriptionUrlInvalid
+	}
+
+	for _, kind := range kinds {
+		if !model.SystemEventKind(kind).Valid() {
+			return nil, errors.Wrap(ErrSubscriptionKindInvalid, fmt.Sprintf("kind %s", kind))
+		}
+	}
+
+	if secret == "" {
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription := &model.WebhookSubscription{
+		Url:    rawUrl,
+		Actors: actors,
+		Kinds:  kinds,
+		Secret: secret,
+	}
+	if subscription.Actors == nil {
+		subscription.Actors = []string{}
+	}
+	if subscription.Kinds == nil {
+		subscription.Kinds = []string{}
+	}
+
+	if err := uc.db.GetWebhooks().WebhookSubscriptions.Create(subscription); err != nil {
+		return nil, err
+	}
+
+	return ToCreatedSubscriptionView(*subscription), nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
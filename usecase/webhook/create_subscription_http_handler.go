@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*createSubscriptionHttpHandler)(nil)
+)
+
+type createSubscriptionHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *createSubscriptionUseCase
+}
+
+func NewCreateSubscriptionHttpHandler(db *psql.Store, c figmentclient.Client) *createSubscriptionHttpHandler {
+	return &createSubscriptionHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type CreateSubscriptionRequest struct {
+	Url    string   `json:"url" binding:"required"`
+	Actors []string `json:"actors" binding:"-"`
+	Kinds  []string `json:"kinds" binding:"-"`
+	Secret string   `json:"secret" binding:"-"`
+}
+
+func (h *createSubscriptionHttpHandler) Handle(c *gin.Context) {
+	var req CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid subscription"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Url, req.Actors, req.Kinds, req.Secret)
+	if errors.Cause(err) == ErrSubscriptionUrlInvalid || errors.Cause(err) == ErrSubscriptionKindInvalid {
+		http.BadRequest(c, err)
+		return
+	}
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *createSubscriptionHttpHandler) getUseCase() *createSubscriptionUseCase {
+	if h.useCase == nil {
+		h.useCase = NewCreateSubscriptionUseCase(h.db)
+	}
+	return h.useCase
+}
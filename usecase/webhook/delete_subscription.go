@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+type deleteSubscriptionUseCase struct {
+	db *psql.Store
+}
+
+func NewDeleteSubscriptionUseCase(db *psql.Store) *deleteSubscriptionUseCase {
+	return &deleteSubscriptionUseCase{
+		db: db,
+	}
+}
+
+func (uc *deleteSubscriptionUseCase) Execute(id types.ID) error {
+	deletedCount, err := uc.db.GetWebhooks().WebhookSubscriptions.DeleteByID(id)
+	if err != nil {
+		return err
+	}
+	if *deletedCount == 0 {
+		return psql.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*deleteSubscriptionHttpHandler)(nil)
+)
+
+type deleteSubscriptionHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *deleteSubscriptionUseCase
+}
+
+func NewDeleteSubscriptionHttpHandler(db *psql.Store, c figmentclient.Client) *deleteSubscriptionHttpHandler {
+	return &deleteSubscriptionHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type DeleteSubscriptionRequest struct {
+	ID types.ID `uri:"id" binding:"required"`
+}
+
+func (h *deleteSubscriptionHttpHandler) Handle(c *gin.Context) {
+	var req DeleteSubscriptionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	err := h.getUseCase().Execute(req.ID)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, gin.H{"id": req.ID})
+}
+
+func (h *deleteSubscriptionHttpHandler) getUseCase() *deleteSubscriptionUseCase {
+	if h.useCase == nil {
+		h.useCase = NewDeleteSubscriptionUseCase(h.db)
+	}
+	return h.useCase
+}
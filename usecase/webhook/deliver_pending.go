@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/webhook"
+)
+
+type deliverPendingUseCase struct {
+	cfg *config.Config
+	db  *psql.Store
+}
+
+func NewDeliverPendingUseCase(cfg *config.Config, db *psql.Store) *deliverPendingUseCase {
+	return &deliverPendingUseCase{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+func (uc *deliverPendingUseCase) Execute(ctx context.Context) error {
+	dispatcherConfig, err := webhook.NewDispatcherConfig(uc.cfg)
+	if err != nil {
+		return err
+	}
+
+	dispatcher := webhook.NewDispatcher(uc.db.GetWebhooks().WebhookSubscriptions, uc.db.GetWebhooks().WebhookDeliveries, dispatcherConfig)
+
+	return dispatcher.DeliverPending(ctx)
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*deliverPendingWorkerHandler)(nil)
+)
+
+type deliverPendingWorkerHandler struct {
+	cfg *config.Config
+	db  *psql.Store
+
+	useCase *deliverPendingUseCase
+}
+
+func NewDeliverPendingWorkerHandler(cfg *config.Config, db *psql.Store) *deliverPendingWorkerHandler {
+	return &deliverPendingWorkerHandler{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+func (h *deliverPendingWorkerHandler) Handle() {
+	ctx := context.Background()
+
+	logger.Info("running deliver pending webhooks use case [handler=worker]")
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *deliverPendingWorkerHandler) getUseCase() *deliverPendingUseCase {
+	if h.useCase == nil {
+		return NewDeliverPendingUseCase(h.cfg, h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+type getDeliveriesUseCase struct {
+	db *psql.Store
+}
+
+func NewGetDeliveriesUseCase(db *psql.Store) *getDeliveriesUseCase {
+	return &getDeliveriesUseCase{
+		db: db,
+	}
+}
+
+func (uc *getDeliveriesUseCase) Execute(subscriptionID types.ID, limit int64) (*DeliveryListView, error) {
+	if _, err := uc.db.GetWebhooks().WebhookSubscriptions.FindByID(subscriptionID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.db.GetWebhooks().WebhookDeliveries.FindBySubscriptionID(subscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDeliveryListView(deliveries), nil
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const defaultDeliveriesLimit = 100
+
+var (
+	_ types.HttpHandler = (*getDeliveriesHttpHandler)(nil)
+)
+
+type getDeliveriesHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getDeliveriesUseCase
+}
+
+func NewGetDeliveriesHttpHandler(db *psql.Store, c figmentclient.Client) *getDeliveriesHttpHandler {
+	return &getDeliveriesHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetDeliveriesRequest struct {
+	ID    types.ID `uri:"id" binding:"required"`
+	Limit int64    `form:"limit" binding:"-"`
+}
+
+func (h *getDeliveriesHttpHandler) Handle(c *gin.Context) {
+	var req GetDeliveriesRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid limit"))
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultDeliveriesLimit
+	}
+
+	resp, err := h.getUseCase().Execute(req.ID, req.Limit)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getDeliveriesHttpHandler) getUseCase() *getDeliveriesUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetDeliveriesUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/store/psql"
+)
+
+type getSubscriptionsUseCase struct {
+	db *psql.Store
+}
+
+func NewGetSubscriptionsUseCase(db *psql.Store) *getSubscriptionsUseCase {
+	return &getSubscriptionsUseCase{
+		db: db,
+	}
+}
+
+func (uc *getSubscriptionsUseCase) Execute() (*SubscriptionListView, error) {
+	subscriptions, err := uc.db.GetWebhooks().WebhookSubscriptions.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return ToSubscriptionListView(subscriptions), nil
+}
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/client/figmentclient"
+	"github.com/figment-networks/celo-indexer/store/psql"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getSubscriptionsHttpHandler)(nil)
+)
+
+type getSubscriptionsHttpHandler struct {
+	db     *psql.Store
+	client figmentclient.Client
+
+	useCase *getSubscriptionsUseCase
+}
+
+func NewGetSubscriptionsHttpHandler(db *psql.Store, c figmentclient.Client) *getSubscriptionsHttpHandler {
+	return &getSubscriptionsHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getSubscriptionsHttpHandler) Handle(c *gin.Context) {
+	resp, err := h.getUseCase().Execute()
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getSubscriptionsHttpHandler) getUseCase() *getSubscriptionsUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetSubscriptionsUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
+)
+
+type SubscriptionView struct {
+	*model.ModelWithTimestamps
+
+	Url    string   `json:"url"`
+	Actors []string `json:"actors"`
+	Kinds  []string `json:"kinds"`
+}
+
+func ToSubscriptionView(m model.WebhookSubscription) SubscriptionView {
+	return SubscriptionView{
+		ModelWithTimestamps: m.ModelWithTimestamps,
+
+		Url:    m.Url,
+		Actors: m.Actors,
+		Kinds:  m.Kinds,
+	}
+}
+
+// CreatedSubscriptionView includes secret which is returned only once, when subscription is created
+type CreatedSubscriptionView struct {
+	SubscriptionView
+
+	Secret string `json:"secret"`
+}
+
+func ToCreatedSubscriptionView(m model.WebhookSubscription) *CreatedSubscriptionView {
+	return &CreatedSubscriptionView{
+		SubscriptionView: ToSubscriptionView(m),
+		Secret:           m.Secret,
+	}
+}
+
+type SubscriptionListView struct {
+	Items []SubscriptionView `json:"items"`
+}
+
+func ToSubscriptionListView(subscriptions []model.WebhookSubscription) *SubscriptionListView {
+	items := make([]SubscriptionView, 0, len(subscriptions))
+	for _, m := range subscriptions {
+		items = append(items, ToSubscriptionView(m))
+	}
+
+	return &SubscriptionListView{
+		Items: items,
+	}
+}
+
+type DeliveryItem struct {
+	*model.ModelWithTimestamps
+
+	Height        int64       `json:"height"`
+	Actor         string      `json:"actor"`
+	Kind          string      `json:"kind"`
+	Attempts      int64       `json:"attempts"`
+	StatusCode    *int64      `json:"status_code"`
+	ErrorMsg      *string     `json:"error_msg"`
+	NextAttemptAt *types.Time `json:"next_attempt_at"`
+	DeliveredAt   *types.Time `json:"delivered_at"`
+}
+
+type DeliveryListView struct {
+	Items []DeliveryItem `json:"items"`
+}
+
+func ToDeliveryListView(deliveries []model.WebhookDelivery) *DeliveryListView {
+	items := make([]DeliveryItem, 0, len(deliveries))
+	for _, m := range deliveries {
+		items = append(items, DeliveryItem{
+			ModelWithTimestamps: m.ModelWithTimestamps,
+
+			Height:        m.Height,
+			Actor:         m.Actor,
+			Kind:          m.Kind.String(),
+			Attempts:      m.Attempts,
+			StatusCode:    m.StatusCode,
+			ErrorMsg:      m.ErrorMsg,
+			NextAttemptAt: m.NextAttemptAt,
+			DeliveredAt:   m.DeliveredAt,
+		})
+	}
+
+	return &DeliveryListView{
+		Items: items,
+	}
+}
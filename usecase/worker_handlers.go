@@ -8,6 +8,7 @@ import (
 	"github.com/figment-networks/celo-indexer/types"
 	"github.com/figment-networks/celo-indexer/usecase/governance"
 	"github.com/figment-networks/celo-indexer/usecase/indexing"
+	"github.com/figment-networks/celo-indexer/usecase/webhook"
 )
 
 func NewWorkerHandlers(cfg *config.Config, db *psql.Store, client figmentclient.Client, theCeloClient theceloclient.Client) *WorkerHandlers {
@@ -16,6 +17,7 @@ func NewWorkerHandlers(cfg *config.Config, db *psql.Store, client figmentclient.
 		SummarizeIndexer: indexing.NewSummarizeWorkerHandler(cfg, db, client),
 		PurgeIndexer:     indexing.NewPurgeWorkerHandler(cfg, db, client),
 		UpdateProposals:  governance.NewUpdateProposalsWorkerHandler(cfg, db, theCeloClient),
+		DeliverWebhooks:  webhook.NewDeliverPendingWorkerHandler(cfg, db),
 	}
 }
 
@@ -24,4 +26,5 @@ type WorkerHandlers struct {
 	SummarizeIndexer types.WorkerHandler
 	PurgeIndexer     types.WorkerHandler
 	UpdateProposals  types.WorkerHandler
+	DeliverWebhooks  types.WorkerHandler
 }
@@ -0,0 +1,274 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/celo-indexer/config"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/store"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/figment-networks/celo-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	SignatureHeader = "X-Indexer-Signature"
+	EventHeader     = "X-Indexer-Event"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	_ Dispatcher = (*dispatcher)(nil)
+)
+
+// Dispatcher enqueues system events for subscribed webhooks and delivers them
+type Dispatcher interface {
+	Enqueue(events []model.SystemEvent) error
+	DeliverPending(ctx context.Context) error
+}
+
+// DispatcherConfig holds retry settings of dispatcher
+type DispatcherConfig struct {
+	MaxAttempts    int64
+	BatchSize      int64
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Timeout        time.Duration
+}
+
+// NewDispatcherConfig creates dispatcher config from application config
+func NewDispatcherConfig(cfg *config.Config) (DispatcherConfig, error) {
+	initialBackoff, err := time.ParseDuration(cfg.WebhookInitialBackoff)
+	if err != nil {
+		return DispatcherConfig{}, err
+	}
+	maxBackoff, err := time.ParseDuration(cfg.WebhookMaxBackoff)
+	if err != nil {
+		return DispatcherConfig{}, err
+	}
+	timeout, err := time.ParseDuration(cfg.WebhookTimeout)
+	if err != nil {
+		return DispatcherConfig{}, err
+	}
+
+	return DispatcherConfig{
+		MaxAttempts:    cfg.WebhookMaxAttempts,
+		BatchSize:      cfg.WebhookBatchSize,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+		Timeout:        timeout,
+	}, nil
+}
+
+// NewDispatcher creates dispatcher which POSTs system events to matching subscriptions.
+// Events are stored in delivery log first and sent later, failed deliveries are retried with exponential backoff
+func NewDispatcher(subscriptionDb store.WebhookSubscriptions, deliveryDb store.WebhookDeliveries, cfg DispatcherConfig) *dispatcher {
+	return &dispatcher{
+		subscriptionDb: subscriptionDb,
+		deliveryDb:     deliveryDb,
+		cfg:            cfg,
+		httpClient:     &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+type dispatcher struct {
+	subscriptionDb store.WebhookSubscriptions
+	deliveryDb     store.WebhookDeliveries
+	cfg            DispatcherConfig
+	httpClient     *http.Client
+}
+
+// EventPayload is a body of webhook request
+type EventPayload struct {
+	Height int64       `json:"height"`
+	Time   types.Time  `json:"time"`
+	Actor  string      `json:"actor"`
+	Kind   string      `json:"kind"`
+	Data   types.Jsonb `json:"data"`
+}
+
+// Enqueue stores delivery of every event to each matching subscription in delivery log.
+// Events which were already enqueued for subscription are skipped
+func (d *dispatcher) Enqueue(events []model.SystemEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	subscriptions, err := d.subscriptionDb.FindAll()
+	if err != nil {
+		return errors.Wrap(err, "could not find webhook subscriptions")
+	}
+
+	now := types.NewTimeFromTime(time.Now())
+
+	var deliveries []model.WebhookDelivery
+	for _, subscription := range subscriptions {
+		for _, event := range events {
+			if !subscription.Matches(event) {
+				continue
+			}
+
+			body, err := json.Marshal(EventPayload{
+				Height: event.Height,
+				Time:   event.Time,
+				Actor:  event.Actor,
+				Kind:   event.Kind.String(),
+				Data:   event.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+			deliveries = append(deliveries, model.WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				Height:         event.Height,
+				Actor:          event.Actor,
+				Kind:           event.Kind,
+				Payload:        types.Jsonb{RawMessage: body},
+				NextAttemptAt:  now,
+			})
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return d.deliveryDb.BulkCreateIfNotExists(deliveries)
+}
+
+// DeliverPending makes single attempt to send every delivery which is due.
+// Deliveries of single subscription are sent in order and sending stops at first failure. Deliveries queued behind the failed one
+// are not pending until it is delivered or given up, so events do not overtake each other
+func (d *dispatcher) DeliverPending(ctx context.Context) error {
+	deliveries, err := d.deliveryDb.FindPending(time.Now(), d.cfg.BatchSize)
+	if err != nil {
+		return errors.Wrap(err, "could not find pending webhook deliveries")
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	subscriptions, err := d.subscriptionDb.FindAll()
+	if err != nil {
+		return errors.Wrap(err, "could not find webhook subscriptions")
+	}
+
+	deliveriesBySubscription := map[types.ID][]*model.WebhookDelivery{}
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		deliveriesBySubscription[delivery.SubscriptionID] = append(deliveriesBySubscription[delivery.SubscriptionID], delivery)
+	}
+
+	var wg sync.WaitGroup
+	for i := range subscriptions {
+		pending, ok := deliveriesBySubscription[subscriptions[i].ID]
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(subscription *model.WebhookSubscription, pending []*model.WebhookDelivery) {
+			defer wg.Done()
+
+			for _, delivery := range pending {
+				if err := d.deliver(ctx, subscription, delivery); err != nil {
+					logger.Error(errors.Wrap(err, fmt.Sprintf("could not deliver webhook [subscription=%d] [height=%d] [kind=%s]", subscription.ID, delivery.Height, delivery.Kind)))
+					return
+				}
+			}
+		}(&subscriptions[i], pending)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// deliver makes single attempt to send delivery and records its outcome.
+// Next attempt is scheduled with backoff until delivery succeeds or runs out of attempts
+func (d *dispatcher) deliver(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) error {
+	delivery.Attempts++
+	statusCode, postErr := d.post(ctx, subscription, delivery.Kind, delivery.Payload.RawMessage)
+	if statusCode != 0 {
+		delivery.StatusCode = &statusCode
+	}
+
+	if postErr == nil {
+		delivery.ErrorMsg = nil
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = types.NewTimeFromTime(time.Now())
+	} else {
+		errMsg := postErr.Error()
+		delivery.ErrorMsg = &errMsg
+
+		if isRetryable(statusCode) && delivery.Attempts < d.cfg.MaxAttempts {
+			delivery.NextAttemptAt = types.NewTimeFromTime(time.Now().Add(d.backoff(delivery.Attempts + 1)))
+		} else {
+			delivery.NextAttemptAt = nil
+		}
+	}
+
+	if err := d.deliveryDb.Save(delivery); err != nil {
+		return err
+	}
+	return postErr
+}
+
+func (d *dispatcher) post(ctx context.Context, subscription *model.WebhookSubscription, kind model.SystemEventKind, body []byte) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, kind.String())
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	statusCode := int64(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return statusCode, errors.New(fmt.Sprintf("unexpected response status %d", resp.StatusCode))
+	}
+	return statusCode, nil
+}
+
+// backoff returns delay before given attempt. It doubles with every attempt up to max backoff
+func (d *dispatcher) backoff(attempt int64) time.Duration {
+	delay := d.cfg.InitialBackoff
+	for i := int64(2); i < attempt; i++ {
+		delay *= 2
+		if delay >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// Sign returns HMAC-SHA256 signature of body created with subscription secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// isRetryable returns false for responses which will not change when request is repeated
+func isRetryable(statusCode int64) bool {
+	if statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode < 400 || statusCode >= 500
+}
@@ -0,0 +1,274 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mock "github.com/figment-networks/celo-indexer/mock/store"
+	"github.com/figment-networks/celo-indexer/model"
+	"github.com/figment-networks/celo-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestDispatcher_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	event := model.SystemEvent{
+		Height: 20,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+		Actor:  "actor1",
+		Kind:   model.SystemEventMissedNofM,
+		Data:   types.Jsonb{RawMessage: json.RawMessage(`{"threshold":50}`)},
+	}
+
+	tests := []struct {
+		description   string
+		subscriptions []model.WebhookSubscription
+		events        []model.SystemEvent
+		expectCount   int
+	}{
+		{
+			description:   "enqueues event for matching subscription",
+			subscriptions: []model.WebhookSubscription{{ModelWithTimestamps: &model.ModelWithTimestamps{ID: 1}, Actors: []string{"actor1"}, Kinds: []string{"missed_n_of_m"}}},
+			events:        []model.SystemEvent{event},
+			expectCount:   1,
+		},
+		{
+			description: "enqueues event for every matching subscription",
+			subscriptions: []model.WebhookSubscription{
+				{ModelWithTimestamps: &model.ModelWithTimestamps{ID: 1}},
+				{ModelWithTimestamps: &model.ModelWithTimestamps{ID: 2}},
+			},
+			events:      []model.SystemEvent{event},
+			expectCount: 2,
+		},
+		{
+			description:   "skips subscription which does not match event",
+			subscriptions: []model.WebhookSubscription{{ModelWithTimestamps: &model.ModelWithTimestamps{ID: 1}, Kinds: []string{"joined_active_set"}}},
+			events:        []model.SystemEvent{event},
+		},
+		{
+			description: "does nothing without events",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptionDbMock := mock.NewMockWebhookSubscriptions(ctrl)
+			deliveryDbMock := mock.NewMockWebhookDeliveries(ctrl)
+
+			if len(tt.events) > 0 {
+				subscriptionDbMock.EXPECT().FindAll().Return(tt.subscriptions, nil).Times(1)
+			}
+			if tt.expectCount > 0 {
+				deliveryDbMock.EXPECT().BulkCreateIfNotExists(gomock.Any()).DoAndReturn(func(deliveries []model.WebhookDelivery) error {
+					if len(deliveries) != tt.expectCount {
+						t.Errorf("unexpected deliveries count, want %d; got %d", tt.expectCount, len(deliveries))
+					}
+					for _, delivery := range deliveries {
+						if !delivery.Pending() {
+							t.Errorf("delivery should be pending")
+						}
+
+						var payload EventPayload
+						if err := json.Unmarshal(delivery.Payload.RawMessage, &payload); err != nil || payload.Height != event.Height || payload.Actor != event.Actor {
+							t.Errorf("unexpected payload: %s", delivery.Payload.RawMessage)
+						}
+					}
+					return nil
+				}).Times(1)
+			}
+
+			d := NewDispatcher(subscriptionDbMock, deliveryDbMock, DispatcherConfig{})
+
+			if err := d.Enqueue(tt.events); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDispatcher_DeliverPending(t *testing.T) {
+	const secret = "test_secret"
+	t.Parallel()
+
+	body := []byte(`{"height":20,"actor":"actor1","kind":"missed_n_of_m"}`)
+
+	tests := []struct {
+		description       string
+		status            int
+		attempts          int64
+		deliveries        int
+		expectRequests    int64
+		expectAttempts    int64
+		expectDelivered   bool
+		expectNextAttempt bool
+	}{
+		{
+			description:     "delivers signed event",
+			status:          http.StatusOK,
+			deliveries:      1,
+			expectRequests:  1,
+			expectAttempts:  1,
+			expectDelivered: true,
+		},
+		{
+			description:       "schedules next attempt when server errors",
+			status:            http.StatusInternalServerError,
+			deliveries:        1,
+			expectRequests:    1,
+			expectAttempts:    1,
+			expectNextAttempt: true,
+		},
+		{
+			description:    "gives up after max attempts",
+			status:         http.StatusInternalServerError,
+			attempts:       2,
+			deliveries:     1,
+			expectRequests: 1,
+			expectAttempts: 3,
+		},
+		{
+			description:    "does not retry when request is rejected",
+			status:         http.StatusBadRequest,
+			deliveries:     1,
+			expectRequests: 1,
+			expectAttempts: 1,
+		},
+		{
+			description:       "stops sending deliveries of subscription after failure to keep their order",
+			status:            http.StatusInternalServerError,
+			deliveries:        2,
+			expectRequests:    1,
+			expectAttempts:    1,
+			expectNextAttempt: true,
+		},
+		{
+			description: "does nothing without pending deliveries",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var requests int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt64(&requests, 1)
+
+				got, _ := ioutil.ReadAll(r.Body)
+				if signature := r.Header.Get(SignatureHeader); signature != Sign(secret, got) {
+					t.Errorf("unexpected signature: %s", signature)
+				}
+				if kind := r.Header.Get(EventHeader); kind != model.SystemEventMissedNofM.String() {
+					t.Errorf("unexpected event header: %s", kind)
+				}
+				if string(got) != string(body) {
+					t.Errorf("unexpected body: %s", got)
+				}
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			subscription := model.WebhookSubscription{
+				ModelWithTimestamps: &model.ModelWithTimestamps{ID: 1},
+				Url:                 server.URL,
+				Secret:              secret,
+			}
+
+			var pending []model.WebhookDelivery
+			for i := 0; i < tt.deliveries; i++ {
+				pending = append(pending, model.WebhookDelivery{
+					SubscriptionID: subscription.ID,
+					Height:         int64(20 + i),
+					Actor:          "actor1",
+					Kind:           model.SystemEventMissedNofM,
+					Payload:        types.Jsonb{RawMessage: body},
+					Attempts:       tt.attempts,
+					NextAttemptAt:  types.NewTimeFromTime(time.Now()),
+				})
+			}
+
+			subscriptionDbMock := mock.NewMockWebhookSubscriptions(ctrl)
+			deliveryDbMock := mock.NewMockWebhookDeliveries(ctrl)
+
+			deliveryDbMock.EXPECT().FindPending(gomock.Any(), int64(10)).Return(pending, nil).Times(1)
+			if tt.deliveries > 0 {
+				subscriptionDbMock.EXPECT().FindAll().Return([]model.WebhookSubscription{subscription}, nil).Times(1)
+			}
+			if tt.expectRequests > 0 {
+				deliveryDbMock.EXPECT().Save(gomock.Any()).DoAndReturn(func(delivery *model.WebhookDelivery) error {
+					if delivery.Attempts != tt.expectAttempts {
+						t.Errorf("unexpected attempts, want %d; got %d", tt.expectAttempts, delivery.Attempts)
+					}
+					if delivery.Delivered() != tt.expectDelivered {
+						t.Errorf("unexpected delivered, want %v; got %v", tt.expectDelivered, delivery.Delivered())
+					}
+					if delivery.Pending() != tt.expectNextAttempt {
+						t.Errorf("unexpected pending, want %v; got %v", tt.expectNextAttempt, delivery.Pending())
+					}
+					if !tt.expectDelivered && delivery.ErrorMsg == nil {
+						t.Errorf("error message should be recorded")
+					}
+					return nil
+				}).Times(int(tt.expectRequests))
+			}
+
+			d := NewDispatcher(subscriptionDbMock, deliveryDbMock, DispatcherConfig{
+				MaxAttempts:    3,
+				BatchSize:      10,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     time.Millisecond,
+				Timeout:        time.Second,
+			})
+
+			if err := d.DeliverPending(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if requests != tt.expectRequests {
+				t.Errorf("unexpected requests count, want %d; got %d", tt.expectRequests, requests)
+			}
+		})
+	}
+}
+
+func TestDispatcher_backoff(t *testing.T) {
+	t.Parallel()
+
+	d := NewDispatcher(nil, nil, DispatcherConfig{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+	tests := []struct {
+		attempt int64
+		expect  time.Duration
+	}{
+		{2, time.Second},
+		{3, 2 * time.Second},
+		{4, 4 * time.Second},
+		{5, 5 * time.Second},
+		{10, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempt); got != tt.expect {
+			t.Errorf("unexpected backoff for attempt %d, want %v; got %v", tt.attempt, tt.expect, got)
+		}
+	}
+}
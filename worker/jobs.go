@@ -25,3 +25,9 @@ func (w *Worker) addUpdateProposalsJob() (cron.EntryID, error) {
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
 	return w.cronJob.AddJob(w.cfg.UpdateProposalsInterval, job)
 }
+
+func (w *Worker) addDeliverWebhooksJob() (cron.EntryID, error) {
+	job = cron.FuncJob(w.handlers.DeliverWebhooks.Handle)
+	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
+	return w.cronJob.AddJob(w.cfg.DeliverWebhooksInterval, job)
+}
@@ -61,6 +61,11 @@ func (w *Worker) init() (*Worker, error) {
 		return nil, err
 	}
 
+	_, err = w.addDeliverWebhooksJob()
+	if err != nil {
+		return nil, err
+	}
+
 	return w, nil
 }
 